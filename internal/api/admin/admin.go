@@ -1,8 +1,8 @@
 package admin
 
 import (
-	"context"
 	"crypto/md5"
+	"encoding/csv"
 	"encoding/hex"
 	"net/http"
 	"strconv"
@@ -13,10 +13,12 @@ import (
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/chat/pkg/common/config"
+	chatconstant "github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/common/xlsx"
 	"github.com/openimsdk/chat/pkg/common/xlsx/model"
+	"github.com/openimsdk/chat/pkg/phonenumber"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/protocol/constant"
@@ -56,13 +58,16 @@ func (o *Api) AdminLogin(c *gin.Context) {
 		apiresp.GinError(c, errs.New("openim-admin-front version too old, please use new version").Wrap())
 		return
 	}
-	loginResp, err := o.adminClient.Login(c, req)
+	ip, err := o.GetClientIP(c)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
-	imAdminUserID := o.GetDefaultIMAdminUserID()
-	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	req.Ip = ip
+	if req.Platform == 0 {
+		req.Platform = constant.AdminPlatformID
+	}
+	loginResp, err := o.adminClient.Login(c, req)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
@@ -72,11 +77,70 @@ func (o *Api) AdminLogin(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
+	if loginResp.TwoFactorSetupRequired {
+		apiresp.GinSuccess(c, resp)
+		return
+	}
+	imAdminUserID := o.GetDefaultIMAdminUserID()
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
 	resp.ImToken = imToken
 	resp.ImUserID = imAdminUserID
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *Api) RefreshToken(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.RefreshTokenReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.UserType = chatconstant.AdminUser
+	if req.Ip, err = o.GetClientIP(c); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RefreshToken(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) GetSessions(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.GetSessions, o.adminClient)
+}
+
+func (o *Api) RevokeSession(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.RevokeSessionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RevokeSession(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	// admins are not IM users, only user sessions have an IM connection to close
+	if resp.UserType == chatconstant.NormalUser {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		if err := o.imApiCaller.ForceOffLinePlatform(mctx.WithApiToken(c, imToken), resp.UserID, resp.PlatformID); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
 func (o *Api) ResetUserPassword(c *gin.Context) {
 	req, err := a2r.ParseRequest[chat.ChangePasswordReq](c)
 	if err != nil {
@@ -100,7 +164,7 @@ func (o *Api) ResetUserPassword(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-
+	util.Audit(c, o.adminClient, chatconstant.AuditUserPasswordReset, req.UserID, nil, nil)
 	apiresp.GinSuccess(c, resp)
 }
 
@@ -151,20 +215,23 @@ func (o *Api) AddUserAccount(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
-	if err != nil {
+	if err := o.registerChatUser(c, ip, []*chat.RegisterUserInfo{req.User}); err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
+	apiresp.GinSuccess(c, nil)
+}
 
-	ctx := o.WithAdminUser(mctx.WithApiToken(c, imToken))
+func (o *Api) AddAPIKey(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.AddAPIKey, o.adminClient)
+}
 
-	err = o.registerChatUser(ctx, ip, []*chat.RegisterUserInfo{req.User})
-	if err != nil {
-		return
-	}
+func (o *Api) SearchAPIKey(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchAPIKey, o.adminClient)
+}
 
-	apiresp.GinSuccess(c, nil)
+func (o *Api) DelAPIKey(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.DelAPIKey, o.adminClient)
 }
 
 func (o *Api) DelAdminAccount(c *gin.Context) {
@@ -175,6 +242,30 @@ func (o *Api) SearchAdminAccount(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.SearchAdminAccount, o.adminClient)
 }
 
+func (o *Api) SetAdminRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SetAdminRole, o.adminClient)
+}
+
+func (o *Api) AddRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.AddRole, o.adminClient)
+}
+
+func (o *Api) UpdateRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.UpdateRole, o.adminClient)
+}
+
+func (o *Api) DelRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.DelRole, o.adminClient)
+}
+
+func (o *Api) SearchRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchRole, o.adminClient)
+}
+
+func (o *Api) GetPermissions(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.GetPermissions, o.adminClient)
+}
+
 func (o *Api) AddDefaultFriend(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.AddDefaultFriend, o.adminClient)
 }
@@ -312,6 +403,30 @@ func (o *Api) DelIPForbidden(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.DelIPForbidden, o.adminClient)
 }
 
+func (o *Api) AddTestAccount(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.AddTestAccount, o.adminClient)
+}
+
+func (o *Api) DelTestAccount(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.DelTestAccount, o.adminClient)
+}
+
+func (o *Api) SearchTestAccount(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchTestAccount, o.adminClient)
+}
+
+func (o *Api) SearchLoginLock(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchLoginLock, o.adminClient)
+}
+
+func (o *Api) GetLoginLock(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.GetLoginLock, o.adminClient)
+}
+
+func (o *Api) ClearLoginLock(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.ClearLoginLock, o.adminClient)
+}
+
 func (o *Api) ParseToken(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.ParseToken, o.adminClient)
 }
@@ -348,6 +463,22 @@ func (o *Api) SearchBlockUser(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.SearchBlockUser, o.adminClient)
 }
 
+func (o *Api) SearchBanAppeal(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchBanAppeal, o.adminClient)
+}
+
+func (o *Api) CommentBanAppeal(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.CommentBanAppeal, o.adminClient)
+}
+
+func (o *Api) ReviewBanAppeal(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.ReviewBanAppeal, o.adminClient)
+}
+
+func (o *Api) SyncLDAP(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SyncLDAP, o.adminClient)
+}
+
 func (o *Api) SetClientConfig(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.SetClientConfig, o.adminClient)
 }
@@ -376,6 +507,10 @@ func (o *Api) SearchApplet(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.SearchApplet, o.adminClient)
 }
 
+func (o *Api) SetAppletClient(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SetAppletClient, o.adminClient)
+}
+
 func (o *Api) LoginUserCount(c *gin.Context) {
 	a2r.Call(c, chat.ChatClient.UserLoginCount, o.chatClient)
 }
@@ -429,14 +564,7 @@ func (o *Api) ImportUserByXlsx(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-
-	ctx := o.WithAdminUser(mctx.WithApiToken(c, imToken))
-	apiresp.GinError(c, o.registerChatUser(ctx, ip, us))
+	apiresp.GinError(c, o.registerChatUser(c, ip, us))
 }
 
 func (o *Api) ImportUserByJson(c *gin.Context) {
@@ -452,17 +580,12 @@ func (o *Api) ImportUserByJson(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-	ctx := o.WithAdminUser(mctx.WithApiToken(c, imToken))
-	apiresp.GinError(c, o.registerChatUser(ctx, ip, req.Users))
+	apiresp.GinError(c, o.registerChatUser(c, ip, req.Users))
 }
 
 func (o *Api) xlsx2user(users []model.User) ([]*chat.RegisterUserInfo, error) {
 	chatUsers := make([]*chat.RegisterUserInfo, len(users))
+	phones := make(map[string]int, len(users)) // phone -> row
 	for i, info := range users {
 		if info.Nickname == "" {
 			return nil, errs.ErrArgs.WrapMsg("nickname is empty")
@@ -473,12 +596,16 @@ func (o *Api) xlsx2user(users []model.User) ([]*chat.RegisterUserInfo, error) {
 		if info.Password == "" {
 			return nil, errs.ErrArgs.WrapMsg("password is empty")
 		}
-		if !strings.HasPrefix(info.AreaCode, "+") {
-			return nil, errs.ErrArgs.WrapMsg("areaCode format error")
+		// checked for every row before any user is registered
+		areaCode, phoneNumber, err := phonenumber.Normalize(info.AreaCode, info.PhoneNumber)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid phone number", "row", i+2)
 		}
-		if _, err := strconv.ParseUint(info.AreaCode[1:], 10, 16); err != nil {
-			return nil, errs.ErrArgs.WrapMsg("areaCode format error")
+		phone := areaCode + " " + phoneNumber
+		if row, ok := phones[phone]; ok {
+			return nil, errs.ErrArgs.WrapMsg("duplicate phone number", "row", i+2, "duplicateRow", row)
 		}
+		phones[phone] = i + 2
 		gender, _ := strconv.Atoi(info.Gender)
 		chatUsers[i] = &chat.RegisterUserInfo{
 			UserID:      info.UserID,
@@ -486,8 +613,8 @@ func (o *Api) xlsx2user(users []model.User) ([]*chat.RegisterUserInfo, error) {
 			FaceURL:     info.FaceURL,
 			Birth:       o.xlsxBirth(info.Birth).UnixMilli(),
 			Gender:      int32(gender),
-			AreaCode:    info.AreaCode,
-			PhoneNumber: info.PhoneNumber,
+			AreaCode:    areaCode,
+			PhoneNumber: phoneNumber,
 			Email:       info.Email,
 			Account:     info.Account,
 			Password:    encrypt.Md5(info.Password),
@@ -521,10 +648,17 @@ func (o *Api) xlsxBirth(s string) time.Time {
 	return t
 }
 
-func (o *Api) registerChatUser(ctx context.Context, ip string, users []*chat.RegisterUserInfo) error {
+// registerChatUser registers the users as the chat admin and records them in the audit log as added
+// by the admin of c.
+func (o *Api) registerChatUser(c *gin.Context, ip string, users []*chat.RegisterUserInfo) error {
 	if len(users) == 0 {
 		return errs.ErrArgs.WrapMsg("users is empty")
 	}
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	if err != nil {
+		return err
+	}
+	ctx := o.WithAdminUser(mctx.WithApiToken(c, imToken))
 	for _, info := range users {
 		respRegisterUser, err := o.chatClient.RegisterUser(ctx, &chat.RegisterUserReq{Ip: ip, User: info, Platform: constant.AdminPlatformID})
 		if err != nil {
@@ -538,6 +672,7 @@ func (o *Api) registerChatUser(ctx context.Context, ip string, users []*chat.Reg
 		if err = o.imApiCaller.RegisterUser(ctx, []*sdkws.UserInfo{userInfo}); err != nil {
 			return err
 		}
+		util.Audit(c, o.adminClient, chatconstant.AuditUserAdd, respRegisterUser.UserID, nil, userInfo)
 
 		if resp, err := o.adminClient.FindDefaultFriend(ctx, &admin.FindDefaultFriendReq{}); err == nil {
 			_ = o.imApiCaller.ImportFriend(ctx, respRegisterUser.UserID, resp.UserIDs)
@@ -565,7 +700,23 @@ func (o *Api) BatchImportTemplate(c *gin.Context) {
 }
 
 func (o *Api) SetAllowRegister(c *gin.Context) {
-	a2r.Call(c, chat.ChatClient.SetAllowRegister, o.chatClient)
+	req, err := a2r.ParseRequest[chat.SetAllowRegisterReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	before, err := o.chatClient.GetAllowRegister(c, &chat.GetAllowRegisterReq{})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.chatClient.SetAllowRegister(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	util.Audit(c, o.adminClient, chatconstant.AuditAllowRegisterSet, "", before, req)
+	apiresp.GinSuccess(c, resp)
 }
 
 func (o *Api) GetAllowRegister(c *gin.Context) {
@@ -591,3 +742,112 @@ func (o *Api) UpdateApplicationVersion(c *gin.Context) {
 func (o *Api) DeleteApplicationVersion(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.DeleteApplicationVersion, o.adminClient)
 }
+
+func (o *Api) SetupTwoFactor(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SetupTwoFactor, o.adminClient)
+}
+
+func (o *Api) ConfirmTwoFactor(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.ConfirmTwoFactor, o.adminClient)
+}
+
+func (o *Api) DisableTwoFactor(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.DisableTwoFactor, o.adminClient)
+}
+
+func (o *Api) RegenerateRecoveryCodes(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.RegenerateRecoveryCodes, o.adminClient)
+}
+
+func (o *Api) GetTwoFactor(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.GetTwoFactor, o.adminClient)
+}
+
+func (o *Api) SetTwoFactorRequired(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SetTwoFactorRequired, o.adminClient)
+}
+
+func (o *Api) GetUserTwoFactor(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.GetTwoFactor, o.chatClient)
+}
+
+func (o *Api) ResetUserTwoFactor(c *gin.Context) {
+	req, err := a2r.ParseRequest[chat.DisableTwoFactorReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.chatClient.DisableTwoFactor(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	util.Audit(c, o.adminClient, chatconstant.AuditUserTwoFactorReset, req.UserID, nil, nil)
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) SearchAuditLog(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchAuditLog, o.adminClient)
+}
+
+// auditLogExportPageSize is the number of audit logs read from admin-rpc at a time during export.
+const auditLogExportPageSize = 500
+
+// ExportAuditLog writes the audit logs matching the filter as a csv attachment, newest first.
+func (o *Api) ExportAuditLog(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.ExportAuditLogReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	search := &admin.SearchAuditLogReq{
+		UserID:     req.UserID,
+		Operation:  req.Operation,
+		Target:     req.Target,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Pagination: &sdkws.RequestPagination{PageNumber: 1, ShowNumber: auditLogExportPageSize},
+	}
+	if search.EndTime == 0 {
+		// logs added during the export would shift the pages
+		search.EndTime = time.Now().UnixMilli()
+	}
+	if err := search.Check(); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	// the first page is read before the headers are written, so that a failure is still a json error
+	resp, err := o.adminClient.SearchAuditLog(c, search)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename=audit_log_"+time.Now().Format("20060102150405")+".csv")
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{"logID", "time", "userID", "ip", "operation", "target", "before", "after"})
+	for {
+		for _, l := range resp.Logs {
+			_ = w.Write([]string{
+				l.LogID,
+				time.UnixMilli(l.CreateTime).Format(time.RFC3339),
+				l.UserID,
+				l.Ip,
+				l.Operation,
+				l.Target,
+				l.Before,
+				l.After,
+			})
+		}
+		if len(resp.Logs) < auditLogExportPageSize {
+			break
+		}
+		search.Pagination.PageNumber++
+		if resp, err = o.adminClient.SearchAuditLog(c, search); err != nil {
+			log.ZError(c, "export audit log failed", err, "pageNumber", search.Pagination.PageNumber)
+			break
+		}
+	}
+	w.Flush()
+}
@@ -3,14 +3,19 @@ package admin
 import (
 	"encoding/json"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/chat/pkg/common/config"
+	chatconstant "github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/kdisc"
 	"github.com/openimsdk/chat/pkg/common/kdisc/etcd"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/version"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
@@ -26,18 +31,20 @@ const (
 )
 
 type ConfigManager struct {
-	config     *config.AllConfig
-	client     *clientv3.Client
-	configPath string
-	runtimeEnv string
+	config      *config.AllConfig
+	client      *clientv3.Client
+	adminClient admin.AdminClient
+	configPath  string
+	runtimeEnv  string
 }
 
-func NewConfigManager(cfg *config.AllConfig, client *clientv3.Client, configPath string, runtimeEnv string) *ConfigManager {
+func NewConfigManager(cfg *config.AllConfig, client *clientv3.Client, adminClient admin.AdminClient, configPath string, runtimeEnv string) *ConfigManager {
 	return &ConfigManager{
-		config:     cfg,
-		client:     client,
-		configPath: configPath,
-		runtimeEnv: runtimeEnv,
+		config:      cfg,
+		client:      client,
+		adminClient: adminClient,
+		configPath:  configPath,
+		runtimeEnv:  runtimeEnv,
 	}
 }
 
@@ -107,6 +114,7 @@ func (cm *ConfigManager) SetConfig(c *gin.Context) {
 		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
 		return
 	}
+	cm.auditConfig(c, &req)
 	apiresp.GinSuccess(c, nil)
 }
 
@@ -164,7 +172,9 @@ func (cm *ConfigManager) SetConfigs(c *gin.Context) {
 			apiresp.GinError(c, errs.WrapMsg(err, "save to etcd failed"))
 			return
 		}
-
+		for i := range req.Configs {
+			cm.auditConfig(c, &req.Configs[i])
+		}
 	}
 
 	apiresp.GinSuccess(c, nil)
@@ -209,7 +219,97 @@ func compareAndSave[T any](c *gin.Context, old any, req *apistruct.SetConfigReq,
 	return nil
 }
 
+// auditConfig records the values a saved config changes keyed by their json path. Values of the fields
+// holding passwords, secrets, keys and tokens are replaced by redactedValue.
+func (cm *ConfigManager) auditConfig(c *gin.Context, req *apistruct.SetConfigReq) {
+	old := cm.config.Name2Config(req.ConfigName)
+	if old == nil {
+		return
+	}
+	conf := reflect.New(reflect.TypeOf(old))
+	if err := json.Unmarshal([]byte(req.Data), conf.Interface()); err != nil {
+		return
+	}
+	before, after := configDiff(old, conf.Elem().Interface())
+	if len(before) == 0 {
+		return
+	}
+	util.Audit(c, cm.adminClient, chatconstant.AuditConfigSet, req.ConfigName, before, after)
+}
+
+const redactedValue = "******"
+
+// configDiff returns the old and the new values of the leaves that differ between old and new.
+func configDiff(old any, new any) (map[string]any, map[string]any) {
+	before, after := make(map[string]any), make(map[string]any)
+	diffJSON("", jsonValue(old), jsonValue(new), false, before, after)
+	return before, after
+}
+
+func diffJSON(path string, old any, new any, secret bool, before map[string]any, after map[string]any) {
+	oldObject, oldOK := old.(map[string]any)
+	newObject, newOK := new.(map[string]any)
+	if oldOK && newOK {
+		names := make([]string, 0, len(oldObject)+len(newObject))
+		for name := range oldObject {
+			names = append(names, name)
+		}
+		for name := range newObject {
+			if _, ok := oldObject[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			sub := name
+			if path != "" {
+				sub = path + "." + name
+			}
+			diffJSON(sub, oldObject[name], newObject[name], secret || secretField(name), before, after)
+		}
+		return
+	}
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+	if secret {
+		old, new = redact(old), redact(new)
+	}
+	before[path], after[path] = old, new
+}
+
+// secretField reports whether a config field holds a password, a secret, a key or a token.
+func secretField(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range []string{"password", "secret", "key", "token", "authorizationcode"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(v any) any {
+	if v == nil {
+		return nil
+	}
+	return redactedValue
+}
+
+func jsonValue(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return value
+}
+
 func (cm *ConfigManager) ResetConfig(c *gin.Context) {
+	util.Audit(c, cm.adminClient, chatconstant.AuditConfigReset, "", nil, nil)
 	go func() {
 		if err := cm.resetConfig(c, true); err != nil {
 			log.ZError(c, "reset config err", err)
@@ -274,6 +374,7 @@ func (cm *ConfigManager) resetConfig(c *gin.Context, checkChange bool, ops ...cl
 }
 
 func (cm *ConfigManager) Restart(c *gin.Context) {
+	util.Audit(c, cm.adminClient, chatconstant.AuditServiceRestart, "", nil, nil)
 	go cm.restart(c)
 	apiresp.GinSuccess(c, nil)
 }
@@ -304,7 +405,8 @@ func (cm *ConfigManager) SetEnableConfigManager(c *gin.Context) {
 		apiresp.GinError(c, errs.WrapMsg(err, "getEnableConfigManager failed"))
 		return
 	}
-	if !(resp.Count > 0 && string(resp.Kvs[0].Value) == etcd.Enable) && req.Enable {
+	enabled := resp.Count > 0 && string(resp.Kvs[0].Value) == etcd.Enable
+	if !enabled && req.Enable {
 		go func() {
 			time.Sleep(waitHttp) // wait for Restart http call return
 			err := cm.resetConfig(c, false, clientv3.OpPut(etcd.BuildKey(etcd.EnableConfigCenterKey), enableStr))
@@ -319,7 +421,7 @@ func (cm *ConfigManager) SetEnableConfigManager(c *gin.Context) {
 			return
 		}
 	}
-
+	util.Audit(c, cm.adminClient, chatconstant.AuditConfigManagerSet, "", &apistruct.GetEnableConfigManagerResp{Enable: enabled}, &req)
 	apiresp.GinSuccess(c, nil)
 }
 
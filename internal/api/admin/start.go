@@ -14,6 +14,7 @@ import (
 	chatmw "github.com/openimsdk/chat/internal/api/mw"
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/kdisc"
 	disetcd "github.com/openimsdk/chat/pkg/common/kdisc/etcd"
@@ -70,7 +71,7 @@ func Start(ctx context.Context, index int, config *Config) error {
 		ChatAdminUserID: config.Share.ChatAdmin[0],
 	}
 	adminApi := New(chatClient, adminClient, im, &base)
-	mwApi := chatmw.New(adminClient)
+	mwApi := chatmw.New(adminClient, &base)
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
 	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
@@ -120,27 +121,54 @@ func Start(ctx context.Context, index int, config *Config) error {
 
 func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW, cfg *Config, client discovery.SvcDiscoveryRegistry) {
 
+	userRead, userWrite := mw.CheckPermission(constant.PermissionUserRead), mw.CheckPermission(constant.PermissionUserWrite)
+	configRead, configWrite := mw.CheckPermission(constant.PermissionConfigRead), mw.CheckPermission(constant.PermissionConfigWrite)
+
 	adminRouterGroup := router.Group("/account")
-	adminRouterGroup.POST("/login", admin.AdminLogin)                                   // Login
-	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo)              // Modify information
-	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)                      // Get information
-	adminRouterGroup.POST("/change_password", mw.CheckAdmin, admin.ChangeAdminPassword) // Change admin account's password
-	adminRouterGroup.POST("/add_admin", mw.CheckAdmin, admin.AddAdminAccount)           // Add admin account
-	adminRouterGroup.POST("/add_user", mw.CheckAdmin, admin.AddUserAccount)             // Add user account
-	adminRouterGroup.POST("/del_admin", mw.CheckAdmin, admin.DelAdminAccount)           // Delete admin
-	adminRouterGroup.POST("/search", mw.CheckAdmin, admin.SearchAdminAccount)           // Get admin list
+	adminRouterGroup.POST("/login", admin.AdminLogin)                                        // Login
+	adminRouterGroup.POST("/token/refresh", admin.RefreshToken)                              // Exchange refresh token for a new token
+	adminRouterGroup.POST("/session/list", mw.CheckAdmin, admin.GetSessions)                 // Get own login sessions
+	adminRouterGroup.POST("/session/revoke", mw.CheckAdmin, admin.RevokeSession)             // Log out one session
+	adminRouterGroup.POST("/update", mw.CheckAdminToken, admin.AdminUpdateInfo)              // Modify information
+	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)                           // Get information
+	adminRouterGroup.POST("/change_password", mw.CheckAdminToken, admin.ChangeAdminPassword) // Change admin account's password
+	adminRouterGroup.POST("/add_admin", mw.CheckAdminToken, admin.AddAdminAccount)           // Add admin account
+	adminRouterGroup.POST("/add_user", userWrite, admin.AddUserAccount)                      // Add user account
+	adminRouterGroup.POST("/del_admin", mw.CheckAdminToken, admin.DelAdminAccount)           // Delete admin
+	adminRouterGroup.POST("/search", mw.CheckAdmin, admin.SearchAdminAccount)                // Get admin list
+	adminRouterGroup.POST("/set_role", mw.CheckAdminToken, admin.SetAdminRole)               // Replace the roles of an admin
 	//account.POST("/add_notification_account")
 
+	apiKeyRouter := adminRouterGroup.Group("/api_key", mw.CheckAdminToken)
+	apiKeyRouter.POST("/add", admin.AddAPIKey)       // Create an API key for automation, sent as "Authorization: Bearer <key>"
+	apiKeyRouter.POST("/search", admin.SearchAPIKey) // Search API keys
+	apiKeyRouter.POST("/del", admin.DelAPIKey)       // Revoke API keys
+
+	roleRouter := router.Group("/role", mw.CheckAdminToken)
+	roleRouter.POST("/add", admin.AddRole)                // Add a role with permissions
+	roleRouter.POST("/update", admin.UpdateRole)          // Modify the name and permissions of a role
+	roleRouter.POST("/del", admin.DelRole)                // Delete roles, removing them from admins
+	roleRouter.POST("/search", admin.SearchRole)          // Search roles
+	roleRouter.POST("/permissions", admin.GetPermissions) // Get all permissions
+
+	twoFactorRouter := adminRouterGroup.Group("/2fa", mw.CheckAdminToken)
+	twoFactorRouter.POST("/setup", admin.SetupTwoFactor)                   // Generate TOTP secret
+	twoFactorRouter.POST("/confirm", admin.ConfirmTwoFactor)               // Enable two-factor authentication with the first code
+	twoFactorRouter.POST("/disable", admin.DisableTwoFactor)               // Disable own, or reset another admin's two-factor authentication
+	twoFactorRouter.POST("/recovery_codes", admin.RegenerateRecoveryCodes) // Regenerate recovery codes
+	twoFactorRouter.POST("/get", admin.GetTwoFactor)                       // Get two-factor authentication status
+	twoFactorRouter.POST("/set_required", admin.SetTwoFactorRequired)      // Require two-factor authentication for an admin
+
 	importGroup := router.Group("/user/import")
-	importGroup.POST("/json", mw.CheckAdmin, admin.ImportUserByJson)
-	importGroup.POST("/xlsx", mw.CheckAdmin, admin.ImportUserByXlsx)
+	importGroup.POST("/json", userWrite, admin.ImportUserByJson)
+	importGroup.POST("/xlsx", userWrite, admin.ImportUserByXlsx)
 	importGroup.GET("/xlsx", admin.BatchImportTemplate)
 
-	allowRegisterGroup := router.Group("/user/allow_register", mw.CheckAdmin)
+	allowRegisterGroup := router.Group("/user/allow_register", mw.CheckPermission(constant.PermissionRegisterManage))
 	allowRegisterGroup.POST("/get", admin.GetAllowRegister)
 	allowRegisterGroup.POST("/set", admin.SetAllowRegister)
 
-	defaultRouter := router.Group("/default", mw.CheckAdmin)
+	defaultRouter := router.Group("/default", mw.CheckPermission(constant.PermissionRegisterManage))
 	defaultUserRouter := defaultRouter.Group("/user")
 	defaultUserRouter.POST("/add", admin.AddDefaultFriend)       // Add default friend at registration
 	defaultUserRouter.POST("/del", admin.DelDefaultFriend)       // Delete default friend at registration
@@ -152,13 +180,13 @@ func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW, cfg *Config, c
 	defaultGroupRouter.POST("/find", admin.FindDefaultGroup)     // Get default group list at registration
 	defaultGroupRouter.POST("/search", admin.SearchDefaultGroup) // Search default group list at registration
 
-	invitationCodeRouter := router.Group("/invitation_code", mw.CheckAdmin)
+	invitationCodeRouter := router.Group("/invitation_code", mw.CheckPermission(constant.PermissionRegisterManage))
 	invitationCodeRouter.POST("/add", admin.AddInvitationCode)       // Add invitation code
 	invitationCodeRouter.POST("/gen", admin.GenInvitationCode)       // Generate invitation code
 	invitationCodeRouter.POST("/del", admin.DelInvitationCode)       // Delete invitation code
 	invitationCodeRouter.POST("/search", admin.SearchInvitationCode) // Search invitation code
 
-	forbiddenRouter := router.Group("/forbidden", mw.CheckAdmin)
+	forbiddenRouter := router.Group("/forbidden", mw.CheckPermission(constant.PermissionSecurityManage))
 	ipForbiddenRouter := forbiddenRouter.Group("/ip")
 	ipForbiddenRouter.POST("/add", admin.AddIPForbidden)       // Add forbidden IP for registration/login
 	ipForbiddenRouter.POST("/del", admin.DelIPForbidden)       // Delete forbidden IP for registration/login
@@ -168,33 +196,58 @@ func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW, cfg *Config, c
 	userForbiddenRouter.POST("/del", admin.DelUserIPLimitLogin)       // Delete user limit on specific IP for login
 	userForbiddenRouter.POST("/search", admin.SearchUserIPLimitLogin) // Search limit for user login on specific IP
 
-	appletRouterGroup := router.Group("/applet", mw.CheckAdmin)
-	appletRouterGroup.POST("/add", admin.AddApplet)       // Add applet
-	appletRouterGroup.POST("/del", admin.DelApplet)       // Delete applet
-	appletRouterGroup.POST("/update", admin.UpdateApplet) // Modify applet
-	appletRouterGroup.POST("/search", admin.SearchApplet) // Search applet
+	testAccountRouter := router.Group("/test_account", mw.CheckPermission(constant.PermissionSecurityManage))
+	testAccountRouter.POST("/add", admin.AddTestAccount)       // Add or update test accounts logging in with a fixed verify code
+	testAccountRouter.POST("/del", admin.DelTestAccount)       // Delete test accounts
+	testAccountRouter.POST("/search", admin.SearchTestAccount) // Search test accounts
+
+	loginLockRouter := router.Group("/login_lock", mw.CheckPermission(constant.PermissionSecurityManage))
+	loginLockRouter.POST("/search", admin.SearchLoginLock) // Search accounts and IPs locked after failed logins
+	loginLockRouter.POST("/get", admin.GetLoginLock)       // Get lock status of an account or IP
+	loginLockRouter.POST("/clear", admin.ClearLoginLock)   // Unlock an account or IP and forget its failed logins
+
+	appletRouterGroup := router.Group("/applet", mw.CheckPermission(constant.PermissionAppletManage))
+	appletRouterGroup.POST("/add", admin.AddApplet)          // Add applet
+	appletRouterGroup.POST("/del", admin.DelApplet)          // Delete applet
+	appletRouterGroup.POST("/update", admin.UpdateApplet)    // Modify applet
+	appletRouterGroup.POST("/search", admin.SearchApplet)    // Search applet
+	appletRouterGroup.POST("/client", admin.SetAppletClient) // Set the redirect URIs and secret of the applet as OpenID Connect client
+
+	blockRouter := router.Group("/block", mw.CheckPermission(constant.PermissionUserBlock))
+	blockRouter.POST("/add", admin.BlockUser)                   // Block user
+	blockRouter.POST("/del", admin.UnblockUser)                 // Unblock user
+	blockRouter.POST("/search", admin.SearchBlockUser)          // Search blocked users
+	blockRouter.POST("/appeal/search", admin.SearchBanAppeal)   // Search the appeals of blocked users
+	blockRouter.POST("/appeal/comment", admin.CommentBanAppeal) // Comment on an appeal, comments are not shown to the user
+	blockRouter.POST("/appeal/review", admin.ReviewBanAppeal)   // Approve or reject an appeal, approving unblocks the user
+
+	ldapRouter := router.Group("/ldap", userWrite)
+	ldapRouter.POST("/sync", admin.SyncLDAP) // Sync users from the directory groups now
 
-	blockRouter := router.Group("/block", mw.CheckAdmin)
-	blockRouter.POST("/add", admin.BlockUser)          // Block user
-	blockRouter.POST("/del", admin.UnblockUser)        // Unblock user
-	blockRouter.POST("/search", admin.SearchBlockUser) // Search blocked users
+	userRouter := router.Group("/user")
+	userRouter.POST("/password/reset", userWrite, admin.ResetUserPassword) // Reset user password
+	userRouter.POST("/2fa/get", userRead, admin.GetUserTwoFactor)          // Get user two-factor authentication status
+	userRouter.POST("/2fa/reset", userWrite, admin.ResetUserTwoFactor)     // Reset user two-factor authentication
+	userRouter.POST("/session/list", userRead, admin.GetSessions)          // Get user login sessions
+	userRouter.POST("/session/revoke", userWrite, admin.RevokeSession)     // Log out one user session
 
-	userRouter := router.Group("/user", mw.CheckAdmin)
-	userRouter.POST("/password/reset", admin.ResetUserPassword) // Reset user password
+	initGroup := router.Group("/client_config")
+	initGroup.POST("/get", configRead, admin.GetClientConfig)  // Get client initialization configuration
+	initGroup.POST("/set", configWrite, admin.SetClientConfig) // Set client initialization configuration
+	initGroup.POST("/del", configWrite, admin.DelClientConfig) // Delete client initialization configuration
 
-	initGroup := router.Group("/client_config", mw.CheckAdmin)
-	initGroup.POST("/get", admin.GetClientConfig) // Get client initialization configuration
-	initGroup.POST("/set", admin.SetClientConfig) // Set client initialization configuration
-	initGroup.POST("/del", admin.DelClientConfig) // Delete client initialization configuration
+	auditLogRouter := router.Group("/audit_log", mw.CheckPermission(constant.PermissionAuditRead))
+	auditLogRouter.POST("/search", admin.SearchAuditLog) // Search operations done by admins
+	auditLogRouter.POST("/export", admin.ExportAuditLog) // Export the searched operations as csv
 
-	statistic := router.Group("/statistic", mw.CheckAdmin)
+	statistic := router.Group("/statistic", mw.CheckPermission(constant.PermissionStatisticRead))
 	statistic.POST("/new_user_count", admin.NewUserCount)
 	statistic.POST("/login_user_count", admin.LoginUserCount)
 
 	applicationGroup := router.Group("application")
-	applicationGroup.POST("/add_version", mw.CheckAdmin, admin.AddApplicationVersion)
-	applicationGroup.POST("/update_version", mw.CheckAdmin, admin.UpdateApplicationVersion)
-	applicationGroup.POST("/delete_version", mw.CheckAdmin, admin.DeleteApplicationVersion)
+	applicationGroup.POST("/add_version", mw.CheckPermission(constant.PermissionApplicationManage), admin.AddApplicationVersion)
+	applicationGroup.POST("/update_version", mw.CheckPermission(constant.PermissionApplicationManage), admin.UpdateApplicationVersion)
+	applicationGroup.POST("/delete_version", mw.CheckPermission(constant.PermissionApplicationManage), admin.DeleteApplicationVersion)
 	applicationGroup.POST("/latest_version", admin.LatestApplicationVersion)
 	applicationGroup.POST("/page_versions", admin.PageApplicationVersion)
 
@@ -202,18 +255,18 @@ func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW, cfg *Config, c
 	if cfg.Discovery.Enable == kdisc.ETCDCONST {
 		etcdClient = client.(*etcd.SvcDiscoveryRegistryImpl).GetClient()
 	}
-	cm := NewConfigManager(cfg.AllConfig, etcdClient, cfg.ConfigPath, cfg.RuntimeEnv)
+	cm := NewConfigManager(cfg.AllConfig, etcdClient, admin.adminClient, cfg.ConfigPath, cfg.RuntimeEnv)
 	{
-		configGroup := router.Group("/config", mw.CheckAdmin)
-		configGroup.POST("/get_config_list", cm.GetConfigList)
-		configGroup.POST("/get_config", cm.GetConfig)
-		configGroup.POST("/set_config", cm.SetConfig)
-		configGroup.POST("/set_configs", cm.SetConfigs)
-		configGroup.POST("/reset_config", cm.ResetConfig)
-		configGroup.POST("/get_enable_config_manager", cm.GetEnableConfigManager)
-		configGroup.POST("/set_enable_config_manager", cm.SetEnableConfigManager)
+		configGroup := router.Group("/config")
+		configGroup.POST("/get_config_list", configRead, cm.GetConfigList)
+		configGroup.POST("/get_config", configRead, cm.GetConfig)
+		configGroup.POST("/set_config", configWrite, cm.SetConfig)
+		configGroup.POST("/set_configs", configWrite, cm.SetConfigs)
+		configGroup.POST("/reset_config", configWrite, cm.ResetConfig)
+		configGroup.POST("/get_enable_config_manager", configRead, cm.GetEnableConfigManager)
+		configGroup.POST("/set_enable_config_manager", configWrite, cm.SetEnableConfigManager)
 	}
 	{
-		router.POST("/restart", mw.CheckAdmin, cm.Restart)
+		router.POST("/restart", mw.CheckPermission(constant.PermissionServiceRestart), cm.Restart)
 	}
 }
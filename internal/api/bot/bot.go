@@ -8,37 +8,123 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/botstruct"
+	chatconstant "github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imwebhook"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/bot"
 	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/a2r"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
-func New(botClient bot.BotClient, api *util.Api) *Api {
+func New(botClient bot.BotClient, adminClient admin.AdminClient, api *util.Api) *Api {
 	return &Api{
-		Api:       api,
-		botClient: botClient,
+		Api:         api,
+		botClient:   botClient,
+		adminClient: adminClient,
 	}
 }
 
 type Api struct {
 	*util.Api
-	botClient bot.BotClient
+	botClient   bot.BotClient
+	adminClient admin.AdminClient
 }
 
 func (o *Api) CreateAgent(c *gin.Context) {
-	a2r.Call(c, bot.BotClient.CreateAgent, o.botClient)
+	req, err := a2r.ParseRequest[bot.CreateAgentReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.botClient.CreateAgent(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if req.Agent != nil {
+		util.Audit(c, o.adminClient, chatconstant.AuditAgentCreate, req.Agent.UserID, nil, auditAgent(req.Agent))
+	}
+	apiresp.GinSuccess(c, resp)
 }
 
 func (o *Api) DeleteAgent(c *gin.Context) {
-	a2r.Call(c, bot.BotClient.DeleteAgent, o.botClient)
+	req, err := a2r.ParseRequest[bot.DeleteAgentReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	agents, err := o.findAgents(c, req.UserIDs)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.botClient.DeleteAgent(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	for _, agent := range agents {
+		util.Audit(c, o.adminClient, chatconstant.AuditAgentDelete, agent.UserID, auditAgent(agent), nil)
+	}
+	apiresp.GinSuccess(c, resp)
 }
 
 func (o *Api) UpdateAgent(c *gin.Context) {
-	a2r.Call(c, bot.BotClient.UpdateAgent, o.botClient)
+	req, err := a2r.ParseRequest[bot.UpdateAgentReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	agents, err := o.findAgents(c, []string{req.UserID})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.botClient.UpdateAgent(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	after := proto.Clone(req).(*bot.UpdateAgentReq)
+	if after.Key != nil {
+		after.Key = proto.String(auditSecret)
+	}
+	var before *bot.Agent
+	if len(agents) > 0 {
+		before = auditAgent(agents[0])
+	}
+	util.Audit(c, o.adminClient, chatconstant.AuditAgentUpdate, req.UserID, before, after)
+	apiresp.GinSuccess(c, resp)
+}
+
+// auditSecret stands in the audit log for the model key of an agent.
+const auditSecret = "******"
+
+func auditAgent(agent *bot.Agent) *bot.Agent {
+	c := proto.Clone(agent).(*bot.Agent)
+	if c.Key != "" {
+		c.Key = auditSecret
+	}
+	return c
+}
+
+func (o *Api) findAgents(c *gin.Context, userIDs []string) ([]*bot.Agent, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	resp, err := o.botClient.PageFindAgent(c, &bot.PageFindAgentReq{
+		Pagination: &sdkws.RequestPagination{PageNumber: 1, ShowNumber: int32(len(userIDs))},
+		UserIDs:    userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Agents, nil
 }
 
 func (o *Api) PageFindAgent(c *gin.Context) {
@@ -14,6 +14,7 @@ import (
 	chatmw "github.com/openimsdk/chat/internal/api/mw"
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/kdisc"
 	disetcd "github.com/openimsdk/chat/pkg/common/kdisc/etcd"
 	adminclient "github.com/openimsdk/chat/pkg/protocol/admin"
@@ -63,8 +64,8 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 		ProxyHeader:     cfg.Share.ProxyHeader,
 		ChatAdminUserID: cfg.Share.ChatAdmin[0],
 	}
-	botApi := New(botClient, &base)
-	mwApi := chatmw.New(adminClient)
+	botApi := New(botClient, adminClient, &base)
+	mwApi := chatmw.New(adminClient, &base)
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
 	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
@@ -121,9 +122,9 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 
 func SetBotRoute(router gin.IRouter, bot *Api, mw *chatmw.MW) {
 	account := router.Group("/agent")
-	account.POST("/create", mw.CheckAdmin, bot.CreateAgent)
-	account.POST("/delete", mw.CheckAdmin, bot.DeleteAgent)
-	account.POST("/update", mw.CheckAdmin, bot.UpdateAgent)
+	account.POST("/create", mw.CheckPermission(constant.PermissionAgentManage), bot.CreateAgent)
+	account.POST("/delete", mw.CheckPermission(constant.PermissionAgentManage), bot.DeleteAgent)
+	account.POST("/update", mw.CheckPermission(constant.PermissionAgentManage), bot.UpdateAgent)
 	account.POST("/page", mw.CheckToken, bot.PageFindAgent)
 
 	imwebhook := router.Group("/im_callback")
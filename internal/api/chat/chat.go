@@ -15,17 +15,25 @@
 package chat
 
 import (
+	"context"
 	"io"
+	"net/http"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/openimsdk/chat/internal/api/util"
 
 	"github.com/gin-gonic/gin"
 	"github.com/openimsdk/chat/pkg/common/apistruct"
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/userexport"
 	constantpb "github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/a2r"
@@ -48,6 +56,8 @@ type Api struct {
 	chatClient  chatpb.ChatClient
 	adminClient admin.AdminClient
 	imApiCaller imapi.CallerInterface
+	oidcIssuer  string
+	userExport  config.UserExport
 }
 
 // ################## ACCOUNT ##################
@@ -64,6 +74,9 @@ func (o *Api) SendVerifyCode(c *gin.Context) {
 		return
 	}
 	req.Ip = ip
+	if req.Language == "" {
+		req.Language = c.GetHeader("Accept-Language")
+	}
 	resp, err := o.chatClient.SendVerifyCode(c, req)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -72,6 +85,54 @@ func (o *Api) SendVerifyCode(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *Api) RequestContactChange(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.RequestContactChangeReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if req.Language == "" {
+		req.Language = c.GetHeader("Accept-Language")
+	}
+	resp, err := o.chatClient.RequestContactChange(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) ConfirmContactChange(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.ConfirmContactChangeReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if req.Language == "" {
+		req.Language = c.GetHeader("Accept-Language")
+	}
+	resp, err := o.chatClient.ConfirmContactChange(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) GetCaptcha(c *gin.Context) {
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.GetCaptcha(c, &admin.GetCaptchaReq{Ip: ip})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
 func (o *Api) VerifyCode(c *gin.Context) {
 	a2r.Call(c, chatpb.ChatClient.VerifyCode, o.chatClient)
 }
@@ -125,24 +186,10 @@ func (o *Api) RegisterUser(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	userInfo := &sdkws.UserInfo{
-		UserID:     respRegisterUser.UserID,
-		Nickname:   req.User.Nickname,
-		FaceURL:    req.User.FaceURL,
-		CreateTime: time.Now().UnixMilli(),
-	}
-	err = o.imApiCaller.RegisterUser(apiCtx, []*sdkws.UserInfo{userInfo})
-	if err != nil {
+	if err := o.registerIMUser(c, apiCtx, respRegisterUser.UserID, req.User.Nickname, req.User.FaceURL); err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
-
-	if resp, err := o.adminClient.FindDefaultFriend(rpcCtx, &admin.FindDefaultFriendReq{}); err == nil {
-		_ = o.imApiCaller.ImportFriend(apiCtx, respRegisterUser.UserID, resp.UserIDs)
-	}
-	if resp, err := o.adminClient.FindDefaultGroup(rpcCtx, &admin.FindDefaultGroupReq{}); err == nil {
-		_ = o.imApiCaller.InviteToGroup(apiCtx, respRegisterUser.UserID, resp.GroupIDs)
-	}
 	var resp apistruct.UserRegisterResp
 	if req.AutoLogin {
 		resp.ImToken, err = o.imApiCaller.GetUserToken(apiCtx, respRegisterUser.UserID, req.Platform)
@@ -152,10 +199,33 @@ func (o *Api) RegisterUser(c *gin.Context) {
 		}
 	}
 	resp.ChatToken = respRegisterUser.ChatToken
+	resp.RefreshToken = respRegisterUser.RefreshToken
+	resp.Expire = respRegisterUser.Expire
 	resp.UserID = respRegisterUser.UserID
 	apiresp.GinSuccess(c, &resp)
 }
 
+// registerIMUser registers a new chat user at the im server, with the default friends and groups.
+func (o *Api) registerIMUser(c *gin.Context, apiCtx context.Context, userID string, nickname string, faceURL string) error {
+	userInfo := &sdkws.UserInfo{
+		UserID:     userID,
+		Nickname:   nickname,
+		FaceURL:    faceURL,
+		CreateTime: time.Now().UnixMilli(),
+	}
+	if err := o.imApiCaller.RegisterUser(apiCtx, []*sdkws.UserInfo{userInfo}); err != nil {
+		return err
+	}
+	rpcCtx := o.WithAdminUser(c)
+	if resp, err := o.adminClient.FindDefaultFriend(rpcCtx, &admin.FindDefaultFriendReq{}); err == nil {
+		_ = o.imApiCaller.ImportFriend(apiCtx, userID, resp.UserIDs)
+	}
+	if resp, err := o.adminClient.FindDefaultGroup(rpcCtx, &admin.FindDefaultGroupReq{}); err == nil {
+		_ = o.imApiCaller.InviteToGroup(apiCtx, userID, resp.GroupIDs)
+	}
+	return nil
+}
+
 func (o *Api) Login(c *gin.Context) {
 	req, err := a2r.ParseRequest[chatpb.LoginReq](c)
 	if err != nil {
@@ -173,6 +243,51 @@ func (o *Api) Login(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
+	o.loginSuccess(c, resp, req.Platform)
+}
+
+func (o *Api) LoginByLink(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.LoginByLinkReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.LoginByLink(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	o.loginSuccess(c, resp, req.Platform)
+}
+
+func (o *Api) PasskeyLogin(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.PasskeyLoginReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.PasskeyLogin(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	o.loginSuccess(c, resp, req.Platform)
+}
+
+// loginSuccess responds a chat login with the im token of the user.
+func (o *Api) loginSuccess(c *gin.Context, resp *chatpb.LoginResp, platform int32) {
 	adminToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -180,22 +295,84 @@ func (o *Api) Login(c *gin.Context) {
 	}
 	apiCtx := mctx.WithApiToken(c, adminToken)
 
-	imToken, err := o.imApiCaller.GetUserToken(apiCtx, resp.UserID, req.Platform)
+	imToken, err := o.imApiCaller.GetUserToken(apiCtx, resp.UserID, platform)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
 	apiresp.GinSuccess(c, &apistruct.LoginResp{
-		ImToken:   imToken,
-		UserID:    resp.UserID,
-		ChatToken: resp.ChatToken,
+		ImToken:           imToken,
+		UserID:            resp.UserID,
+		ChatToken:         resp.ChatToken,
+		RefreshToken:      resp.RefreshToken,
+		Expire:            resp.Expire,
+		PasswordChanged:   resp.PasswordChanged,
+		DeletionCancelled: resp.DeletionCancelled,
 	})
 }
 
+func (o *Api) RefreshToken(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.RefreshTokenReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.UserType = constant.NormalUser
+	if req.Ip, err = o.GetClientIP(c); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RefreshToken(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+// JWKS serves the token verification keys as a plain JSON Web Key Set, not wrapped in the api response.
+func (o *Api) JWKS(c *gin.Context) {
+	if c.GetString(constantpb.OperationID) == "" {
+		c.Set(constantpb.OperationID, "jwks_"+strconv.FormatInt(time.Now().UnixNano(), 10))
+	}
+	resp, err := o.adminClient.GetJWKS(c, &admin.GetJWKSReq{})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	jwks := tokenverify.JWKS{Keys: make([]tokenverify.JWK, 0, len(resp.Keys))}
+	for _, key := range resp.Keys {
+		jwks.Keys = append(jwks.Keys, tokenverify.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, jwks)
+}
+
 func (o *Api) ResetPassword(c *gin.Context) {
 	a2r.Call(c, chatpb.ChatClient.ResetPassword, o.chatClient)
 }
 
+func (o *Api) SubmitBanAppeal(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.SubmitBanAppeal, o.chatClient)
+}
+
+func (o *Api) GetBanAppeal(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetBanAppeal, o.chatClient)
+}
+
+func (o *Api) CheckPassword(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.CheckPassword, o.chatClient)
+}
+
 func (o *Api) ChangePassword(c *gin.Context) {
 	req, err := a2r.ParseRequest[chatpb.ChangePasswordReq](c)
 	if err != nil {
@@ -221,6 +398,199 @@ func (o *Api) ChangePassword(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *Api) RequestUserDeletion(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.RequestUserDeletionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.chatClient.RequestUserDeletion(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := o.imApiCaller.ForceOffLine(mctx.WithApiToken(c, imToken), mctx.GetOpUserID(c)); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) RequestUserExport(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.RequestUserExport, o.chatClient)
+}
+
+func (o *Api) GetUserExport(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetUserExport, o.chatClient)
+}
+
+// DownloadUserExport serves an archive written by chat-rpc, the signature of the link stands in for a token
+// so the link can be opened in a browser.
+func (o *Api) DownloadUserExport(c *gin.Context) {
+	if !o.userExport.Enable {
+		apiresp.GinError(c, errs.ErrArgs.WrapMsg("user export is not enabled"))
+		return
+	}
+	exportID := c.Query("id")
+	expire, err := strconv.ParseInt(c.Query("expire"), 10, 64)
+	if err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WrapMsg("invalid expire"))
+		return
+	}
+	if err := userexport.Verify(o.userExport.Secret, exportID, expire, c.Query("sign"), time.Now()); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	path, err := userexport.Path(o.userExport.Dir, exportID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if _, err := os.Stat(path); err != nil {
+		apiresp.GinError(c, errs.ErrRecordNotFound.WrapMsg("export not found"))
+		return
+	}
+	c.FileAttachment(path, "personal-data"+userexport.FileExt)
+}
+
+func (o *Api) GetSessions(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.GetSessions, o.adminClient)
+}
+
+func (o *Api) RevokeSession(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.RevokeSessionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RevokeSession(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := o.imApiCaller.ForceOffLinePlatform(mctx.WithApiToken(c, imToken), resp.UserID, resp.PlatformID); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (o *Api) SetupTwoFactor(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.SetupTwoFactor, o.chatClient)
+}
+
+func (o *Api) ConfirmTwoFactor(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.ConfirmTwoFactor, o.chatClient)
+}
+
+func (o *Api) DisableTwoFactor(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.DisableTwoFactor, o.chatClient)
+}
+
+func (o *Api) RegenerateRecoveryCodes(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.RegenerateRecoveryCodes, o.chatClient)
+}
+
+func (o *Api) GetTwoFactor(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetTwoFactor, o.chatClient)
+}
+
+func (o *Api) BeginPasskeyRegistration(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.BeginPasskeyRegistration, o.chatClient)
+}
+
+func (o *Api) FinishPasskeyRegistration(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.FinishPasskeyRegistration, o.chatClient)
+}
+
+func (o *Api) BeginPasskeyLogin(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.BeginPasskeyLogin, o.chatClient)
+}
+
+func (o *Api) GetPasskeys(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetPasskeys, o.chatClient)
+}
+
+func (o *Api) DelPasskey(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.DelPasskey, o.chatClient)
+}
+
+func (o *Api) GetOAuthProviders(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetOAuthProviders, o.chatClient)
+}
+
+func (o *Api) OAuthAuthorize(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.OAuthAuthorize, o.chatClient)
+}
+
+func (o *Api) OAuthLogin(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.OAuthLoginReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.OAuthLogin(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	adminToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiCtx := mctx.WithApiToken(c, adminToken)
+	if resp.Registered {
+		if err := o.registerIMUser(c, apiCtx, resp.UserID, resp.Nickname, resp.FaceURL); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	imToken, err := o.imApiCaller.GetUserToken(apiCtx, resp.UserID, req.Platform)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &apistruct.OAuthLoginResp{
+		LoginResp: apistruct.LoginResp{
+			ImToken:      imToken,
+			UserID:       resp.UserID,
+			ChatToken:    resp.ChatToken,
+			RefreshToken: resp.RefreshToken,
+			Expire:       resp.Expire,
+		},
+		Registered: resp.Registered,
+	})
+}
+
+func (o *Api) LinkOAuth(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.LinkOAuth, o.chatClient)
+}
+
+func (o *Api) UnlinkOAuth(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.UnlinkOAuth, o.chatClient)
+}
+
+func (o *Api) GetOAuthIdentities(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetOAuthIdentities, o.chatClient)
+}
+
 // ################## USER ##################
 
 func (o *Api) UpdateUserInfo(c *gin.Context) {
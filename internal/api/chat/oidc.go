@@ -0,0 +1,133 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// The token, userinfo and discovery endpoints follow OpenID Connect and OAuth 2.0 instead of the api
+// response format, as they are called by the libraries of applets.
+
+func oidcError(c *gin.Context, status int, code string, description string) {
+	c.Header("Cache-Control", "no-store")
+	c.JSON(status, gin.H{"error": code, "error_description": description})
+}
+
+// oidcRPCError maps the errors of the admin rpc: ErrNoPermission for the client, ErrArgs for the grant.
+func oidcRPCError(c *gin.Context, err error) {
+	switch {
+	case errs.ErrNoPermission.Is(err):
+		oidcError(c, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+	case errs.ErrArgs.Is(err):
+		oidcError(c, http.StatusBadRequest, "invalid_grant", "invalid authorization code")
+	default:
+		log.ZError(c, "oidc token failed", err)
+		oidcError(c, http.StatusInternalServerError, "server_error", "")
+	}
+}
+
+func (o *Api) OIDCDiscovery(c *gin.Context) {
+	if o.oidcIssuer == "" {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{
+		"issuer":                                o.oidcIssuer,
+		"authorization_endpoint":                o.oidcIssuer + "/oidc/authorize",
+		"token_endpoint":                        o.oidcIssuer + "/oidc/token",
+		"userinfo_endpoint":                     o.oidcIssuer + "/oidc/userinfo",
+		"jwks_uri":                              o.oidcIssuer + "/.well-known/jwks.json",
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256", "EdDSA"},
+		"scopes_supported":                      []string{"openid", "profile", "email", "phone"},
+		"claims_supported":                      []string{"sub", "name", "preferred_username", "picture", "birthdate", "email", "phone_number"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+// OIDCAuthorize is called by the client of chat with the token of the user, it opens the returned url in the applet.
+func (o *Api) OIDCAuthorize(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.OIDCAuthorize, o.adminClient)
+}
+
+func (o *Api) OIDCToken(c *gin.Context) {
+	if c.PostForm("grant_type") != "authorization_code" {
+		oidcError(c, http.StatusBadRequest, "unsupported_grant_type", "only authorization_code is supported")
+		return
+	}
+	req := &admin.OIDCTokenReq{
+		Code:         c.PostForm("code"),
+		RedirectURI:  c.PostForm("redirect_uri"),
+		CodeVerifier: c.PostForm("code_verifier"),
+	}
+	if clientID, clientSecret, ok := c.Request.BasicAuth(); ok {
+		req.ClientID, req.ClientSecret = clientID, clientSecret
+	} else {
+		req.ClientID, req.ClientSecret = c.PostForm("client_id"), c.PostForm("client_secret")
+	}
+	if req.Code == "" || req.RedirectURI == "" || req.ClientID == "" {
+		oidcError(c, http.StatusBadRequest, "invalid_request", "code, redirect_uri and client_id are required")
+		return
+	}
+	resp, err := o.adminClient.OIDCToken(c, req)
+	if err != nil {
+		oidcRPCError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"access_token": resp.AccessToken,
+		"token_type":   "Bearer",
+		"id_token":     resp.IdToken,
+		"expires_in":   resp.ExpiresIn,
+		"scope":        resp.Scope,
+	})
+}
+
+func (o *Api) OIDCUserInfo(c *gin.Context) {
+	accessToken, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || accessToken == "" {
+		c.Header("WWW-Authenticate", "Bearer")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+	resp, err := o.adminClient.OIDCUserInfo(c, &admin.OIDCUserInfoReq{AccessToken: accessToken})
+	if err != nil {
+		if errs.ErrNoPermission.Is(err) {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			c.Status(http.StatusUnauthorized)
+			return
+		}
+		log.ZError(c, "oidc userinfo failed", err)
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/json", json.RawMessage(resp.Claims))
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -70,10 +71,12 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 		ChatAdminUserID: cfg.Share.ChatAdmin[0],
 	}
 	adminApi := New(chatClient, adminClient, im, &base)
-	mwApi := chatmw.New(adminClient)
+	adminApi.oidcIssuer = strings.TrimSuffix(cfg.Share.OIDCProvider.Issuer, "/")
+	adminApi.userExport = cfg.Share.UserExport
+	mwApi := chatmw.New(adminClient, &base)
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
-	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
+	engine.Use(gin.Recovery(), mw.CorsHandler(), chatmw.ParseOperationID("/oidc/token", "/oidc/userinfo", "/user/export/download"))
 	SetChatRoute(engine, adminApi, mwApi)
 
 	var (
@@ -127,12 +130,43 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 
 func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	account := router.Group("/account")
+	account.POST("/captcha/get", chat.GetCaptcha)                        // Get a challenge for sending codes and registering
 	account.POST("/code/send", chat.SendVerifyCode)                      // Send verification code
 	account.POST("/code/verify", chat.VerifyCode)                        // Verify the verification code
 	account.POST("/register", mw.CheckAdminOrNil, chat.RegisterUser)     // Register
 	account.POST("/login", chat.Login)                                   // Login
+	account.POST("/login/link", chat.LoginByLink)                        // Login with the token of an email login link
+	account.POST("/token/refresh", chat.RefreshToken)                    // Exchange refresh token for a new token
 	account.POST("/password/reset", chat.ResetPassword)                  // Forgot password
 	account.POST("/password/change", mw.CheckToken, chat.ChangePassword) // Change password
+	account.POST("/password/check", chat.CheckPassword)                  // Check a plaintext password against the password policy
+	account.POST("/session/list", mw.CheckToken, chat.GetSessions)       // Get login sessions
+	account.POST("/session/revoke", mw.CheckToken, chat.RevokeSession)   // Log out one session
+	account.POST("/appeal/submit", chat.SubmitBanAppeal)                 // Appeal against the block of the account, proven with a verification code
+	account.POST("/appeal/get", chat.GetBanAppeal)                       // Get the block and the latest appeal of the account
+
+	oauth := account.Group("/oauth")
+	oauth.POST("/providers", chat.GetOAuthProviders)                  // Get configured identity providers
+	oauth.POST("/authorize", chat.OAuthAuthorize)                     // Get the authorization url of a provider
+	oauth.POST("/login", chat.OAuthLogin)                             // Login or register with an authorization code
+	oauth.POST("/link", mw.CheckToken, chat.LinkOAuth)                // Link an identity to the current user
+	oauth.POST("/unlink", mw.CheckToken, chat.UnlinkOAuth)            // Unlink the identity of a provider
+	oauth.POST("/identities", mw.CheckToken, chat.GetOAuthIdentities) // List linked identities
+
+	passkey := account.Group("/passkey")
+	passkey.POST("/register/begin", mw.CheckToken, chat.BeginPasskeyRegistration)   // Get the options to create a passkey
+	passkey.POST("/register/finish", mw.CheckToken, chat.FinishPasskeyRegistration) // Register the created passkey
+	passkey.POST("/login/begin", chat.BeginPasskeyLogin)                            // Get the options to sign in with a passkey
+	passkey.POST("/login", chat.PasskeyLogin)                                       // Login with a passkey
+	passkey.POST("/list", mw.CheckToken, chat.GetPasskeys)                          // List passkeys
+	passkey.POST("/del", mw.CheckToken, chat.DelPasskey)                            // Remove a passkey
+
+	twoFactor := account.Group("/2fa", mw.CheckToken)
+	twoFactor.POST("/setup", chat.SetupTwoFactor)                   // Generate TOTP secret
+	twoFactor.POST("/confirm", chat.ConfirmTwoFactor)               // Enable two-factor authentication with the first code
+	twoFactor.POST("/disable", chat.DisableTwoFactor)               // Disable two-factor authentication
+	twoFactor.POST("/recovery_codes", chat.RegenerateRecoveryCodes) // Regenerate recovery codes
+	twoFactor.POST("/get", chat.GetTwoFactor)                       // Get two-factor authentication status
 
 	user := router.Group("/user", mw.CheckToken)
 	user.POST("/update", chat.UpdateUserInfo)                 // Edit personal information
@@ -141,6 +175,13 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	user.POST("/search/full", chat.SearchUserFullInfo)        // Search user's public information
 	user.POST("/search/public", chat.SearchUserPublicInfo)    // Search all information of the user
 	user.POST("/rtc/get_token", chat.GetTokenForVideoMeeting) // Get token for video meeting for the user
+	user.POST("/delete", chat.RequestUserDeletion)            // Request the deletion of the account, logging in again cancels it
+	user.POST("/export/request", chat.RequestUserExport)      // Start an export of the personal data of the user
+	user.POST("/export/get", chat.GetUserExport)              // Get the latest export and its download link
+	user.POST("/contact/request", chat.RequestContactChange)  // Send a code to the new email or phone number
+	user.POST("/contact/confirm", chat.ConfirmContactChange)  // Replace the email or phone number after checking the code
+	// the signature of the link authorizes the download, browsers cannot send the token
+	router.GET("/user/export/download", chat.DownloadUserExport)
 
 	router.POST("/friend/search", mw.CheckToken, chat.SearchFriend)
 
@@ -153,4 +194,13 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	applicationGroup.POST("/page_versions", chat.PageApplicationVersion)
 
 	router.Group("/callback").POST("/open_im", chat.OpenIMCallback) // Callback
+
+	router.GET("/.well-known/jwks.json", chat.JWKS)                     // Public keys that verify tokens
+	router.GET("/.well-known/openid-configuration", chat.OIDCDiscovery) // OpenID Connect provider metadata
+
+	oidc := router.Group("/oidc")
+	oidc.POST("/authorize", mw.CheckToken, chat.OIDCAuthorize) // Issue an authorization code to an applet for the current user
+	oidc.POST("/token", chat.OIDCToken)                        // Redeem an authorization code, called by applets
+	oidc.GET("/userinfo", chat.OIDCUserInfo)                   // Claims of an access token
+	oidc.POST("/userinfo", chat.OIDCUserInfo)
 }
@@ -16,21 +16,27 @@ package mw
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	constantpb "github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mw"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
-func New(client admin.AdminClient) *MW {
-	return &MW{client: client}
+func New(client admin.AdminClient, base *util.Api) *MW {
+	return &MW{client: client, base: base}
 }
 
 type MW struct {
 	client admin.AdminClient
+	base   *util.Api
 }
 
 func (o *MW) parseToken(c *gin.Context) (string, int32, string, error) {
@@ -97,7 +103,28 @@ func (o *MW) CheckToken(c *gin.Context) {
 	o.setToken(c, userID, userType)
 }
 
+// CheckAdmin accepts the token of an admin, or an API key of an admin as "Authorization: Bearer <key>"
+// when there is no token.
 func (o *MW) CheckAdmin(c *gin.Context) {
+	if key, ok := apiKey(c); ok {
+		o.checkAPIKey(c, key)
+		return
+	}
+	o.checkAdminToken(c)
+}
+
+// CheckAdminToken accepts only the token of an admin, for the routes that manage credentials, API keys,
+// roles and two-factor authentication, which an API key must not reach whatever its routes are.
+func (o *MW) CheckAdminToken(c *gin.Context) {
+	if _, ok := apiKey(c); ok {
+		c.Abort()
+		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("not allowed with an api key"))
+		return
+	}
+	o.checkAdminToken(c)
+}
+
+func (o *MW) checkAdminToken(c *gin.Context) {
 	userID, token, err := o.parseTokenType(c, constant.AdminUser)
 	if err != nil {
 		c.Abort()
@@ -110,6 +137,55 @@ func (o *MW) CheckAdmin(c *gin.Context) {
 		return
 	}
 	o.setToken(c, userID, constant.AdminUser)
+	if ip, err := o.base.GetClientIP(c); err == nil {
+		setOpIP(c, ip)
+	}
+}
+
+func apiKey(c *gin.Context) (string, bool) {
+	if c.GetHeader("token") != "" {
+		return "", false
+	}
+	return strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+}
+
+func (o *MW) checkAPIKey(c *gin.Context, key string) {
+	ip, err := o.base.GetClientIP(c)
+	if err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.client.CheckAPIKey(c, &admin.CheckAPIKeyReq{Key: key, Ip: ip, Route: c.FullPath()})
+	if err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+	o.setToken(c, resp.UserID, constant.AdminUser)
+	setOpIP(c, ip)
+	// lets the rpc refuse an API key where CheckAdminToken is not in front
+	c.Set(constant.RpcOpAPIKey, []string{resp.UserID})
+	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType, constant.RpcOpIP, constant.RpcOpAPIKey})
+}
+
+func setOpIP(c *gin.Context, ip string) {
+	c.Set(constant.RpcOpIP, []string{ip})
+	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType, constant.RpcOpIP})
+}
+
+// CheckPermission returns a handler that checks the token is an admin's with the permission.
+func (o *MW) CheckPermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if o.CheckAdmin(c); c.IsAborted() {
+			return
+		}
+		if _, err := o.client.CheckPermission(c, &admin.CheckPermissionReq{Permission: permission}); err != nil {
+			c.Abort()
+			apiresp.GinError(c, err)
+			return
+		}
+	}
 }
 
 func (o *MW) CheckUser(c *gin.Context) {
@@ -143,3 +219,17 @@ func SetToken(c *gin.Context, userID string, userType int32) {
 	c.Set(constant.RpcOpUserType, []string{strconv.Itoa(int(userType))})
 	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType})
 }
+
+// ParseOperationID requires the operationID header like mw.GinParseOperationID, except on the paths
+// called by third parties that do not know it, where one is generated.
+func ParseOperationID(exempt ...string) gin.HandlerFunc {
+	parse := mw.GinParseOperationID()
+	return func(c *gin.Context) {
+		if c.GetHeader(constantpb.OperationID) == "" && datautil.Contain(c.Request.URL.Path, exempt...) {
+			c.Set(constantpb.OperationID, "ext_"+strconv.FormatInt(time.Now().UnixNano(), 10))
+			c.Next()
+			return
+		}
+		parse(c)
+	}
+}
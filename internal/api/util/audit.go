@@ -0,0 +1,37 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/tools/log"
+)
+
+// Audit records an operation done outside admin-rpc in the audit log, the admin and IP are taken
+// from ctx. A failure is logged and does not fail the operation, which is already done.
+func Audit(ctx context.Context, client admin.AdminClient, operation string, target string, before any, after any) {
+	req := &admin.AddAuditLogReq{
+		Operation: operation,
+		Target:    target,
+		Before:    admindb.AuditJSON(before),
+		After:     admindb.AuditJSON(after),
+	}
+	if _, err := client.AddAuditLog(ctx, req); err != nil {
+		log.ZError(ctx, "add audit log failed", err, "operation", operation, "target", target)
+	}
+}
@@ -21,10 +21,12 @@ import (
 	"time"
 
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/datautil"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
@@ -41,35 +43,60 @@ func (o *adminServer) GetAdminInfo(ctx context.Context, req *admin.GetAdminInfoR
 	if err != nil {
 		return nil, err
 	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := o.adminPermissions(ctx, a)
+	if err != nil {
+		return nil, err
+	}
 	return &admin.GetAdminInfoResp{
-		Account:    a.Account,
-		Password:   a.Password,
-		FaceURL:    a.FaceURL,
-		Nickname:   a.Nickname,
-		UserID:     a.UserID,
-		Level:      a.Level,
-		CreateTime: a.CreateTime.UnixMilli(),
+		Account:           a.Account,
+		FaceURL:           a.FaceURL,
+		Nickname:          a.Nickname,
+		UserID:            a.UserID,
+		Level:             a.Level,
+		CreateTime:        a.CreateTime.UnixMilli(),
+		TwoFactorRequired: o.twoFactorRequired(a),
+		TwoFactorEnabled:  tf != nil && tf.Enabled,
+		RoleIDs:           a.RoleIDs,
+		Permissions:       permissions,
 	}, nil
 }
 
 func (o *adminServer) ChangeAdminPassword(ctx context.Context, req *admin.ChangeAdminPasswordReq) (*admin.ChangeAdminPasswordResp, error) {
+	if _, err := mctx.CheckAdminToken(ctx); err != nil {
+		return nil, err
+	}
 	user, err := o.Database.GetAdminUserID(ctx, req.UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.Password != req.CurrentPassword {
+	match, err := o.Password.Verify(user.Password, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
 		return nil, errs.ErrInternalServer.WrapMsg("password error")
 	}
-
-	if err := o.Database.ChangePassword(ctx, req.UserID, req.NewPassword); err != nil {
+	if req.NewPassword == "" {
+		return nil, errs.ErrArgs.WrapMsg("new password is empty")
+	}
+	hashed, err := o.Password.Hash(req.NewPassword)
+	if err != nil {
 		return nil, err
 	}
+	if err := o.Database.ChangePassword(ctx, req.UserID, hashed); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditAdminPasswordChange, req.UserID, nil, nil)
 	return &admin.ChangeAdminPasswordResp{}, nil
 }
 
 func (o *adminServer) AddAdminAccount(ctx context.Context, req *admin.AddAdminAccountReq) (*admin.AddAdminAccountResp, error) {
-	if err := o.CheckSuperAdmin(ctx); err != nil {
+	if err := o.checkSuperAdminToken(ctx); err != nil {
 		return nil, err
 	}
 
@@ -77,24 +104,33 @@ func (o *adminServer) AddAdminAccount(ctx context.Context, req *admin.AddAdminAc
 	if err == nil {
 		return nil, errs.ErrDuplicateKey.WrapMsg("the account is registered")
 	}
+	hashed, err := o.Password.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkRoleIDs(ctx, req.RoleIDs); err != nil {
+		return nil, err
+	}
 
 	adm := &admindb.Admin{
 		Account:    req.Account,
-		Password:   req.Password,
+		Password:   hashed,
 		FaceURL:    req.FaceURL,
 		Nickname:   req.Nickname,
 		UserID:     o.genUserID(),
 		Level:      80,
 		CreateTime: time.Now(),
+		RoleIDs:    nonNil(req.RoleIDs),
 	}
 	if err = o.Database.AddAdminAccount(ctx, []*admindb.Admin{adm}); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditAdminAdd, adm.UserID, nil, auditAdmin(adm))
 	return &admin.AddAdminAccountResp{}, nil
 }
 
 func (o *adminServer) DelAdminAccount(ctx context.Context, req *admin.DelAdminAccountReq) (*admin.DelAdminAccountResp, error) {
-	if err := o.CheckSuperAdmin(ctx); err != nil {
+	if err := o.checkSuperAdminToken(ctx); err != nil {
 		return nil, err
 	}
 
@@ -102,6 +138,7 @@ func (o *adminServer) DelAdminAccount(ctx context.Context, req *admin.DelAdminAc
 		return nil, errs.ErrArgs.WrapMsg("user ids is duplicate")
 	}
 
+	admins := make([]*admindb.Admin, 0, len(req.UserIDs))
 	for _, userID := range req.UserIDs {
 		superAdmin, err := o.Database.GetAdminUserID(ctx, userID)
 		if err != nil {
@@ -110,11 +147,15 @@ func (o *adminServer) DelAdminAccount(ctx context.Context, req *admin.DelAdminAc
 		if superAdmin.Level == constant.AdvancedUserLevel {
 			return nil, errs.ErrNoPermission.WrapMsg(fmt.Sprintf("%s is superAdminID", userID))
 		}
+		admins = append(admins, superAdmin)
 	}
 
 	if err := o.Database.DelAdminAccount(ctx, req.UserIDs); err != nil {
 		return nil, err
 	}
+	for _, a := range admins {
+		o.audit(ctx, constant.AuditAdminDel, a.UserID, auditAdmin(a), nil)
+	}
 	return &admin.DelAdminAccountResp{}, nil
 }
 
@@ -136,6 +177,7 @@ func (o *adminServer) SearchAdminAccount(ctx context.Context, req *admin.SearchA
 			UserID:     v.UserID,
 			Level:      v.Level,
 			CreateTime: v.CreateTime.Unix(),
+			RoleIDs:    v.RoleIDs,
 		}
 		accounts = append(accounts, temp)
 	}
@@ -143,7 +185,7 @@ func (o *adminServer) SearchAdminAccount(ctx context.Context, req *admin.SearchA
 }
 
 func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdateInfoReq) (*admin.AdminUpdateInfoResp, error) {
-	userID, err := mctx.CheckAdmin(ctx)
+	userID, err := mctx.CheckAdminToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +193,11 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 	if err != nil {
 		return nil, err
 	}
+	if req.Password != nil {
+		if update["password"], err = o.Password.Hash(req.Password.Value); err != nil {
+			return nil, err
+		}
+	}
 	info, err := o.Database.GetAdminUserID(ctx, mcontext.GetOpUserID(ctx))
 	if err != nil {
 		return nil, err
@@ -158,6 +205,23 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 	if err := o.Database.UpdateAdmin(ctx, userID, update); err != nil {
 		return nil, err
 	}
+	after := *info
+	if req.Account != nil {
+		after.Account = req.Account.Value
+	}
+	if req.FaceURL != nil {
+		after.FaceURL = req.FaceURL.Value
+	}
+	if req.Nickname != nil {
+		after.Nickname = req.Nickname.Value
+	}
+	if req.Level != nil {
+		after.Level = req.Level.Value
+	}
+	o.audit(ctx, constant.AuditAdminUpdate, userID, auditAdmin(info), auditAdmin(&after))
+	if req.Password != nil {
+		o.audit(ctx, constant.AuditAdminPasswordChange, userID, nil, nil)
+	}
 	resp := &admin.AdminUpdateInfoResp{UserID: info.UserID}
 	if req.Nickname == nil {
 		resp.Nickname = info.Nickname
@@ -173,32 +237,71 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 }
 
 func (o *adminServer) Login(ctx context.Context, req *admin.LoginReq) (*admin.LoginResp, error) {
+	if err := o.checkLoginLock(ctx, cache.LoginTargetAdmin, "", req.Ip); err != nil {
+		return nil, err
+	}
 	a, err := o.Database.GetAdmin(ctx, req.Account)
 	if err != nil {
 		if dbutil.IsDBNotFound(err) {
-			return nil, eerrs.ErrAccountNotFound.Wrap()
+			return nil, o.loginFailed(ctx, cache.LoginTargetAdmin, "", req.Ip, eerrs.ErrAccountNotFound.Wrap())
 		}
 		return nil, err
 	}
-	if a.Password != req.Password {
-		return nil, eerrs.ErrPassword.Wrap()
+	if err := o.checkLoginLock(ctx, cache.LoginTargetAdmin, a.UserID, ""); err != nil {
+		return nil, err
 	}
-	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser})
+	match, err := o.Password.Verify(a.Password, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		return nil, o.loginFailed(ctx, cache.LoginTargetAdmin, a.UserID, req.Ip, eerrs.ErrPassword.Wrap())
+	}
+	if o.Password.NeedsRehash(a.Password) {
+		if hashed, err := o.Password.Hash(req.Password); err != nil {
+			log.ZError(ctx, "rehash admin password failed", err, "userID", a.UserID)
+		} else if err := o.Database.ChangePassword(ctx, a.UserID, hashed); err != nil {
+			log.ZError(ctx, "update admin password hash failed", err, "userID", a.UserID)
+		}
+	}
+	setup, recoveryCodes, err := o.checkTwoFactor(ctx, a, req.TwoFactorCode)
+	if err != nil {
+		if eerrs.ErrTwoFactorCodeInvalid.Is(err) {
+			return nil, o.loginFailed(ctx, cache.LoginTargetAdmin, a.UserID, req.Ip, err)
+		}
+		return nil, err
+	}
+	if setup != nil {
+		return setup, nil
+	}
+	if err := o.loginSucceeded(ctx, cache.LoginTargetAdmin, a.UserID); err != nil {
+		return nil, err
+	}
+	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{
+		UserID:     a.UserID,
+		UserType:   constant.AdminUser,
+		PlatformID: req.Platform,
+		DeviceID:   req.DeviceID,
+		Ip:         req.Ip,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &admin.LoginResp{
-		AdminUserID:  a.UserID,
-		AdminAccount: a.Account,
-		AdminToken:   adminToken.Token,
-		Nickname:     a.Nickname,
-		FaceURL:      a.FaceURL,
-		Level:        a.Level,
+		AdminUserID:   a.UserID,
+		AdminAccount:  a.Account,
+		AdminToken:    adminToken.Token,
+		Nickname:      a.Nickname,
+		FaceURL:       a.FaceURL,
+		Level:         a.Level,
+		RecoveryCodes: recoveryCodes,
+		RefreshToken:  adminToken.RefreshToken,
+		Expire:        adminToken.Expire,
 	}, nil
 }
 
 func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePasswordReq) (*admin.ChangePasswordResp, error) {
-	userID, err := mctx.CheckAdmin(ctx)
+	userID, err := mctx.CheckAdminToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -210,9 +313,13 @@ func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePassw
 	if err != nil {
 		return nil, err
 	}
+	if update["password"], err = o.Password.Hash(req.Password); err != nil {
+		return nil, err
+	}
 	if err := o.Database.UpdateAdmin(ctx, a.UserID, update); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditAdminPasswordChange, a.UserID, nil, nil)
 	return &admin.ChangePasswordResp{}, nil
 }
 
@@ -247,3 +354,11 @@ func (o *adminServer) CheckSuperAdmin(ctx context.Context) error {
 	}
 	return nil
 }
+
+// checkSuperAdminToken is CheckSuperAdmin, but refuses an admin authenticated by an API key.
+func (o *adminServer) checkSuperAdminToken(ctx context.Context) error {
+	if _, err := mctx.CheckAdminToken(ctx); err != nil {
+		return err
+	}
+	return o.CheckSuperAdmin(ctx)
+}
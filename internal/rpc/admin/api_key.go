@@ -0,0 +1,234 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/iprange"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+const (
+	// apiKeyPrefix starts every key, so that a leaked key is easy to recognize
+	apiKeyPrefix = "ak_"
+	// apiKeyUsedInterval is how often the last use of a key is written at most
+	apiKeyUsedInterval = time.Minute
+)
+
+// AddAPIKey creates a key for the admin making the request, the key is only returned here.
+func (o *adminServer) AddAPIKey(ctx context.Context, req *admin.AddAPIKeyReq) (*admin.AddAPIKeyResp, error) {
+	userID, err := mctx.CheckAdminToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetAdminUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	ips := make([]string, 0, len(req.Ips))
+	for _, ip := range req.Ips {
+		r, err := iprange.Parse(ip)
+		if err != nil {
+			return nil, err
+		}
+		ips = append(ips, r.Value)
+	}
+	if req.ExpireTime > 0 && req.ExpireTime <= time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("expireTime has passed")
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	apiKey := &admindb.APIKey{
+		KeyID:      uuid.New().String(),
+		UserID:     userID,
+		Name:       req.Name,
+		Hash:       apiKeyHash(key),
+		Prefix:     key[:len(apiKeyPrefix)+6],
+		Routes:     datautil.Distinct(req.Routes),
+		IPs:        datautil.Distinct(ips),
+		CreateTime: time.Now(),
+	}
+	if req.ExpireTime > 0 {
+		apiKey.ExpireTime = time.UnixMilli(req.ExpireTime)
+	}
+	if err := o.Database.AddAPIKey(ctx, []*admindb.APIKey{apiKey}); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditAPIKeyAdd, apiKey.KeyID, nil, apiKeyDB2PB(apiKey))
+	return &admin.AddAPIKeyResp{KeyID: apiKey.KeyID, Key: key}, nil
+}
+
+func (o *adminServer) SearchAPIKey(ctx context.Context, req *admin.SearchAPIKeyReq) (*admin.SearchAPIKeyResp, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID != "" && req.UserID != userID {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+		userID = req.UserID
+	}
+	total, keys, err := o.Database.SearchAPIKey(ctx, userID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchAPIKeyResp{
+		Total: uint32(total),
+		Keys:  datautil.Slice(keys, apiKeyDB2PB),
+	}, nil
+}
+
+// DelAPIKey revokes keys, admins can revoke their own keys and the super admin any key.
+func (o *adminServer) DelAPIKey(ctx context.Context, req *admin.DelAPIKeyReq) (*admin.DelAPIKeyResp, error) {
+	userID, err := mctx.CheckAdminToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := o.Database.FindAPIKey(ctx, req.KeyIDs)
+	if err != nil {
+		return nil, err
+	}
+	if ids := datautil.Single(req.KeyIDs, datautil.Slice(keys, func(e *admindb.APIKey) string { return e.KeyID })); len(ids) > 0 {
+		return nil, errs.ErrRecordNotFound.WrapMsg("api key not found", "keyIDs", ids)
+	}
+	for _, key := range keys {
+		if key.UserID != userID {
+			if err := o.CheckSuperAdmin(ctx); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	if err := o.Database.DelAPIKey(ctx, req.KeyIDs); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		o.audit(ctx, constant.AuditAPIKeyDel, key.KeyID, apiKeyDB2PB(key), nil)
+	}
+	return &admin.DelAPIKeyResp{}, nil
+}
+
+// CheckAPIKey is called by the apis for a request with a key instead of a token, the request is
+// accepted when the key is valid for its route and IP.
+func (o *adminServer) CheckAPIKey(ctx context.Context, req *admin.CheckAPIKeyReq) (*admin.CheckAPIKeyResp, error) {
+	if !strings.HasPrefix(req.Key, apiKeyPrefix) {
+		return nil, eerrs.ErrAPIKeyInvalid.Wrap()
+	}
+	key, err := o.Database.TakeAPIKeyByHash(ctx, apiKeyHash(req.Key))
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrAPIKeyInvalid.Wrap()
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if !key.ExpireTime.IsZero() && !now.Before(key.ExpireTime) {
+		return nil, eerrs.ErrAPIKeyInvalid.WrapMsg("api key expired", "keyID", key.KeyID)
+	}
+	if !apiKeyRouteAllowed(key.Routes, req.Route) {
+		return nil, errs.ErrNoPermission.WrapMsg("route not allowed for the api key", "keyID", key.KeyID, "route", req.Route)
+	}
+	if !apiKeyIPAllowed(key.IPs, req.Ip) {
+		return nil, errs.ErrNoPermission.WrapMsg("ip not allowed for the api key", "keyID", key.KeyID, "ip", req.Ip)
+	}
+	if _, err := o.Database.GetAdminUserID(ctx, key.UserID); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrAPIKeyInvalid.WrapMsg("admin of the api key not found", "keyID", key.KeyID)
+		}
+		return nil, err
+	}
+	if now.Sub(key.LastUsedTime) >= apiKeyUsedInterval || key.LastUsedIP != req.Ip {
+		if err := o.Database.SetAPIKeyLastUsed(ctx, key.KeyID, req.Ip, now); err != nil {
+			log.ZWarn(ctx, "set api key last used failed", err, "keyID", key.KeyID)
+		}
+	}
+	return &admin.CheckAPIKeyResp{UserID: key.UserID}, nil
+}
+
+func apiKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// apiKeyRouteAllowed reports whether route is one of routes, or below one ending with "/*".
+func apiKeyRouteAllowed(routes []string, route string) bool {
+	for _, r := range routes {
+		if r == route {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(r, "*"); ok && strings.HasSuffix(prefix, "/") && strings.HasPrefix(route, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func apiKeyIPAllowed(ips []string, ip string) bool {
+	if len(ips) == 0 {
+		return true
+	}
+	addr, err := iprange.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	k := iprange.Key(addr)
+	for _, v := range ips {
+		r, err := iprange.Parse(v)
+		if err == nil && r.Start <= k && k <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+func apiKeyDB2PB(key *admindb.APIKey) *admin.APIKey {
+	res := &admin.APIKey{
+		KeyID:      key.KeyID,
+		UserID:     key.UserID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Routes:     key.Routes,
+		Ips:        key.IPs,
+		LastUsedIP: key.LastUsedIP,
+		CreateTime: key.CreateTime.UnixMilli(),
+	}
+	if !key.ExpireTime.IsZero() {
+		res.ExpireTime = key.ExpireTime.UnixMilli()
+	}
+	if !key.LastUsedTime.IsZero() {
+		res.LastUsedTime = key.LastUsedTime.UnixMilli()
+	}
+	return res
+}
@@ -32,7 +32,7 @@ import (
 )
 
 func (o *adminServer) AddApplet(ctx context.Context, req *admin.AddAppletReq) (*admin.AddAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionAppletManage); err != nil {
 		return nil, err
 	}
 	if req.Name == "" {
@@ -63,11 +63,12 @@ func (o *adminServer) AddApplet(ctx context.Context, req *admin.AddAppletReq) (*
 	if err := o.Database.CreateApplet(ctx, []*admindb.Applet{&m}); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditAppletAdd, m.ID, nil, &m)
 	return &admin.AddAppletResp{}, nil
 }
 
 func (o *adminServer) DelApplet(ctx context.Context, req *admin.DelAppletReq) (*admin.DelAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionAppletManage); err != nil {
 		return nil, err
 	}
 	if len(req.AppletIds) == 0 {
@@ -83,14 +84,17 @@ func (o *adminServer) DelApplet(ctx context.Context, req *admin.DelAppletReq) (*
 	if err := o.Database.DelApplet(ctx, req.AppletIds); err != nil {
 		return nil, err
 	}
+	for _, applet := range applets {
+		o.audit(ctx, constant.AuditAppletDel, applet.ID, auditApplet(applet), nil)
+	}
 	return &admin.DelAppletResp{}, nil
 }
 
 func (o *adminServer) UpdateApplet(ctx context.Context, req *admin.UpdateAppletReq) (*admin.UpdateAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionAppletManage); err != nil {
 		return nil, err
 	}
-	_, err := o.Database.GetApplet(ctx, req.Id)
+	applet, err := o.Database.GetApplet(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +105,7 @@ func (o *adminServer) UpdateApplet(ctx context.Context, req *admin.UpdateAppletR
 	if err := o.Database.UpdateApplet(ctx, req.Id, update); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditAppletUpdate, req.Id, auditApplet(applet), update)
 	return &admin.UpdateAppletResp{}, nil
 }
 
@@ -132,7 +137,7 @@ func (o *adminServer) FindApplet(ctx context.Context, req *admin.FindAppletReq)
 }
 
 func (o *adminServer) SearchApplet(ctx context.Context, req *admin.SearchAppletReq) (*admin.SearchAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionAppletManage); err != nil {
 		return nil, err
 	}
 	total, applets, err := o.Database.SearchApplet(ctx, req.Keyword, req.Pagination)
@@ -142,17 +147,19 @@ func (o *adminServer) SearchApplet(ctx context.Context, req *admin.SearchAppletR
 	resp := &admin.SearchAppletResp{Total: uint32(total), Applets: make([]*common.AppletInfo, 0, len(applets))}
 	for _, applet := range applets {
 		resp.Applets = append(resp.Applets, &common.AppletInfo{
-			Id:         applet.ID,
-			Name:       applet.Name,
-			AppID:      applet.AppID,
-			Icon:       applet.Icon,
-			Url:        applet.URL,
-			Md5:        applet.MD5,
-			Size:       applet.Size,
-			Version:    applet.Version,
-			Priority:   applet.Priority,
-			Status:     uint32(applet.Status),
-			CreateTime: applet.CreateTime.UnixMilli(),
+			Id:              applet.ID,
+			Name:            applet.Name,
+			AppID:           applet.AppID,
+			Icon:            applet.Icon,
+			Url:             applet.URL,
+			Md5:             applet.MD5,
+			Size:            applet.Size,
+			Version:         applet.Version,
+			Priority:        applet.Priority,
+			Status:          uint32(applet.Status),
+			CreateTime:      applet.CreateTime.UnixMilli(),
+			RedirectURIs:    applet.RedirectURIs,
+			HasClientSecret: applet.ClientSecret != "",
 		})
 	}
 	return resp, nil
@@ -2,8 +2,8 @@ package admin
 
 import (
 	"context"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -48,7 +48,7 @@ func (o *adminServer) LatestApplicationVersion(ctx context.Context, req *admin.L
 }
 
 func (o *adminServer) AddApplicationVersion(ctx context.Context, req *admin.AddApplicationVersionReq) (*admin.AddApplicationVersionResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionApplicationManage); err != nil {
 		return nil, err
 	}
 	val := &admindb.Application{
@@ -65,11 +65,12 @@ func (o *adminServer) AddApplicationVersion(ctx context.Context, req *admin.AddA
 	if err := o.Database.AddVersion(ctx, val); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditApplicationVersionAdd, val.ID.Hex(), nil, val)
 	return &admin.AddApplicationVersionResp{}, nil
 }
 
 func (o *adminServer) UpdateApplicationVersion(ctx context.Context, req *admin.UpdateApplicationVersionReq) (*admin.UpdateApplicationVersionResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionApplicationManage); err != nil {
 		return nil, err
 	}
 	oid, err := primitive.ObjectIDFromHex(req.Id)
@@ -84,14 +85,22 @@ func (o *adminServer) UpdateApplicationVersion(ctx context.Context, req *admin.U
 	putUpdate(update, "force", req.Force)
 	putUpdate(update, "latest", req.Latest)
 	putUpdate(update, "hot", req.Hot)
+	versions, err := o.Database.FindVersion(ctx, []primitive.ObjectID{oid})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, errs.ErrRecordNotFound.WrapMsg("application version not found", "id", req.Id)
+	}
 	if err := o.Database.UpdateVersion(ctx, oid, update); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditApplicationVersionUpdate, req.Id, versions[0], update)
 	return &admin.UpdateApplicationVersionResp{}, nil
 }
 
 func (o *adminServer) DeleteApplicationVersion(ctx context.Context, req *admin.DeleteApplicationVersionReq) (*admin.DeleteApplicationVersionResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionApplicationManage); err != nil {
 		return nil, err
 	}
 	ids := make([]primitive.ObjectID, 0, len(req.Id))
@@ -102,9 +111,16 @@ func (o *adminServer) DeleteApplicationVersion(ctx context.Context, req *admin.D
 		}
 		ids = append(ids, oid)
 	}
+	versions, err := o.Database.FindVersion(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
 	if err := o.Database.DeleteVersion(ctx, ids); err != nil {
 		return nil, err
 	}
+	for _, version := range versions {
+		o.audit(ctx, constant.AuditApplicationVersionDel, version.ID.Hex(), version, nil)
+	}
 	return &admin.DeleteApplicationVersionResp{}, nil
 }
 
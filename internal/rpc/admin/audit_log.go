@@ -0,0 +1,117 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// AddAuditLog is called by the apis for the changes made by admins outside the admin service.
+func (o *adminServer) AddAuditLog(ctx context.Context, req *admin.AddAuditLogReq) (*admin.AddAuditLogResp, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entry := &admindb.AuditLog{
+		LogID:      uuid.New().String(),
+		UserID:     userID,
+		IP:         mctx.GetOpIP(ctx),
+		Operation:  req.Operation,
+		Target:     req.Target,
+		Before:     req.Before,
+		After:      req.After,
+		CreateTime: time.Now(),
+	}
+	if err := o.Database.AddAuditLog(ctx, []*admindb.AuditLog{entry}); err != nil {
+		return nil, err
+	}
+	return &admin.AddAuditLogResp{}, nil
+}
+
+func (o *adminServer) SearchAuditLog(ctx context.Context, req *admin.SearchAuditLogReq) (*admin.SearchAuditLogResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionAuditRead); err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		end = time.UnixMilli(req.EndTime)
+	}
+	total, logs, err := o.Database.SearchAuditLog(ctx, req.UserID, req.Operation, req.Target, start, end, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchAuditLogResp{
+		Total: uint32(total),
+		Logs:  datautil.Slice(logs, auditLogDB2PB),
+	}, nil
+}
+
+// audit appends a change to the audit log, before and after are the changed record, nil when it did not
+// exist. The change is made already, so a failed write is only logged.
+func (o *adminServer) audit(ctx context.Context, operation string, target string, before any, after any) {
+	entry := &admindb.AuditLog{
+		LogID:      uuid.New().String(),
+		UserID:     mctx.GetOpUserID(ctx),
+		IP:         mctx.GetOpIP(ctx),
+		Operation:  operation,
+		Target:     target,
+		Before:     admindb.AuditJSON(before),
+		After:      admindb.AuditJSON(after),
+		CreateTime: time.Now(),
+	}
+	if err := o.Database.AddAuditLog(ctx, []*admindb.AuditLog{entry}); err != nil {
+		log.ZError(ctx, "write audit log failed", err, "operation", operation, "target", target)
+	}
+}
+
+// auditAdmin leaves the password hash out of the audit log.
+func auditAdmin(a *admindb.Admin) *admindb.Admin {
+	c := *a
+	c.Password = ""
+	return &c
+}
+
+// auditApplet leaves the client secret hash out of the audit log.
+func auditApplet(a *admindb.Applet) *admindb.Applet {
+	c := *a
+	c.ClientSecret = ""
+	return &c
+}
+
+func auditLogDB2PB(l *admindb.AuditLog) *admin.AuditLog {
+	return &admin.AuditLog{
+		LogID:      l.LogID,
+		UserID:     l.UserID,
+		Ip:         l.IP,
+		Operation:  l.Operation,
+		Target:     l.Target,
+		Before:     l.Before,
+		After:      l.After,
+		CreateTime: l.CreateTime.UnixMilli(),
+	}
+}
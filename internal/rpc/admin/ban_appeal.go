@@ -0,0 +1,214 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// maxBanAppealLen limits the runes of an appeal and of a comment.
+const maxBanAppealLen = 2000
+
+// SubmitBanAppeal is called by chat rpc for a blocked user it has verified, a user has one pending appeal at a time.
+func (o *adminServer) SubmitBanAppeal(ctx context.Context, req *admin.SubmitBanAppealReq) (*admin.SubmitBanAppealResp, error) {
+	content := strings.TrimSpace(req.Content)
+	if utf8.RuneCountInString(content) > maxBanAppealLen {
+		return nil, errs.ErrArgs.WrapMsg("content is too long", "max", maxBanAppealLen)
+	}
+	block, err := o.Database.GetBlockInfo(ctx, req.UserID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("user is not blocked")
+		}
+		return nil, err
+	}
+	last, err := o.Database.TakeLatestBanAppeal(ctx, req.UserID)
+	if err == nil && last.Status == constant.BanAppealPending {
+		if !last.CreateTime.Before(block.CreateTime) {
+			return nil, errs.ErrArgs.WrapMsg("an appeal is already pending")
+		}
+		// The block the appeal was against has ended, it is closed to make room for the new one.
+		if _, err := o.Database.ReviewBanAppeal(ctx, last, constant.BanAppealRejected, o.ChatAdminUserID, time.Now()); err != nil {
+			return nil, err
+		}
+	} else if err != nil && !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	appeal := &admindb.BanAppeal{
+		AppealID:   uuid.New().String(),
+		UserID:     req.UserID,
+		BanReason:  block.Reason,
+		Content:    content,
+		Status:     constant.BanAppealPending,
+		Comments:   []*admindb.BanAppealComment{},
+		CreateTime: time.Now(),
+	}
+	if err := o.Database.AddBanAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+	return &admin.SubmitBanAppealResp{AppealID: appeal.AppealID}, nil
+}
+
+// GetUserBanAppeal is called by chat rpc, it returns the active block and the newest appeal of the user.
+func (o *adminServer) GetUserBanAppeal(ctx context.Context, req *admin.GetUserBanAppealReq) (*admin.GetUserBanAppealResp, error) {
+	var resp admin.GetUserBanAppealResp
+	block, err := o.Database.GetBlockInfo(ctx, req.UserID)
+	if err == nil {
+		resp.Block = blockInfo(block)
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	appeal, err := o.Database.TakeLatestBanAppeal(ctx, req.UserID)
+	if err == nil {
+		resp.Appeal = banAppealDB2PB(appeal)
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (o *adminServer) SearchBanAppeal(ctx context.Context, req *admin.SearchBanAppealReq) (*admin.SearchBanAppealResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserBlock); err != nil {
+		return nil, err
+	}
+	total, appeals, err := o.Database.SearchBanAppeal(ctx, req.UserID, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchBanAppealResp{
+		Total:   uint32(total),
+		Appeals: datautil.Slice(appeals, banAppealDB2PB),
+	}, nil
+}
+
+func (o *adminServer) CommentBanAppeal(ctx context.Context, req *admin.CommentBanAppealReq) (*admin.CommentBanAppealResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserBlock); err != nil {
+		return nil, err
+	}
+	comment, err := newBanAppealComment(ctx, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.takeBanAppeal(ctx, req.AppealID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.AddBanAppealComment(ctx, req.AppealID, comment); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditBanAppealComment, req.AppealID, nil, comment)
+	return &admin.CommentBanAppealResp{}, nil
+}
+
+// ReviewBanAppeal approves or rejects a pending appeal, approving lifts the block of the user.
+func (o *adminServer) ReviewBanAppeal(ctx context.Context, req *admin.ReviewBanAppealReq) (*admin.ReviewBanAppealResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserBlock); err != nil {
+		return nil, err
+	}
+	var comment *admindb.BanAppealComment
+	if req.Comment != "" {
+		var err error
+		if comment, err = newBanAppealComment(ctx, req.Comment); err != nil {
+			return nil, err
+		}
+	}
+	appeal, err := o.takeBanAppeal(ctx, req.AppealID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != constant.BanAppealPending {
+		return nil, errs.ErrArgs.WrapMsg("appeal is already reviewed")
+	}
+	var block *admindb.ForbiddenAccount
+	status := int32(constant.BanAppealRejected)
+	if req.Approve {
+		status = constant.BanAppealApproved
+		if block, err = o.Database.GetBlockInfo(ctx, appeal.UserID); err != nil && !dbutil.IsDBNotFound(err) {
+			return nil, err
+		}
+	}
+	ok, err := o.Database.ReviewBanAppeal(ctx, appeal, status, mcontext.GetOpUserID(ctx), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("appeal is already reviewed")
+	}
+	if comment != nil {
+		if err := o.Database.AddBanAppealComment(ctx, appeal.AppealID, comment); err != nil {
+			return nil, err
+		}
+	}
+	o.audit(ctx, constant.AuditBanAppealReview, appeal.AppealID, map[string]any{"status": appeal.Status}, map[string]any{"status": status, "comment": req.Comment})
+	if block != nil {
+		o.audit(ctx, constant.AuditUserUnblock, block.UserID, block, nil)
+	}
+	return &admin.ReviewBanAppealResp{}, nil
+}
+
+func (o *adminServer) takeBanAppeal(ctx context.Context, appealID string) (*admindb.BanAppeal, error) {
+	appeal, err := o.Database.TakeBanAppeal(ctx, appealID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("appeal not found", "appealID", appealID)
+		}
+		return nil, err
+	}
+	return appeal, nil
+}
+
+func newBanAppealComment(ctx context.Context, content string) (*admindb.BanAppealComment, error) {
+	content = strings.TrimSpace(content)
+	if utf8.RuneCountInString(content) > maxBanAppealLen {
+		return nil, errs.ErrArgs.WrapMsg("comment is too long", "max", maxBanAppealLen)
+	}
+	return &admindb.BanAppealComment{
+		UserID:     mcontext.GetOpUserID(ctx),
+		Content:    content,
+		CreateTime: time.Now(),
+	}, nil
+}
+
+func banAppealDB2PB(appeal *admindb.BanAppeal) *admin.BanAppeal {
+	var reviewTime int64
+	if !appeal.ReviewTime.IsZero() {
+		reviewTime = appeal.ReviewTime.UnixMilli()
+	}
+	return &admin.BanAppeal{
+		AppealID:  appeal.AppealID,
+		UserID:    appeal.UserID,
+		BanReason: appeal.BanReason,
+		Content:   appeal.Content,
+		Status:    appeal.Status,
+		Comments: datautil.Slice(appeal.Comments, func(c *admindb.BanAppealComment) *admin.BanAppealComment {
+			return &admin.BanAppealComment{UserID: c.UserID, Content: c.Content, CreateTime: c.CreateTime.UnixMilli()}
+		}),
+		ReviewerUserID: appeal.ReviewerUserID,
+		ReviewTime:     reviewTime,
+		CreateTime:     appeal.CreateTime.UnixMilli(),
+	}
+}
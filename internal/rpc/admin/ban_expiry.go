@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+)
+
+const banExpiryJob = "ban_expiry"
+
+type banExpiry struct {
+	Interval time.Duration
+	Batch    int64
+}
+
+// startBanExpiry removes the expired blocks every interval, on one admin rpc instance at a time.
+// Expired blocks no longer stop a login, removing them only keeps the block list and the audit log accurate.
+func (o *adminServer) startBanExpiry(ctx context.Context) {
+	if o.BanExpiry.Interval <= 0 || o.BanExpiry.Batch <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(o.BanExpiry.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			expiryCtx := mctx.WithAdminUser(mcontext.SetOperationID(ctx, "banExpiry"+strconv.FormatInt(time.Now().UnixMilli(), 10)), o.ChatAdminUserID)
+			ok, err := o.Database.TryLockJob(expiryCtx, banExpiryJob, o.BanExpiry.Interval-o.BanExpiry.Interval/10)
+			if err != nil {
+				log.ZError(expiryCtx, "lock ban expiry failed", err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			lifted, err := o.liftExpiredBlocks(expiryCtx)
+			if err != nil {
+				log.ZError(expiryCtx, "ban expiry failed", err)
+				continue
+			}
+			if lifted > 0 {
+				log.ZInfo(expiryCtx, "ban expiry finished", "lifted", lifted)
+			}
+		}
+	}()
+}
+
+// liftExpiredBlocks removes one batch of expired blocks, a block renewed in the meantime is kept.
+func (o *adminServer) liftExpiredBlocks(ctx context.Context) (int, error) {
+	now := time.Now()
+	blocks, err := o.Database.FindExpiredBlock(ctx, now, o.BanExpiry.Batch)
+	if err != nil {
+		return 0, err
+	}
+	if len(blocks) == 0 {
+		return 0, nil
+	}
+	userIDs := datautil.Slice(blocks, func(b *admindb.ForbiddenAccount) string { return b.UserID })
+	if err := o.Database.DelExpiredBlock(ctx, userIDs, now); err != nil {
+		return 0, err
+	}
+	for _, b := range blocks {
+		o.audit(ctx, constant.AuditUserUnblock, b.UserID, b, nil)
+	}
+	return len(blocks), nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+type captchaLimit struct {
+	Threshold int
+	Window    time.Duration
+}
+
+// captchaStore keeps the answers of challenges in redis.
+type captchaStore struct {
+	db database.AdminDatabaseInterface
+}
+
+func (s captchaStore) Set(ctx context.Context, id string, value string, expire time.Duration) error {
+	return s.db.SetCaptcha(ctx, id, value, expire)
+}
+
+func (s captchaStore) Take(ctx context.Context, id string) (string, error) {
+	return s.db.TakeCaptcha(ctx, id)
+}
+
+func (o *adminServer) GetCaptcha(ctx context.Context, req *admin.GetCaptchaReq) (*admin.GetCaptchaResp, error) {
+	if o.Captcha == nil {
+		return &admin.GetCaptchaResp{}, nil
+	}
+	count, err := o.Database.CaptchaCount(ctx, req.Ip)
+	if err != nil {
+		return nil, err
+	}
+	c, err := o.Captcha.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.GetCaptchaResp{
+		Required: count >= int64(o.CaptchaLimit.Threshold),
+		Captcha: &admin.Captcha{
+			Type:       c.Type,
+			Id:         c.ID,
+			ExpireTime: c.ExpireTime,
+			Image:      c.Image,
+			Data:       c.Data,
+			Difficulty: int32(c.Difficulty),
+			SiteKey:    c.SiteKey,
+		},
+	}, nil
+}
+
+// CheckCaptcha counts a request of the ip, the request passes without an answer until the ip is over
+// the threshold of the window. An answer is always checked when there is one.
+func (o *adminServer) CheckCaptcha(ctx context.Context, req *admin.CheckCaptchaReq) (*admin.CheckCaptchaResp, error) {
+	if o.Captcha == nil {
+		return &admin.CheckCaptchaResp{}, nil
+	}
+	count, err := o.Database.CaptchaHit(ctx, req.Ip, o.CaptchaLimit.Window)
+	if err != nil {
+		return nil, err
+	}
+	if req.CaptchaAnswer == "" {
+		if count <= int64(o.CaptchaLimit.Threshold) {
+			return &admin.CheckCaptchaResp{}, nil
+		}
+		return nil, eerrs.ErrCaptchaRequired.WrapMsg("captcha required", "type", o.Captcha.Type())
+	}
+	ok, err := o.Captcha.Verify(ctx, req.CaptchaID, req.CaptchaAnswer, req.Ip)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, eerrs.ErrCaptchaInvalid.WrapMsg("wrong or expired captcha answer")
+	}
+	return &admin.CheckCaptchaResp{}, nil
+}
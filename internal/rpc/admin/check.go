@@ -17,13 +17,14 @@ package admin
 import (
 	"context"
 
+	"github.com/openimsdk/chat/pkg/common/db/cache"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
 func (o *adminServer) CheckRegisterForbidden(ctx context.Context, req *admin.CheckRegisterForbiddenReq) (*admin.CheckRegisterForbiddenResp, error) {
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
+	forbiddens, err := o.Database.MatchIPForbidden(ctx, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +37,10 @@ func (o *adminServer) CheckRegisterForbidden(ctx context.Context, req *admin.Che
 }
 
 func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckLoginForbiddenReq) (*admin.CheckLoginForbiddenResp, error) {
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
+	if err := o.checkLoginLock(ctx, cache.LoginTargetUser, req.UserID, req.Ip); err != nil {
+		return nil, err
+	}
+	forbiddens, err := o.Database.MatchIPForbidden(ctx, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +49,7 @@ func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckL
 			return nil, eerrs.ErrForbidden.WrapMsg("ip forbidden")
 		}
 	}
-	if _, err := o.Database.GetLimitUserLoginIP(ctx, req.UserID, req.Ip); err != nil {
+	if _, err := o.Database.MatchLimitUserLoginIP(ctx, req.UserID, req.Ip); err != nil {
 		if !dbutil.IsDBNotFound(err) {
 			return nil, err
 		}
@@ -58,7 +62,7 @@ func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckL
 		}
 	}
 	if forbiddenAccount, err := o.Database.GetBlockInfo(ctx, req.UserID); err == nil {
-		return nil, eerrs.ErrForbidden.WrapMsg("account forbidden", "reason", forbiddenAccount.Reason)
+		return nil, eerrs.ErrForbidden.WrapMsg("account forbidden", "reason", forbiddenAccount.Reason, "expireTime", blockExpireTime(forbiddenAccount))
 	} else if !dbutil.IsDBNotFound(err) {
 		return nil, err
 	}
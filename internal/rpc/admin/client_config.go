@@ -18,8 +18,10 @@ import (
 	"context"
 
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
 
-	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/password"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
@@ -32,24 +34,48 @@ func (o *adminServer) GetClientConfig(ctx context.Context, req *admin.GetClientC
 }
 
 func (o *adminServer) SetClientConfig(ctx context.Context, req *admin.SetClientConfigReq) (*admin.SetClientConfigResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionConfigWrite); err != nil {
 		return nil, err
 	}
 	if len(req.Config) == 0 {
 		return nil, errs.ErrArgs.WrapMsg("update config empty")
 	}
+	if _, err := password.ParsePolicy(req.Config); err != nil {
+		return nil, err
+	}
+	before, err := o.Database.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if err := o.Database.SetConfig(ctx, req.Config); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditClientConfigSet, "", configValues(before, datautil.Keys(req.Config)), req.Config)
 	return &admin.SetClientConfigResp{}, nil
 }
 
 func (o *adminServer) DelClientConfig(ctx context.Context, req *admin.DelClientConfigReq) (*admin.DelClientConfigResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionConfigWrite); err != nil {
+		return nil, err
+	}
+	before, err := o.Database.GetConfig(ctx)
+	if err != nil {
 		return nil, err
 	}
 	if err := o.Database.DelConfig(ctx, req.Keys); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditClientConfigDel, "", configValues(before, req.Keys), nil)
 	return &admin.DelClientConfigResp{}, nil
 }
+
+// configValues returns the values of keys that are set in conf.
+func configValues(conf map[string]string, keys []string) map[string]string {
+	values := make(map[string]string)
+	for _, key := range keys {
+		if value, ok := conf[key]; ok {
+			values[key] = value
+		}
+	}
+	return values
+}
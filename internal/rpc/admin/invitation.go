@@ -24,6 +24,7 @@ import (
 
 	"github.com/openimsdk/tools/errs"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
@@ -31,7 +32,7 @@ import (
 )
 
 func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvitationCodeReq) (*admin.AddInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	if len(req.Codes) == 0 {
@@ -60,11 +61,12 @@ func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvit
 	if err := o.Database.CreatInvitationRegister(ctx, codes); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditInvitationCodeAdd, "", nil, codes)
 	return &admin.AddInvitationCodeResp{}, nil
 }
 
 func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvitationCodeReq) (*admin.GenInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	if req.Num <= 0 || req.Len <= 0 {
@@ -103,6 +105,7 @@ func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvit
 	if err := o.Database.CreatInvitationRegister(ctx, invitationRegisters); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditInvitationCodeAdd, "", nil, invitationRegisters)
 	return &admin.GenInvitationCodeResp{}, nil
 }
 
@@ -160,7 +163,7 @@ func (o *adminServer) UseInvitationCode(ctx context.Context, req *admin.UseInvit
 }
 
 func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvitationCodeReq) (*admin.DelInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	if len(req.Codes) == 0 {
@@ -180,11 +183,12 @@ func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvit
 	if err := o.Database.DelInvitationRegister(ctx, req.Codes); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditInvitationCodeDel, "", irs, nil)
 	return &admin.DelInvitationCodeResp{}, nil
 }
 
 func (o *adminServer) SearchInvitationCode(ctx context.Context, req *admin.SearchInvitationCodeReq) (*admin.SearchInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	total, list, err := o.Database.SearchInvitationRegister(ctx, req.Keyword, req.Status, req.UserIDs, req.Codes, req.Pagination)
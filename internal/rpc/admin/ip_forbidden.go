@@ -18,13 +18,14 @@ import (
 	"context"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/iprange"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
 func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIPForbiddenReq) (*admin.SearchIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
 		return nil, err
 	}
 	total, forbiddens, err := o.Database.SearchIPForbidden(ctx, req.Keyword, req.Status, req.Pagination)
@@ -47,14 +48,20 @@ func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIP
 }
 
 func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbiddenReq) (*admin.AddIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
 		return nil, err
 	}
 	now := time.Now()
 	tables := make([]*admindb.IPForbidden, 0, len(req.Forbiddens))
 	for _, forbidden := range req.Forbiddens {
+		r, err := iprange.Parse(forbidden.Ip)
+		if err != nil {
+			return nil, err
+		}
 		tables = append(tables, &admindb.IPForbidden{
-			IP:            forbidden.Ip,
+			IP:            r.Value,
+			RangeStart:    r.Start,
+			RangeEnd:      r.End,
 			LimitLogin:    forbidden.LimitLogin,
 			LimitRegister: forbidden.LimitRegister,
 			CreateTime:    now,
@@ -63,15 +70,30 @@ func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbid
 	if err := o.Database.AddIPForbidden(ctx, tables); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditIPForbiddenAdd, "", nil, tables)
 	return &admin.AddIPForbiddenResp{}, nil
 }
 
 func (o *adminServer) DelIPForbidden(ctx context.Context, req *admin.DelIPForbiddenReq) (*admin.DelIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
 		return nil, err
 	}
-	if err := o.Database.DelIPForbidden(ctx, req.Ips); err != nil {
+	if err := o.Database.DelIPForbidden(ctx, ipValues(req.Ips)); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditIPForbiddenDel, "", req.Ips, nil)
 	return &admin.DelIPForbiddenResp{}, nil
 }
+
+// ipValues adds the canonical form of every ip or CIDR block, entries are stored canonical
+// but those written before CIDR support are stored as they were entered.
+func ipValues(ips []string) []string {
+	values := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		values = append(values, ip)
+		if r, err := iprange.Parse(ip); err == nil && r.Value != ip {
+			values = append(values, r.Value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,262 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/protocol/wrapperspb"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/directory"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/protocol/common"
+)
+
+const (
+	ldapSyncJob = "ldap_sync"
+	// ldapSyncBlockReason marks the users blocked by the sync, only they are unblocked by it.
+	ldapSyncBlockReason = "removed from the directory"
+)
+
+type ldapSync struct {
+	Interval     time.Duration
+	BlockRemoved bool
+	Groups       []ldapSyncGroup
+}
+
+// ldapSyncGroup maps the members of a directory group onto IM groups, like the default groups of new users.
+type ldapSyncGroup struct {
+	DN         string
+	IMGroupIDs []string
+}
+
+// startLDAPSync runs the directory sync every interval, on one admin rpc instance at a time.
+func (o *adminServer) startLDAPSync(ctx context.Context) {
+	if o.Directory == nil || o.LDAPSync.Interval <= 0 || len(o.LDAPSync.Groups) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(o.LDAPSync.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			syncCtx := mctx.WithAdminUser(mcontext.SetOperationID(ctx, "ldapSync"+strconv.FormatInt(time.Now().UnixMilli(), 10)), o.ChatAdminUserID)
+			ok, err := o.Database.TryLockJob(syncCtx, ldapSyncJob, o.LDAPSync.Interval-o.LDAPSync.Interval/10)
+			if err != nil {
+				log.ZError(syncCtx, "lock ldap sync failed", err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			resp, err := o.syncLDAP(syncCtx)
+			if err != nil {
+				log.ZError(syncCtx, "ldap sync failed", err)
+				continue
+			}
+			log.ZInfo(syncCtx, "ldap sync finished", "created", resp.Created, "updated", resp.Updated,
+				"blocked", resp.Blocked, "unblocked", resp.Unblocked, "failed", resp.Failed)
+		}
+	}()
+}
+
+func (o *adminServer) SyncLDAP(ctx context.Context, req *admin.SyncLDAPReq) (*admin.SyncLDAPResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserWrite); err != nil {
+		return nil, err
+	}
+	if o.Directory == nil {
+		return nil, errs.ErrArgs.WrapMsg("ldap is not enabled")
+	}
+	resp, err := o.syncLDAP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditLDAPSync, "", nil, resp)
+	return resp, nil
+}
+
+// syncLDAP registers the members of the configured groups, updates their profiles and blocks the users
+// who left all groups or are disabled. Failures of single users are logged and counted.
+func (o *adminServer) syncLDAP(ctx context.Context) (*admin.SyncLDAPResp, error) {
+	members := make(map[string]*directory.Entry)
+	memberGroupIDs := make(map[string][]string)
+	for _, group := range o.LDAPSync.Groups {
+		entries, err := o.Directory.GroupMembers(ctx, group.DN)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			members[entry.Username] = entry
+			memberGroupIDs[entry.Username] = append(memberGroupIDs[entry.Username], group.IMGroupIDs...)
+		}
+	}
+	users, err := o.Chat.FindLDAPUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+
+	var resp admin.SyncLDAPResp
+	active, inactive, added := directory.Classify(users, members)
+	for _, entry := range added {
+		if err := o.registerLDAPUser(ctx, imCtx, entry, memberGroupIDs[entry.Username]); err != nil {
+			log.ZWarn(ctx, "ldap sync register user failed", err, "username", entry.Username)
+			resp.Failed++
+			continue
+		}
+		resp.Created++
+	}
+	if err := o.updateLDAPUsers(ctx, imCtx, active, users, members, &resp); err != nil {
+		return nil, err
+	}
+	if !o.LDAPSync.BlockRemoved {
+		return &resp, nil
+	}
+	if len(members) == 0 && len(inactive) > 0 {
+		// an empty result is more likely a broken filter than a directory without users
+		log.ZWarn(ctx, "ldap sync found no group members, blocking skipped", nil, "users", len(inactive))
+		return &resp, nil
+	}
+	if err := o.blockLDAPUsers(ctx, imCtx, active, inactive, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// registerLDAPUser registers a directory user the way an administrator adds a user.
+func (o *adminServer) registerLDAPUser(ctx context.Context, imCtx context.Context, entry *directory.Entry, groupIDs []string) error {
+	nickname := entry.Nickname
+	if nickname == "" {
+		nickname = entry.Username
+	}
+	userID, err := o.Chat.AddUserAccount(ctx, &chat.AddUserAccountReq{
+		User: &chat.RegisterUserInfo{
+			Nickname: nickname,
+			Email:    entry.Email,
+		},
+		LdapUsername: entry.Username,
+	})
+	if err != nil {
+		return err
+	}
+	if err := o.IM.RegisterUser(imCtx, []*sdkws.UserInfo{{UserID: userID, Nickname: nickname}}); err != nil {
+		return err
+	}
+	if friendUserIDs, err := o.Database.FindDefaultFriend(ctx, nil); err == nil {
+		_ = o.IM.ImportFriend(imCtx, userID, friendUserIDs)
+	}
+	defaultGroupIDs, err := o.Database.FindDefaultGroup(ctx, nil)
+	if err != nil {
+		log.ZWarn(ctx, "ldap sync find default group failed", err)
+	}
+	return o.IM.InviteToGroup(imCtx, userID, datautil.Distinct(append(defaultGroupIDs, groupIDs...)))
+}
+
+func (o *adminServer) updateLDAPUsers(ctx context.Context, imCtx context.Context, userIDs []string, users map[string]string, members map[string]*directory.Entry, resp *admin.SyncLDAPResp) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	infos, err := o.Chat.MapUserFullInfo(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	for username, userID := range users {
+		entry, info := members[username], infos[userID]
+		if entry == nil || entry.Disabled || info == nil {
+			continue
+		}
+		if err := o.updateLDAPUser(ctx, imCtx, info, entry, resp); err != nil {
+			log.ZWarn(ctx, "ldap sync update user failed", err, "username", username, "userID", userID)
+			resp.Failed++
+		}
+	}
+	return nil
+}
+
+func (o *adminServer) updateLDAPUser(ctx context.Context, imCtx context.Context, info *common.UserFullInfo, entry *directory.Entry, resp *admin.SyncLDAPResp) error {
+	req := &chat.UpdateUserInfoReq{UserID: info.UserID}
+	if entry.Nickname != "" && entry.Nickname != info.Nickname {
+		req.Nickname = wrapperspb.String(entry.Nickname)
+	}
+	if entry.Email != "" && entry.Email != info.Email {
+		req.Email = wrapperspb.String(entry.Email)
+	}
+	if req.Nickname == nil && req.Email == nil {
+		return nil
+	}
+	if err := o.Chat.UpdateUser(ctx, req); err != nil {
+		return err
+	}
+	resp.Updated++
+	if req.Nickname != nil {
+		return o.IM.UpdateUserInfo(imCtx, info.UserID, entry.Nickname, info.FaceURL)
+	}
+	return nil
+}
+
+// blockLDAPUsers blocks the inactive users and unblocks the active users that were blocked by the sync,
+// users blocked by an administrator stay blocked.
+func (o *adminServer) blockLDAPUsers(ctx context.Context, imCtx context.Context, active []string, inactive []string, resp *admin.SyncLDAPResp) error {
+	blocks, err := o.Database.FindBlockInfo(ctx, append(append([]string{}, active...), inactive...))
+	if err != nil {
+		return err
+	}
+	blocked := datautil.SliceToMap(blocks, func(b *admindb.ForbiddenAccount) string { return b.UserID })
+	for _, userID := range inactive {
+		if _, ok := blocked[userID]; ok {
+			continue
+		}
+		if _, err := o.BlockUser(ctx, &admin.BlockUserReq{UserID: userID, Reason: ldapSyncBlockReason}); err != nil {
+			log.ZWarn(ctx, "ldap sync block user failed", err, "userID", userID)
+			resp.Failed++
+			continue
+		}
+		_ = o.IM.ForceOffLine(imCtx, userID)
+		resp.Blocked++
+	}
+	var unblock []string
+	for _, userID := range active {
+		if b, ok := blocked[userID]; ok && b.Reason == ldapSyncBlockReason {
+			unblock = append(unblock, userID)
+		}
+	}
+	if len(unblock) == 0 {
+		return nil
+	}
+	if _, err := o.UnblockUser(ctx, &admin.UnblockUserReq{UserIDs: unblock}); err != nil {
+		return err
+	}
+	resp.Unblocked += int32(len(unblock))
+	return nil
+}
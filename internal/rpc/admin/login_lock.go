@@ -0,0 +1,224 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// maxLoginDelayShift caps the doubling of the login delay before delayMax applies.
+const maxLoginDelayShift = 16
+
+type loginLimit struct {
+	Window         time.Duration // 0 disables failed login accounting
+	AccountMaxFail int
+	IPMaxFail      int
+	LockTime       time.Duration
+	DelayAfter     int
+	DelayBase      time.Duration
+	DelayMax       time.Duration
+}
+
+func (o *adminServer) LoginFailed(ctx context.Context, req *admin.LoginFailedReq) (*admin.LoginFailedResp, error) {
+	kind, err := loginTargetKind(req.UserType)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkLoginLock(ctx, kind, req.UserID, req.Ip); err != nil {
+		return nil, err
+	}
+	if err := o.loginFailed(ctx, kind, req.UserID, req.Ip, nil); err != nil {
+		return nil, err
+	}
+	return &admin.LoginFailedResp{}, nil
+}
+
+func (o *adminServer) LoginSucceeded(ctx context.Context, req *admin.LoginSucceededReq) (*admin.LoginSucceededResp, error) {
+	kind, err := loginTargetKind(req.UserType)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.loginSucceeded(ctx, kind, req.UserID); err != nil {
+		return nil, err
+	}
+	return &admin.LoginSucceededResp{}, nil
+}
+
+func (o *adminServer) SearchLoginLock(ctx context.Context, req *admin.SearchLoginLockReq) (*admin.SearchLoginLockResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
+		return nil, err
+	}
+	total, locks, err := o.Database.SearchLoginLocks(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchLoginLockResp{Total: uint32(total), Locks: make([]*admin.LoginLock, 0, len(locks))}
+	for _, lock := range locks {
+		resp.Locks = append(resp.Locks, &admin.LoginLock{
+			Kind:       lock.Kind,
+			Target:     lock.ID,
+			UnlockTime: lock.UnlockTime,
+		})
+	}
+	return resp, nil
+}
+
+func (o *adminServer) GetLoginLock(ctx context.Context, req *admin.GetLoginLockReq) (*admin.GetLoginLockResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
+		return nil, err
+	}
+	if err := checkLoginLockKind(req.Kind); err != nil {
+		return nil, err
+	}
+	ttl, err := o.Database.LoginLockTTL(ctx, req.Kind, req.Target)
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 {
+		return &admin.GetLoginLockResp{}, nil
+	}
+	return &admin.GetLoginLockResp{Locked: true, UnlockTime: time.Now().Add(ttl).UnixMilli()}, nil
+}
+
+func (o *adminServer) ClearLoginLock(ctx context.Context, req *admin.ClearLoginLockReq) (*admin.ClearLoginLockResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
+		return nil, err
+	}
+	if err := checkLoginLockKind(req.Kind); err != nil {
+		return nil, err
+	}
+	if req.Kind == cache.LoginTargetAdmin && req.Target != mctx.GetOpUserID(ctx) {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.Database.UnlockLogin(ctx, req.Kind, req.Target); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditLoginLockClear, req.Kind+":"+req.Target, nil, nil)
+	return &admin.ClearLoginLockResp{}, nil
+}
+
+// checkLoginLock rejects the login while the account or the ip is locked, either may be empty.
+func (o *adminServer) checkLoginLock(ctx context.Context, kind string, userID string, ip string) error {
+	if o.LoginLimit.Window <= 0 {
+		return nil
+	}
+	targets := make([][2]string, 0, 2)
+	if userID != "" {
+		targets = append(targets, [2]string{kind, userID})
+	}
+	if ip != "" {
+		targets = append(targets, [2]string{cache.LoginTargetIP, ip})
+	}
+	for _, target := range targets {
+		ttl, err := o.Database.LoginLockTTL(ctx, target[0], target[1])
+		if err != nil {
+			return err
+		}
+		if ttl > 0 {
+			return eerrs.ErrLoginLocked.WrapMsg("too many failed login attempts", "kind", target[0], "retryAfter", int64(ttl.Seconds())+1)
+		}
+	}
+	return nil
+}
+
+// loginFailed counts a failed login of the account and the ip. It returns the lock error when this failure
+// locks either of them, otherwise it returns cause after the progressive delay of the account.
+func (o *adminServer) loginFailed(ctx context.Context, kind string, userID string, ip string, cause error) error {
+	if o.LoginLimit.Window <= 0 {
+		return cause
+	}
+	var count int64
+	if userID != "" {
+		n, locked, err := o.Database.LoginFail(ctx, kind, userID, o.LoginLimit.Window, o.LoginLimit.AccountMaxFail, o.LoginLimit.LockTime)
+		if err != nil {
+			return err
+		}
+		if locked {
+			log.ZWarn(ctx, "account login locked", nil, "kind", kind, "userID", userID, "ip", ip, "failures", n)
+			return eerrs.ErrLoginLocked.WrapMsg("too many failed login attempts", "kind", kind, "retryAfter", int64(o.LoginLimit.LockTime.Seconds()))
+		}
+		count = n
+	}
+	if ip != "" {
+		n, locked, err := o.Database.LoginFail(ctx, cache.LoginTargetIP, ip, o.LoginLimit.Window, o.LoginLimit.IPMaxFail, o.LoginLimit.LockTime)
+		if err != nil {
+			return err
+		}
+		if locked {
+			log.ZWarn(ctx, "ip login locked", nil, "ip", ip, "failures", n)
+			return eerrs.ErrLoginLocked.WrapMsg("too many failed login attempts", "kind", cache.LoginTargetIP, "retryAfter", int64(o.LoginLimit.LockTime.Seconds()))
+		}
+	}
+	if delay := o.loginDelay(count); delay > 0 {
+		select {
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+	return cause
+}
+
+// loginSucceeded forgets the failures of the account, the failures of the ip are kept.
+func (o *adminServer) loginSucceeded(ctx context.Context, kind string, userID string) error {
+	if o.LoginLimit.Window <= 0 {
+		return nil
+	}
+	return o.Database.ResetLoginFail(ctx, kind, userID)
+}
+
+func (o *adminServer) loginDelay(count int64) time.Duration {
+	n := count - int64(o.LoginLimit.DelayAfter)
+	if n <= 0 || o.LoginLimit.DelayBase <= 0 {
+		return 0
+	}
+	delay := o.LoginLimit.DelayBase << min(n-1, maxLoginDelayShift)
+	if o.LoginLimit.DelayMax > 0 && delay > o.LoginLimit.DelayMax {
+		delay = o.LoginLimit.DelayMax
+	}
+	return delay
+}
+
+func loginTargetKind(userType int32) (string, error) {
+	switch userType {
+	case constant.NormalUser:
+		return cache.LoginTargetUser, nil
+	case constant.AdminUser:
+		return cache.LoginTargetAdmin, nil
+	default:
+		return "", errs.ErrArgs.WrapMsg("invalid user type", "userType", userType)
+	}
+}
+
+func checkLoginLockKind(kind string) error {
+	switch kind {
+	case cache.LoginTargetUser, cache.LoginTargetAdmin, cache.LoginTargetIP, cache.LoginTargetTestAccount:
+		return nil
+	default:
+		return errs.ErrArgs.WrapMsg("invalid kind", "kind", kind)
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// Applets are the OpenID Connect clients of chat, the AppID of an applet is its client id.
+// Only the authorization code flow is supported, clients without a secret must use PKCE (S256).
+
+type oidcProvider struct {
+	Issuer      string // empty when the provider is disabled
+	CodeExpire  time.Duration
+	TokenExpire time.Duration
+}
+
+// oidcScopes are the supported scopes, other requested scopes are ignored.
+var oidcScopes = []string{"openid", "profile", "email", "phone"}
+
+func oidcHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func oidcRandom() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func (o *adminServer) oidcEnabled() error {
+	if o.OIDC.Issuer == "" {
+		return errs.ErrInternalServer.WrapMsg("oidc provider is not enabled")
+	}
+	return nil
+}
+
+// oidcClient returns the applet of a client id, it must be on the shelf and have redirect URIs.
+func (o *adminServer) oidcClient(ctx context.Context, clientID string) (*admindb.Applet, error) {
+	if clientID == "" {
+		return nil, errs.ErrArgs.WrapMsg("client id is empty")
+	}
+	applets, err := o.Database.FindAppletByAppID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if len(applets) != 1 || len(applets[0].RedirectURIs) == 0 || applets[0].Status != constant.StatusOnShelf {
+		return nil, errs.ErrNoPermission.WrapMsg("unknown client", "clientID", clientID)
+	}
+	return applets[0], nil
+}
+
+func (o *adminServer) SetAppletClient(ctx context.Context, req *admin.SetAppletClientReq) (*admin.SetAppletClientResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionAppletManage); err != nil {
+		return nil, err
+	}
+	applet, err := o.Database.GetApplet(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if applet.AppID == "" {
+		return nil, errs.ErrArgs.WrapMsg("applet has no app id")
+	}
+	applets, err := o.Database.FindAppletByAppID(ctx, applet.AppID)
+	if err != nil {
+		return nil, err
+	}
+	if len(applets) > 1 {
+		return nil, errs.ErrArgs.WrapMsg("app id is used by several applets", "appID", applet.AppID)
+	}
+	for _, uri := range req.RedirectURIs {
+		u, err := url.Parse(uri)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return nil, errs.ErrArgs.WrapMsg("redirect uri must be an absolute url without fragment", "redirectURI", uri)
+		}
+	}
+	update := map[string]any{"redirect_uris": req.RedirectURIs}
+	var resp admin.SetAppletClientResp
+	if req.ResetSecret {
+		resp.ClientSecret, err = oidcRandom()
+		if err != nil {
+			return nil, err
+		}
+		update["client_secret"], err = o.Password.Hash(resp.ClientSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := o.Database.UpdateApplet(ctx, applet.ID, update); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditAppletClientSet, applet.ID, map[string]any{"redirect_uris": applet.RedirectURIs},
+		map[string]any{"redirect_uris": req.RedirectURIs, "reset_secret": req.ResetSecret})
+	return &resp, nil
+}
+
+// OIDCAuthorize issues an authorization code to a client for the current user, the client of chat
+// calls it with the token of the user before it opens the applet at the returned url.
+func (o *adminServer) OIDCAuthorize(ctx context.Context, req *admin.OIDCAuthorizeReq) (*admin.OIDCAuthorizeResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.oidcEnabled(); err != nil {
+		return nil, err
+	}
+	applet, err := o.oidcClient(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(applet.RedirectURIs, req.RedirectURI) {
+		return nil, errs.ErrArgs.WrapMsg("redirect uri is not registered", "redirectURI", req.RedirectURI)
+	}
+	if req.ResponseType != "code" {
+		return nil, errs.ErrArgs.WrapMsg("unsupported response type", "responseType", req.ResponseType)
+	}
+	var scopes []string
+	for _, scope := range strings.Fields(req.Scope) {
+		if slices.Contains(oidcScopes, scope) && !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if !slices.Contains(scopes, "openid") {
+		return nil, errs.ErrArgs.WrapMsg("scope must contain openid")
+	}
+	if req.CodeChallenge != "" && req.CodeChallengeMethod != "S256" {
+		return nil, errs.ErrArgs.WrapMsg("code challenge method must be S256")
+	}
+	if req.CodeChallenge == "" && applet.ClientSecret == "" {
+		return nil, errs.ErrArgs.WrapMsg("public clients must use pkce")
+	}
+	code, err := oidcRandom()
+	if err != nil {
+		return nil, err
+	}
+	grant := &cache.OIDCGrant{
+		ClientID:      applet.AppID,
+		UserID:        userID,
+		RedirectURI:   req.RedirectURI,
+		Scope:         strings.Join(scopes, " "),
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+		AuthTime:      time.Now().Unix(),
+	}
+	if err := o.Database.SetOIDCCode(ctx, oidcHash(code), grant, o.OIDC.CodeExpire); err != nil {
+		return nil, err
+	}
+	redirect, err := url.Parse(req.RedirectURI)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid redirect uri")
+	}
+	query := redirect.Query()
+	query.Set("code", code)
+	if req.State != "" {
+		query.Set("state", req.State)
+	}
+	redirect.RawQuery = query.Encode()
+	return &admin.OIDCAuthorizeResp{RedirectURL: redirect.String()}, nil
+}
+
+// OIDCToken redeems an authorization code for an id token and an access token of the userinfo endpoint.
+// Errors of the client are ErrNoPermission, errors of the grant ErrArgs.
+func (o *adminServer) OIDCToken(ctx context.Context, req *admin.OIDCTokenReq) (*admin.OIDCTokenResp, error) {
+	if err := o.oidcEnabled(); err != nil {
+		return nil, err
+	}
+	applet, err := o.oidcClient(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if applet.ClientSecret != "" {
+		match, err := o.Password.Verify(applet.ClientSecret, req.ClientSecret)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			return nil, errs.ErrNoPermission.WrapMsg("invalid client secret")
+		}
+	}
+	grant, err := o.Database.TakeOIDCCode(ctx, oidcHash(req.Code))
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("invalid authorization code")
+		}
+		return nil, err
+	}
+	if grant.ClientID != applet.AppID || grant.RedirectURI != req.RedirectURI {
+		return nil, errs.ErrArgs.WrapMsg("invalid authorization code")
+	}
+	if grant.CodeChallenge != "" {
+		sum := sha256.Sum256([]byte(req.CodeVerifier))
+		if subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(grant.CodeChallenge)) != 1 {
+			return nil, errs.ErrArgs.WrapMsg("invalid code verifier")
+		}
+	}
+	if _, err := o.Database.GetBlockInfo(ctx, grant.UserID); err == nil {
+		return nil, errs.ErrArgs.WrapMsg("user is blocked")
+	} else if !IsNotFound(err) {
+		return nil, err
+	}
+	claims, err := o.oidcClaims(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	claims["iss"] = o.OIDC.Issuer
+	claims["aud"] = grant.ClientID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(o.OIDC.TokenExpire).Unix()
+	claims["auth_time"] = grant.AuthTime
+	if grant.Nonce != "" {
+		claims["nonce"] = grant.Nonce
+	}
+	idToken, err := o.Token.SignClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := oidcRandom()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.SetOIDCAccessToken(ctx, oidcHash(accessToken), grant, o.OIDC.TokenExpire); err != nil {
+		return nil, err
+	}
+	return &admin.OIDCTokenResp{
+		AccessToken: accessToken,
+		IdToken:     idToken,
+		ExpiresIn:   int64(o.OIDC.TokenExpire / time.Second),
+		Scope:       grant.Scope,
+	}, nil
+}
+
+func (o *adminServer) OIDCUserInfo(ctx context.Context, req *admin.OIDCUserInfoReq) (*admin.OIDCUserInfoResp, error) {
+	if err := o.oidcEnabled(); err != nil {
+		return nil, err
+	}
+	grant, err := o.Database.GetOIDCAccessToken(ctx, oidcHash(req.AccessToken))
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrNoPermission.WrapMsg("invalid access token")
+		}
+		return nil, err
+	}
+	claims, err := o.oidcClaims(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &admin.OIDCUserInfoResp{Claims: string(data)}, nil
+}
+
+// oidcClaims returns the claims of the user that the scope of the grant allows.
+func (o *adminServer) oidcClaims(ctx context.Context, grant *cache.OIDCGrant) (jwt.MapClaims, error) {
+	user, err := o.Chat.GetUserFullInfo(mctx.WithAdminUser(ctx, o.ChatAdminUserID), grant.UserID)
+	if err != nil {
+		return nil, err
+	}
+	claims := jwt.MapClaims{"sub": user.UserID}
+	for _, scope := range strings.Fields(grant.Scope) {
+		switch scope {
+		case "profile":
+			claims["name"] = user.Nickname
+			if user.Account != "" {
+				claims["preferred_username"] = user.Account
+			}
+			if user.FaceURL != "" {
+				claims["picture"] = user.FaceURL
+			}
+			if user.Birth > 0 {
+				claims["birthdate"] = time.UnixMilli(user.Birth).UTC().Format(time.DateOnly)
+			}
+		case "email":
+			if user.Email != "" {
+				claims["email"] = user.Email
+			}
+		case "phone":
+			if user.PhoneNumber != "" {
+				claims["phone_number"] = user.AreaCode + user.PhoneNumber
+			}
+		}
+	}
+	return claims, nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/openimsdk/tools/errs"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
@@ -28,7 +29,7 @@ import (
 )
 
 func (o *adminServer) AddDefaultFriend(ctx context.Context, req *admin.AddDefaultFriendReq) (*admin.AddDefaultFriendResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -62,11 +63,12 @@ func (o *adminServer) AddDefaultFriend(ctx context.Context, req *admin.AddDefaul
 	if err := o.Database.AddDefaultFriend(ctx, ms); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditDefaultFriendAdd, "", nil, req.UserIDs)
 	return &admin.AddDefaultFriendResp{}, nil
 }
 
 func (o *adminServer) DelDefaultFriend(ctx context.Context, req *admin.DelDefaultFriendReq) (*admin.DelDefaultFriendResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -93,6 +95,7 @@ func (o *adminServer) DelDefaultFriend(ctx context.Context, req *admin.DelDefaul
 	if err := o.Database.DelDefaultFriend(ctx, req.UserIDs); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditDefaultFriendDel, "", req.UserIDs, nil)
 	return &admin.DelDefaultFriendResp{}, nil
 }
 
@@ -108,7 +111,7 @@ func (o *adminServer) FindDefaultFriend(ctx context.Context, req *admin.FindDefa
 }
 
 func (o *adminServer) SearchDefaultFriend(ctx context.Context, req *admin.SearchDefaultFriendReq) (*admin.SearchDefaultFriendResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	total, infos, err := o.Database.SearchDefaultFriend(ctx, req.Keyword, req.Pagination)
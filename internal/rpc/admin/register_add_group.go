@@ -21,13 +21,14 @@ import (
 
 	"github.com/openimsdk/tools/errs"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
 func (o *adminServer) AddDefaultGroup(ctx context.Context, req *admin.AddDefaultGroupReq) (*admin.AddDefaultGroupResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	if len(req.GroupIDs) == 0 {
@@ -54,11 +55,12 @@ func (o *adminServer) AddDefaultGroup(ctx context.Context, req *admin.AddDefault
 	if err := o.Database.AddDefaultGroup(ctx, ms); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditDefaultGroupAdd, "", nil, req.GroupIDs)
 	return &admin.AddDefaultGroupResp{}, nil
 }
 
 func (o *adminServer) DelDefaultGroup(ctx context.Context, req *admin.DelDefaultGroupReq) (*admin.DelDefaultGroupResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	if len(req.GroupIDs) == 0 {
@@ -85,6 +87,7 @@ func (o *adminServer) DelDefaultGroup(ctx context.Context, req *admin.DelDefault
 	if err := o.Database.DelDefaultGroup(ctx, req.GroupIDs); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditDefaultGroupDel, "", req.GroupIDs, nil)
 	return &admin.DelDefaultGroupResp{}, nil
 }
 
@@ -100,7 +103,7 @@ func (o *adminServer) FindDefaultGroup(ctx context.Context, req *admin.FindDefau
 }
 
 func (o *adminServer) SearchDefaultGroup(ctx context.Context, req *admin.SearchDefaultGroupReq) (*admin.SearchDefaultGroupResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionRegisterManage); err != nil {
 		return nil, err
 	}
 	total, infos, err := o.Database.SearchDefaultGroup(ctx, req.Keyword, req.Pagination)
@@ -0,0 +1,243 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func (o *adminServer) AddRole(ctx context.Context, req *admin.AddRoleReq) (*admin.AddRoleResp, error) {
+	if err := o.checkSuperAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	name, err := checkRole(req.Name, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	role := &admindb.Role{
+		RoleID:      uuid.New().String(),
+		Name:        name,
+		Permissions: nonNil(req.Permissions),
+		CreateTime:  time.Now(),
+	}
+	if err := o.Database.AddRole(ctx, []*admindb.Role{role}); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditRoleAdd, role.RoleID, nil, role)
+	return &admin.AddRoleResp{RoleID: role.RoleID}, nil
+}
+
+func (o *adminServer) UpdateRole(ctx context.Context, req *admin.UpdateRoleReq) (*admin.UpdateRoleResp, error) {
+	if err := o.checkSuperAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	name, err := checkRole(req.Name, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	before, err := o.Database.TakeRole(ctx, req.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	update := map[string]any{
+		"name":        name,
+		"permissions": nonNil(req.Permissions),
+	}
+	if err := o.Database.UpdateRole(ctx, req.RoleID, update); err != nil {
+		return nil, err
+	}
+	after := *before
+	after.Name, after.Permissions = name, nonNil(req.Permissions)
+	o.audit(ctx, constant.AuditRoleUpdate, req.RoleID, before, &after)
+	return &admin.UpdateRoleResp{}, nil
+}
+
+func (o *adminServer) DelRole(ctx context.Context, req *admin.DelRoleReq) (*admin.DelRoleResp, error) {
+	if err := o.checkSuperAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	roles, err := o.Database.FindRole(ctx, req.RoleIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelRole(ctx, req.RoleIDs); err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		o.audit(ctx, constant.AuditRoleDel, role.RoleID, role, nil)
+	}
+	return &admin.DelRoleResp{}, nil
+}
+
+func (o *adminServer) SearchRole(ctx context.Context, req *admin.SearchRoleReq) (*admin.SearchRoleResp, error) {
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, roles, err := o.Database.SearchRole(ctx, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchRoleResp{
+		Total: uint32(total),
+		Roles: datautil.Slice(roles, roleDB2PB),
+	}, nil
+}
+
+func (o *adminServer) SetAdminRole(ctx context.Context, req *admin.SetAdminRoleReq) (*admin.SetAdminRoleResp, error) {
+	if err := o.checkSuperAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if a.Level == constant.AdvancedUserLevel {
+		return nil, errs.ErrArgs.WrapMsg("the super admin has all permissions")
+	}
+	if err := o.checkRoleIDs(ctx, req.RoleIDs); err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, req.UserID, map[string]any{"role_ids": nonNil(req.RoleIDs)}); err != nil {
+		return nil, err
+	}
+	after := auditAdmin(a)
+	after.RoleIDs = nonNil(req.RoleIDs)
+	o.audit(ctx, constant.AuditAdminSetRole, req.UserID, auditAdmin(a), after)
+	return &admin.SetAdminRoleResp{}, nil
+}
+
+func (o *adminServer) GetPermissions(ctx context.Context, req *admin.GetPermissionsReq) (*admin.GetPermissionsResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return &admin.GetPermissionsResp{Permissions: constant.Permissions}, nil
+}
+
+// CheckPermission is called by the apis for the routes that need a permission.
+func (o *adminServer) CheckPermission(ctx context.Context, req *admin.CheckPermissionReq) (*admin.CheckPermissionResp, error) {
+	if _, err := o.checkPermission(ctx, req.Permission); err != nil {
+		return nil, err
+	}
+	return &admin.CheckPermissionResp{}, nil
+}
+
+// checkPermission checks that the operator is an admin with the permission and returns its user id.
+func (o *adminServer) checkPermission(ctx context.Context, permission string) (string, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+	// the services act as the chat admin, which has no admin account
+	if userID == o.ChatAdminUserID {
+		return userID, nil
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	permissions, err := o.adminPermissions(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	if !datautil.Contain(permission, permissions...) {
+		return "", errs.ErrNoPermission.WrapMsg("missing permission " + permission)
+	}
+	return userID, nil
+}
+
+// adminPermissions returns the permissions granted by the roles of the admin, or all of them for the super admin.
+func (o *adminServer) adminPermissions(ctx context.Context, a *admindb.Admin) ([]string, error) {
+	if a.Level == constant.AdvancedUserLevel {
+		return constant.Permissions, nil
+	}
+	roles, err := o.Database.FindRole(ctx, a.RoleIDs)
+	if err != nil {
+		return nil, err
+	}
+	var permissions []string
+	for _, role := range roles {
+		permissions = append(permissions, role.Permissions...)
+	}
+	return datautil.Distinct(permissions), nil
+}
+
+func (o *adminServer) checkRoleIDs(ctx context.Context, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	roles, err := o.Database.FindRole(ctx, roleIDs)
+	if err != nil {
+		return err
+	}
+	if len(roles) != len(datautil.Distinct(roleIDs)) {
+		return errs.ErrRecordNotFound.WrapMsg("role not found")
+	}
+	return nil
+}
+
+// initRole gives the normal admins from before roles existed a role with all permissions, so they
+// keep their access until the super admin changes their roles.
+func (o *adminServer) initRole(ctx context.Context) error {
+	return o.Database.InitRole(ctx, &admindb.Role{
+		RoleID:      uuid.New().String(),
+		Name:        "Administrator",
+		Permissions: constant.Permissions,
+		CreateTime:  time.Now(),
+	})
+}
+
+func roleDB2PB(r *admindb.Role) *admin.Role {
+	return &admin.Role{
+		RoleID:      r.RoleID,
+		Name:        r.Name,
+		Permissions: r.Permissions,
+		CreateTime:  r.CreateTime.UnixMilli(),
+	}
+}
+
+// checkRole returns the trimmed name of the role, a role needs a name and only known permissions.
+func checkRole(name string, permissions []string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errs.ErrArgs.WrapMsg("role name is empty")
+	}
+	if datautil.Duplicate(permissions) {
+		return "", errs.ErrArgs.WrapMsg("permissions is duplicate")
+	}
+	for _, permission := range permissions {
+		if !datautil.Contain(permission, constant.Permissions...) {
+			return "", errs.ErrArgs.WrapMsg("unknown permission", "permission", permission)
+		}
+	}
+	return name, nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
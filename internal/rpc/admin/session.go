@@ -0,0 +1,126 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"sort"
+
+	constantpb "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func (o *adminServer) GetSessions(ctx context.Context, req *adminpb.GetSessionsReq) (*adminpb.GetSessionsResp, error) {
+	userID, err := o.checkSessionUser(ctx, req.UserID, constant.PermissionUserRead)
+	if err != nil {
+		return nil, err
+	}
+	families, err := o.Database.GetRefreshFamilies(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].LastSeen > families[j].LastSeen
+	})
+	sessions := make([]*adminpb.Session, 0, len(families))
+	for _, family := range families {
+		sessions = append(sessions, &adminpb.Session{
+			SessionID:  family.SessionID,
+			PlatformID: family.PlatformID,
+			Platform:   constantpb.PlatformIDToName(int(family.PlatformID)),
+			DeviceID:   family.DeviceID,
+			Ip:         family.IP,
+			CreateTime: family.CreateTime,
+			LastSeen:   family.LastSeen,
+		})
+	}
+	return &adminpb.GetSessionsResp{Sessions: sessions}, nil
+}
+
+func (o *adminServer) RevokeSession(ctx context.Context, req *adminpb.RevokeSessionReq) (*adminpb.RevokeSessionResp, error) {
+	userID, err := o.checkSessionUser(ctx, req.UserID, constant.PermissionUserWrite)
+	if err != nil {
+		return nil, err
+	}
+	families, err := o.Database.GetRefreshFamilies(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var family *cache.RefreshFamily
+	for _, f := range families {
+		if f.SessionID == req.SessionID {
+			family = f
+			break
+		}
+	}
+	if family == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("session not found", "sessionID", req.SessionID)
+	}
+	if err := o.Database.DelRefreshFamily(ctx, userID, family.FamilyID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DeleteTokenFlag(ctx, userID, family.AccessToken); err != nil {
+		return nil, err
+	}
+	if userID != mctx.GetOpUserID(ctx) {
+		// the family holds the tokens, only the public fields are logged
+		session := &adminpb.Session{
+			SessionID:  family.SessionID,
+			PlatformID: family.PlatformID,
+			DeviceID:   family.DeviceID,
+			Ip:         family.IP,
+			CreateTime: family.CreateTime,
+			LastSeen:   family.LastSeen,
+		}
+		o.audit(ctx, constant.AuditSessionRevoke, userID, session, nil)
+	}
+	return &adminpb.RevokeSessionResp{
+		UserID:     userID,
+		UserType:   family.UserType,
+		PlatformID: family.PlatformID,
+	}, nil
+}
+
+// checkSessionUser returns whose sessions are accessed. Users can only access their own sessions,
+// admins with the permission can access any user's sessions, but only the super admin can access the
+// sessions of other admins.
+func (o *adminServer) checkSessionUser(ctx context.Context, userID string, permission string) (string, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return "", err
+	}
+	if userID == "" || userID == opUserID {
+		return opUserID, nil
+	}
+	if userType != constant.AdminUser {
+		return "", errs.ErrNoPermission.WrapMsg("no permission access other user sessions")
+	}
+	if _, err := o.Database.GetAdminUserID(ctx, userID); err == nil {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return "", err
+		}
+	} else if !dbutil.IsDBNotFound(err) {
+		return "", err
+	} else if _, err := o.checkPermission(ctx, permission); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
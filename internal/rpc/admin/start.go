@@ -5,14 +5,19 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"math/rand"
+	"strings"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/captcha"
 	"github.com/openimsdk/chat/pkg/common/config"
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/database"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/directory"
+	"github.com/openimsdk/chat/pkg/password"
 	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
@@ -61,13 +66,98 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	srv.Chat = chatClient.NewChatClient(chat.NewChatClient(conn))
+	srv.Password, err = config.Share.PasswordHash.Build()
+	if err != nil {
+		return err
+	}
+	srv.TwoFactorIssuer = config.RpcConfig.TwoFactor.Issuer
+	srv.TwoFactorRequiredLevel = config.RpcConfig.TwoFactor.RequiredLevel
+	limit := config.RpcConfig.LoginLimit
+	srv.LoginLimit = loginLimit{
+		Window:         time.Duration(limit.Window) * time.Second,
+		AccountMaxFail: limit.AccountMaxFail,
+		IPMaxFail:      limit.IPMaxFail,
+		LockTime:       time.Duration(limit.LockTime) * time.Second,
+		DelayAfter:     limit.DelayAfter,
+		DelayBase:      time.Duration(limit.DelayBase) * time.Millisecond,
+		DelayMax:       time.Duration(limit.DelayMax) * time.Millisecond,
+	}
+	if srv.LoginLimit.Window > 0 && (limit.AccountMaxFail > 0 || limit.IPMaxFail > 0) && srv.LoginLimit.LockTime <= 0 {
+		return errs.New("loginLimit lockTime must be positive")
+	}
+	srv.Captcha, err = config.RpcConfig.Captcha.Build(captchaStore{db: srv.Database})
+	if err != nil {
+		return err
+	}
+	srv.CaptchaLimit = captchaLimit{
+		Threshold: config.RpcConfig.Captcha.Threshold,
+		Window:    time.Duration(config.RpcConfig.Captcha.Window) * time.Second,
+	}
+	if srv.Captcha != nil && srv.CaptchaLimit.Threshold > 0 && srv.CaptchaLimit.Window <= 0 {
+		return errs.New("captcha window must be positive")
+	}
 	srv.Token = &tokenverify.Token{
-		Expires: time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
-		Secret:  config.RpcConfig.Secret,
+		Expires:        time.Duration(config.RpcConfig.TokenPolicy.AccessExpire) * time.Minute,
+		RefreshExpires: time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
+		Secret:         config.RpcConfig.Secret,
+	}
+	if srv.Token.Expires <= 0 || srv.Token.Expires > srv.Token.RefreshExpires {
+		srv.Token.Expires = srv.Token.RefreshExpires
+	}
+	srv.Token.SigningKeys, err = config.RpcConfig.SigningKeys.Build()
+	if err != nil {
+		return err
 	}
+	srv.Token.ActiveKeyID = config.RpcConfig.SigningKeys.Active
 	if err := srv.initAdmin(ctx, config.Share.ChatAdmin, config.Share.OpenIM.AdminUserID); err != nil {
 		return err
 	}
+	if err := srv.initRole(ctx); err != nil {
+		return err
+	}
+	srv.ChatAdminUserID = config.Share.ChatAdmin[0]
+	srv.IM = imapi.New(config.Share.OpenIM.ApiURL, config.Share.OpenIM.Secret, config.Share.OpenIM.AdminUserID)
+	srv.Directory, err = config.Share.LDAP.Build()
+	if err != nil {
+		return err
+	}
+	srv.LDAPSync = ldapSync{
+		Interval:     time.Duration(config.RpcConfig.LDAPSync.Interval) * time.Second,
+		BlockRemoved: config.RpcConfig.LDAPSync.BlockRemoved,
+	}
+	for _, group := range config.RpcConfig.LDAPSync.Groups {
+		srv.LDAPSync.Groups = append(srv.LDAPSync.Groups, ldapSyncGroup{DN: group.DN, IMGroupIDs: group.IMGroupIDs})
+	}
+	srv.startLDAPSync(ctx)
+	srv.UserDeletion = userDeletion{
+		Interval: time.Duration(config.RpcConfig.UserDeletion.Interval) * time.Second,
+		Batch:    int32(config.RpcConfig.UserDeletion.Batch),
+	}
+	if srv.UserDeletion.Batch <= 0 {
+		srv.UserDeletion.Batch = 100
+	}
+	srv.startUserDeletion(ctx)
+	srv.BanExpiry = banExpiry{
+		Interval: time.Duration(config.RpcConfig.BanExpiry.Interval) * time.Second,
+		Batch:    int64(config.RpcConfig.BanExpiry.Batch),
+	}
+	if srv.BanExpiry.Batch <= 0 {
+		srv.BanExpiry.Batch = 100
+	}
+	srv.startBanExpiry(ctx)
+	srv.OIDC = oidcProvider{
+		Issuer:      strings.TrimSuffix(config.Share.OIDCProvider.Issuer, "/"),
+		CodeExpire:  time.Duration(config.Share.OIDCProvider.CodeExpire) * time.Second,
+		TokenExpire: time.Duration(config.Share.OIDCProvider.TokenExpire) * time.Second,
+	}
+	if srv.OIDC.Issuer != "" {
+		if srv.Token.ActiveKeyID == "" {
+			return errs.New("oidcProvider requires an active signing key to sign id tokens")
+		}
+		if srv.OIDC.CodeExpire <= 0 || srv.OIDC.TokenExpire <= 0 {
+			return errs.New("oidcProvider codeExpire and tokenExpire must be positive")
+		}
+	}
 	adminpb.RegisterAdminServer(server, &srv)
 	return nil
 }
@@ -77,6 +167,22 @@ type adminServer struct {
 	Database database.AdminDatabaseInterface
 	Chat     *chatClient.ChatClient
 	Token    *tokenverify.Token
+	Password password.Hasher
+	IM       imapi.CallerInterface
+	// Directory is nil when ldap is disabled
+	Directory *directory.Directory
+	// Captcha is nil when challenges are disabled
+	Captcha captcha.Provider
+
+	TwoFactorIssuer        string
+	TwoFactorRequiredLevel int32
+	LoginLimit             loginLimit
+	CaptchaLimit           captchaLimit
+	LDAPSync               ldapSync
+	UserDeletion           userDeletion
+	BanExpiry              banExpiry
+	OIDC                   oidcProvider
+	ChatAdminUserID        string
 }
 
 func (o *adminServer) initAdmin(ctx context.Context, admins []string, imUserID string) error {
@@ -87,10 +193,14 @@ func (o *adminServer) initAdmin(ctx context.Context, admins []string, imUserID s
 			return err
 		}
 		sum := md5.Sum([]byte(account))
+		hashed, err := o.Password.Hash(hex.EncodeToString(sum[:]))
+		if err != nil {
+			return err
+		}
 		a := admin.Admin{
 			Account:    account,
 			UserID:     imUserID,
-			Password:   hex.EncodeToString(sum[:]),
+			Password:   hashed,
 			Level:      constant.DefaultAdminLevel,
 			CreateTime: time.Now(),
 		}
@@ -0,0 +1,221 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/phonenumber"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// minTestAccountCodeLen keeps the fixed codes, which log in as the accounts, from being trivial to guess.
+const minTestAccountCodeLen = 8
+
+// Wrong codes of a test account are counted whether login locks are enabled or not, a fixed code would
+// otherwise be open to guessing through the verify code and password reset endpoints.
+const (
+	testAccountFailWindow = time.Hour
+	testAccountMaxFail    = 5
+	testAccountLockTime   = time.Hour
+)
+
+// testAccountCode returns the trimmed code of a test account, codes are compared as typed so they may not contain spaces.
+func testAccountCode(account string, code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if len(code) < minTestAccountCodeLen {
+		return "", errs.ErrArgs.WrapMsg("code is too short", "account", account, "min", minTestAccountCodeLen)
+	}
+	if strings.ContainsFunc(code, unicode.IsSpace) {
+		return "", errs.ErrArgs.WrapMsg("code contains spaces", "account", account)
+	}
+	return code, nil
+}
+
+// testAccountKey returns the account the way the chat service keys verify codes, an email or
+// the area code and phone number joined by a space.
+func testAccountKey(account string) (string, error) {
+	account = strings.TrimSpace(account)
+	if strings.Contains(account, "@") {
+		if err := chat.EmailCheck(account); err != nil {
+			return "", errs.ErrArgs.WrapMsg("email is invalid", "account", account)
+		}
+		return account, nil
+	}
+	fields := strings.Fields(account)
+	if len(fields) != 2 {
+		return "", errs.ErrArgs.WrapMsg("account must be an email or an area code and phone number separated by a space", "account", account)
+	}
+	areaCode, phoneNumber, err := phonenumber.Normalize(fields[0], fields[1])
+	if err != nil {
+		return "", err
+	}
+	return areaCode + " " + phoneNumber, nil
+}
+
+func (o *adminServer) AddTestAccount(ctx context.Context, req *admin.AddTestAccountReq) (*admin.AddTestAccountResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	tables := make([]*admindb.TestAccount, 0, len(req.TestAccounts))
+	for _, t := range req.TestAccounts {
+		account, err := testAccountKey(t.Account)
+		if err != nil {
+			return nil, err
+		}
+		code, err := testAccountCode(account, t.Code)
+		if err != nil {
+			return nil, err
+		}
+		var expireTime time.Time
+		if t.ExpireTime > 0 {
+			expireTime = time.UnixMilli(t.ExpireTime)
+			if !expireTime.After(now) {
+				return nil, errs.ErrArgs.WrapMsg("expire time has passed", "account", account)
+			}
+		}
+		tables = append(tables, &admindb.TestAccount{
+			Account:    account,
+			Code:       code,
+			Remark:     t.Remark,
+			ExpireTime: expireTime,
+			CreateTime: now,
+		})
+	}
+	if datautil.Duplicate(datautil.Slice(tables, func(t *admindb.TestAccount) string { return t.Account })) {
+		return nil, errs.ErrArgs.WrapMsg("duplicate account")
+	}
+	if err := o.Database.SetTestAccount(ctx, tables); err != nil {
+		return nil, err
+	}
+	// the codes log in as the accounts, they are left out of the audit log
+	o.audit(ctx, constant.AuditTestAccountSet, "", nil, datautil.Slice(tables, func(t *admindb.TestAccount) *admindb.TestAccount {
+		c := *t
+		c.Code = ""
+		return &c
+	}))
+	return &admin.AddTestAccountResp{}, nil
+}
+
+func (o *adminServer) DelTestAccount(ctx context.Context, req *admin.DelTestAccountReq) (*admin.DelTestAccountResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
+		return nil, err
+	}
+	accounts := make([]string, 0, len(req.Accounts))
+	for _, account := range req.Accounts {
+		accounts = append(accounts, account)
+		if key, err := testAccountKey(account); err == nil && key != account {
+			accounts = append(accounts, key)
+		}
+	}
+	if err := o.Database.DelTestAccount(ctx, accounts); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditTestAccountDel, "", req.Accounts, nil)
+	return &admin.DelTestAccountResp{}, nil
+}
+
+func (o *adminServer) SearchTestAccount(ctx context.Context, req *admin.SearchTestAccountReq) (*admin.SearchTestAccountResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
+		return nil, err
+	}
+	total, tables, err := o.Database.SearchTestAccount(ctx, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchTestAccountResp{
+		Total:        uint32(total),
+		TestAccounts: datautil.Slice(tables, testAccountDB2PB),
+	}, nil
+}
+
+// GetTestAccount is called by the chat service before it sends a verify code.
+func (o *adminServer) GetTestAccount(ctx context.Context, req *admin.GetTestAccountReq) (*admin.GetTestAccountResp, error) {
+	t, err := o.Database.TakeTestAccount(ctx, req.Account)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return &admin.GetTestAccountResp{}, nil
+		}
+		return nil, err
+	}
+	if !t.Valid(time.Now()) {
+		return &admin.GetTestAccountResp{}, nil
+	}
+	return &admin.GetTestAccountResp{TestAccount: testAccountDB2PB(t)}, nil
+}
+
+// CheckTestAccountCode is called by the chat service to check a verify code typed for a test account.
+func (o *adminServer) CheckTestAccountCode(ctx context.Context, req *admin.CheckTestAccountCodeReq) (*admin.CheckTestAccountCodeResp, error) {
+	t, err := o.Database.TakeTestAccount(ctx, req.Account)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return &admin.CheckTestAccountCodeResp{}, nil
+		}
+		return nil, err
+	}
+	if !t.Valid(time.Now()) {
+		return &admin.CheckTestAccountCodeResp{}, nil
+	}
+	ttl, err := o.Database.LoginLockTTL(ctx, cache.LoginTargetTestAccount, t.Account)
+	if err != nil {
+		return nil, err
+	}
+	if ttl > 0 {
+		return nil, eerrs.ErrLoginLocked.WrapMsg("too many wrong test account codes", "kind", cache.LoginTargetTestAccount, "retryAfter", int64(ttl.Seconds())+1)
+	}
+	if subtle.ConstantTimeCompare([]byte(t.Code), []byte(req.Code)) != 1 {
+		n, locked, err := o.Database.LoginFail(ctx, cache.LoginTargetTestAccount, t.Account, testAccountFailWindow, testAccountMaxFail, testAccountLockTime)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			log.ZWarn(ctx, "test account locked", nil, "account", t.Account, "failures", n)
+			return nil, eerrs.ErrLoginLocked.WrapMsg("too many wrong test account codes", "kind", cache.LoginTargetTestAccount, "retryAfter", int64(testAccountLockTime.Seconds()))
+		}
+		return nil, eerrs.ErrVerifyCodeNotMatch.Wrap()
+	}
+	if err := o.Database.ResetLoginFail(ctx, cache.LoginTargetTestAccount, t.Account); err != nil {
+		return nil, err
+	}
+	return &admin.CheckTestAccountCodeResp{TestAccount: true}, nil
+}
+
+func testAccountDB2PB(t *admindb.TestAccount) *admin.TestAccount {
+	var expireTime int64
+	if !t.ExpireTime.IsZero() {
+		expireTime = t.ExpireTime.UnixMilli()
+	}
+	return &admin.TestAccount{
+		Account:    t.Account,
+		Code:       t.Code,
+		Remark:     t.Remark,
+		ExpireTime: expireTime,
+		CreateTime: t.CreateTime.UnixMilli(),
+	}
+}
@@ -16,9 +16,12 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/tools/log"
@@ -34,8 +37,107 @@ func (o *adminServer) CreateToken(ctx context.Context, req *adminpb.CreateTokenR
 	if err != nil {
 		return nil, err
 	}
+	familyID, err := tokenverify.NewRefreshTokenFamily()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := tokenverify.NewRefreshToken(familyID)
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := tokenverify.NewRefreshTokenFamily()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	family := &cache.RefreshFamily{
+		FamilyID:    familyID,
+		SessionID:   sessionID,
+		UserID:      req.UserID,
+		UserType:    req.UserType,
+		Current:     tokenverify.HashRefreshToken(refreshToken),
+		AccessToken: token,
+		PlatformID:  req.PlatformID,
+		DeviceID:    req.DeviceID,
+		IP:          req.Ip,
+		CreateTime:  now,
+		LastSeen:    now,
+	}
+	if err := o.Database.CreateRefreshFamily(ctx, family, o.Token.RefreshExpires); err != nil {
+		return nil, err
+	}
 	return &adminpb.CreateTokenResp{
-		Token: token,
+		Token:         token,
+		RefreshToken:  refreshToken,
+		Expire:        int64(expire / time.Second),
+		RefreshExpire: int64(o.Token.RefreshExpires / time.Second),
+	}, nil
+}
+
+func (o *adminServer) RefreshToken(ctx context.Context, req *adminpb.RefreshTokenReq) (*adminpb.RefreshTokenResp, error) {
+	familyID, err := tokenverify.ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		return nil, eerrs.ErrRefreshTokenInvalid.Wrap()
+	}
+	family, err := o.Database.GetRefreshFamily(ctx, familyID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, eerrs.ErrRefreshTokenInvalid.Wrap()
+		}
+		return nil, err
+	}
+	if req.UserType != 0 && req.UserType != family.UserType {
+		return nil, eerrs.ErrRefreshTokenInvalid.Wrap()
+	}
+	token, expire, err := o.Token.CreateToken(family.UserID, family.UserType)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := tokenverify.NewRefreshToken(familyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.CacheToken(ctx, family.UserID, token, expire); err != nil {
+		return nil, err
+	}
+	state, previous, err := o.Database.RotateRefreshToken(ctx, familyID, tokenverify.HashRefreshToken(req.RefreshToken),
+		tokenverify.HashRefreshToken(refreshToken), token, req.Ip, o.Token.RefreshExpires)
+	if err != nil || state != cache.RefreshRotated {
+		if err := o.Database.DeleteTokenFlag(ctx, family.UserID, token); err != nil {
+			log.ZError(ctx, "delete unused access token failed", err, "userID", family.UserID)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch state {
+	case cache.RefreshRotated:
+	case cache.RefreshReused:
+		// An already rotated refresh token was presented, so it leaked or the client is replaying it.
+		// Revoke the whole family, both the attacker and the legitimate client have to log in again.
+		log.ZWarn(ctx, "refresh token reuse detected, revoke token family", nil, "userID", family.UserID, "familyID", familyID)
+		if err := o.Database.DelRefreshFamily(ctx, family.UserID, familyID); err != nil {
+			return nil, err
+		}
+		if err := o.Database.DeleteTokenFlag(ctx, family.UserID, family.AccessToken); err != nil {
+			return nil, err
+		}
+		return nil, eerrs.ErrRefreshTokenReused.Wrap()
+	default:
+		return nil, eerrs.ErrRefreshTokenInvalid.Wrap()
+	}
+	if previous != "" {
+		if err := o.Database.DeleteTokenFlag(ctx, family.UserID, previous); err != nil {
+			log.ZError(ctx, "delete rotated access token failed", err, "userID", family.UserID)
+		}
+	}
+	return &adminpb.RefreshTokenResp{
+		UserID:        family.UserID,
+		UserType:      family.UserType,
+		Token:         token,
+		RefreshToken:  refreshToken,
+		Expire:        int64(expire / time.Second),
+		RefreshExpire: int64(o.Token.RefreshExpires / time.Second),
 	}, nil
 }
 
@@ -61,6 +163,24 @@ func (o *adminServer) ParseToken(ctx context.Context, req *adminpb.ParseTokenReq
 	}, nil
 }
 
+func (o *adminServer) GetJWKS(ctx context.Context, req *adminpb.GetJWKSReq) (*adminpb.GetJWKSResp, error) {
+	jwks := o.Token.JWKS()
+	keys := make([]*adminpb.JWK, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		keys = append(keys, &adminpb.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return &adminpb.GetJWKSResp{Keys: keys}, nil
+}
+
 func (o *adminServer) GetUserToken(ctx context.Context, req *adminpb.GetUserTokenReq) (*adminpb.GetUserTokenResp, error) {
 	tokensMap, err := o.Database.GetTokens(ctx, req.UserID)
 	if err != nil {
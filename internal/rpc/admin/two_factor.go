@@ -0,0 +1,302 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/totp"
+)
+
+func (o *adminServer) SetupTwoFactor(ctx context.Context, req *admin.SetupTwoFactorReq) (*admin.SetupTwoFactorResp, error) {
+	userID, err := mctx.CheckAdminToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf != nil && tf.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication already enabled")
+	}
+	tf, err = o.newTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SetupTwoFactorResp{
+		Secret: tf.Secret,
+		Uri:    totp.URI(o.TwoFactorIssuer, a.Account, tf.Secret),
+	}, nil
+}
+
+func (o *adminServer) ConfirmTwoFactor(ctx context.Context, req *admin.ConfirmTwoFactorReq) (*admin.ConfirmTwoFactorResp, error) {
+	userID, err := mctx.CheckAdminToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication is not set up")
+	}
+	if tf.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication already enabled")
+	}
+	codes, err := o.enableTwoFactor(ctx, tf, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.ConfirmTwoFactorResp{RecoveryCodes: codes}, nil
+}
+
+func (o *adminServer) DisableTwoFactor(ctx context.Context, req *admin.DisableTwoFactorReq) (*admin.DisableTwoFactorResp, error) {
+	opUserID, err := mctx.CheckAdminToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID != "" && req.UserID != opUserID {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+		if _, err := o.Database.GetAdminUserID(ctx, req.UserID); err != nil {
+			return nil, err
+		}
+	} else {
+		req.UserID = opUserID
+		a, err := o.Database.GetAdminUserID(ctx, opUserID)
+		if err != nil {
+			return nil, err
+		}
+		if o.twoFactorRequired(a) {
+			return nil, errs.ErrNoPermission.WrapMsg("two-factor authentication is required for this account")
+		}
+		tf, err := o.takeTwoFactor(ctx, opUserID)
+		if err != nil {
+			return nil, err
+		}
+		if tf != nil && tf.Enabled {
+			if req.Code == "" {
+				return nil, eerrs.ErrTwoFactorRequired.Wrap()
+			}
+			if err := o.verifyTwoFactor(ctx, tf, req.Code); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := o.Database.DelTwoFactor(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	o.audit(ctx, constant.AuditAdminTwoFactorReset, req.UserID, nil, nil)
+	return &admin.DisableTwoFactorResp{}, nil
+}
+
+func (o *adminServer) RegenerateRecoveryCodes(ctx context.Context, req *admin.RegenerateRecoveryCodesReq) (*admin.RegenerateRecoveryCodesResp, error) {
+	userID, err := mctx.CheckAdminToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil || !tf.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication is not enabled")
+	}
+	if err := o.verifyTwoFactor(ctx, tf, req.Code); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTwoFactor(ctx, userID, map[string]any{"recovery_codes": hashes}); err != nil {
+		return nil, err
+	}
+	return &admin.RegenerateRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (o *adminServer) GetTwoFactor(ctx context.Context, req *admin.GetTwoFactorReq) (*admin.GetTwoFactorResp, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.GetTwoFactorResp{Required: o.twoFactorRequired(a)}
+	if tf != nil && tf.Enabled {
+		resp.Enabled = true
+		resp.RecoveryCodeCount = int32(len(tf.RecoveryCodes))
+		resp.EnableTime = tf.EnableTime.UnixMilli()
+	}
+	return resp, nil
+}
+
+func (o *adminServer) SetTwoFactorRequired(ctx context.Context, req *admin.SetTwoFactorRequiredReq) (*admin.SetTwoFactorRequiredResp, error) {
+	if err := o.checkSuperAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, req.UserID, map[string]any{"two_factor_required": req.Required}); err != nil {
+		return nil, err
+	}
+	after := auditAdmin(a)
+	after.TwoFactorRequired = req.Required
+	o.audit(ctx, constant.AuditAdminTwoFactorRequired, req.UserID, auditAdmin(a), after)
+	return &admin.SetTwoFactorRequiredResp{}, nil
+}
+
+// twoFactorRequired reports whether the admin may not log in with the password only,
+// either by explicit policy or because its level is at least the configured required level.
+func (o *adminServer) twoFactorRequired(a *admindb.Admin) bool {
+	if a.TwoFactorRequired {
+		return true
+	}
+	return o.TwoFactorRequiredLevel > 0 && a.Level >= o.TwoFactorRequiredLevel
+}
+
+// checkTwoFactor is the second login step. When two-factor authentication is required but not enabled yet,
+// it returns the pending secret for enrollment, the next login with a code generated from it finishes the
+// enrollment and returns the recovery codes.
+func (o *adminServer) checkTwoFactor(ctx context.Context, a *admindb.Admin, code string) (*admin.LoginResp, []string, error) {
+	tf, err := o.takeTwoFactor(ctx, a.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tf != nil && tf.Enabled {
+		if code == "" {
+			return nil, nil, eerrs.ErrTwoFactorRequired.Wrap()
+		}
+		return nil, nil, o.verifyTwoFactor(ctx, tf, code)
+	}
+	if !o.twoFactorRequired(a) {
+		return nil, nil, nil
+	}
+	if tf == nil {
+		if tf, err = o.newTwoFactor(ctx, a.UserID); err != nil {
+			return nil, nil, err
+		}
+	}
+	if code == "" {
+		return &admin.LoginResp{
+			AdminUserID:            a.UserID,
+			AdminAccount:           a.Account,
+			Nickname:               a.Nickname,
+			FaceURL:                a.FaceURL,
+			Level:                  a.Level,
+			TwoFactorSetupRequired: true,
+			TwoFactorSecret:        tf.Secret,
+			TwoFactorURI:           totp.URI(o.TwoFactorIssuer, a.Account, tf.Secret),
+		}, nil, nil
+	}
+	codes, err := o.enableTwoFactor(ctx, tf, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, codes, nil
+}
+
+func (o *adminServer) newTwoFactor(ctx context.Context, userID string) (*admindb.TwoFactor, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	tf := &admindb.TwoFactor{
+		UserID:     userID,
+		Secret:     secret,
+		CreateTime: time.Now(),
+	}
+	if err := o.Database.SetTwoFactor(ctx, tf); err != nil {
+		return nil, err
+	}
+	return tf, nil
+}
+
+func (o *adminServer) enableTwoFactor(ctx context.Context, tf *admindb.TwoFactor, code string) ([]string, error) {
+	step, ok := totp.Validate(tf.Secret, code, time.Now())
+	if !ok {
+		return nil, eerrs.ErrTwoFactorCodeInvalid.Wrap()
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTwoFactor(ctx, tf.UserID, map[string]any{
+		"enabled":        true,
+		"recovery_codes": hashes,
+		"last_step":      step,
+		"enable_time":    time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// verifyTwoFactor accepts a TOTP code that has not been used yet, or consumes a recovery code.
+func (o *adminServer) verifyTwoFactor(ctx context.Context, tf *admindb.TwoFactor, code string) error {
+	if step, ok := totp.Validate(tf.Secret, code, time.Now()); ok {
+		used, err := o.Database.UseTwoFactorStep(ctx, tf.UserID, step)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return eerrs.ErrTwoFactorCodeInvalid.WrapMsg("code already used")
+		}
+		return nil
+	}
+	used, err := o.Database.UseTwoFactorRecoveryCode(ctx, tf.UserID, totp.HashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return eerrs.ErrTwoFactorCodeInvalid.Wrap()
+	}
+	return nil
+}
+
+func (o *adminServer) takeTwoFactor(ctx context.Context, userID string) (*admindb.TwoFactor, error) {
+	tf, err := o.Database.TakeTwoFactor(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tf, nil
+}
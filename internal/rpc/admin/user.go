@@ -21,9 +21,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/errs"
@@ -31,7 +31,7 @@ import (
 )
 
 func (o *adminServer) CancellationUser(ctx context.Context, req *admin.CancellationUserReq) (*admin.CancellationUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserWrite); err != nil {
 		return nil, err
 	}
 	empty := wrapperspb.String("")
@@ -39,14 +39,22 @@ func (o *adminServer) CancellationUser(ctx context.Context, req *admin.Cancellat
 	if err := o.Chat.UpdateUser(ctx, update); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditUserCancel, req.UserID, nil, nil)
 	return &admin.CancellationUserResp{}, nil
 }
 
 func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*admin.BlockUserResp, error) {
-	_, err := mctx.CheckAdmin(ctx)
+	_, err := o.checkPermission(ctx, constant.PermissionUserBlock)
 	if err != nil {
 		return nil, err
 	}
+	var expireTime time.Time
+	if req.ExpireTime > 0 {
+		expireTime = time.UnixMilli(req.ExpireTime)
+		if !expireTime.After(time.Now()) {
+			return nil, errs.ErrArgs.WrapMsg("expireTime must be in the future")
+		}
+	}
 	_, err = o.Database.GetBlockInfo(ctx, req.UserID)
 	if err == nil {
 		return nil, errs.ErrArgs.WrapMsg("user already blocked")
@@ -59,15 +67,17 @@ func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*
 		Reason:         req.Reason,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		CreateTime:     time.Now(),
+		ExpireTime:     expireTime,
 	}
 	if err := o.Database.BlockUser(ctx, []*admindb.ForbiddenAccount{t}); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditUserBlock, req.UserID, nil, t)
 	return &admin.BlockUserResp{}, nil
 }
 
 func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq) (*admin.UnblockUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserBlock); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -87,11 +97,14 @@ func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq
 	if err := o.Database.DelBlockUser(ctx, req.UserIDs); err != nil {
 		return nil, err
 	}
+	for _, b := range bs {
+		o.audit(ctx, constant.AuditUserUnblock, b.UserID, b, nil)
+	}
 	return &admin.UnblockUserResp{}, nil
 }
 
 func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBlockUserReq) (*admin.SearchBlockUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserBlock); err != nil {
 		return nil, err
 	}
 	total, infos, err := o.Database.SearchBlockUser(ctx, req.Keyword, req.Pagination)
@@ -110,6 +123,7 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 			Reason:     info.Reason,
 			OpUserID:   info.OperatorUserID,
 			CreateTime: info.CreateTime.UnixMilli(),
+			ExpireTime: blockExpireTime(info),
 		}
 		if userFull := userMap[info.UserID]; userFull != nil {
 			user.Account = userFull.Account
@@ -126,7 +140,7 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 }
 
 func (o *adminServer) FindUserBlockInfo(ctx context.Context, req *admin.FindUserBlockInfoReq) (*admin.FindUserBlockInfoResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionUserRead); err != nil {
 		return nil, err
 	}
 	list, err := o.Database.FindBlockUser(ctx, req.UserIDs)
@@ -135,12 +149,25 @@ func (o *adminServer) FindUserBlockInfo(ctx context.Context, req *admin.FindUser
 	}
 	blocks := make([]*admin.BlockInfo, 0, len(list))
 	for _, info := range list {
-		blocks = append(blocks, &admin.BlockInfo{
-			UserID:     info.UserID,
-			Reason:     info.Reason,
-			OpUserID:   info.OperatorUserID,
-			CreateTime: info.CreateTime.UnixMilli(),
-		})
+		blocks = append(blocks, blockInfo(info))
 	}
 	return &admin.FindUserBlockInfoResp{Blocks: blocks}, nil
 }
+
+func blockInfo(info *admindb.ForbiddenAccount) *admin.BlockInfo {
+	return &admin.BlockInfo{
+		UserID:     info.UserID,
+		Reason:     info.Reason,
+		OpUserID:   info.OperatorUserID,
+		CreateTime: info.CreateTime.UnixMilli(),
+		ExpireTime: blockExpireTime(info),
+	}
+}
+
+// blockExpireTime returns the expiry in unix milliseconds, 0 for a permanent block.
+func blockExpireTime(info *admindb.ForbiddenAccount) int64 {
+	if info.ExpireTime.IsZero() {
+		return 0
+	}
+	return info.ExpireTime.UnixMilli()
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+
+	"github.com/openimsdk/chat/pkg/common/mctx"
+)
+
+const (
+	userDeletionJob = "user_deletion"
+	// deletedUserNickname replaces the IM profile of a purged user, the IM user itself is kept for the messages.
+	deletedUserNickname = "Deleted user"
+)
+
+type userDeletion struct {
+	Interval time.Duration
+	Batch    int32
+}
+
+// startUserDeletion purges the users whose deletion is due every interval, on one admin rpc instance at a time.
+func (o *adminServer) startUserDeletion(ctx context.Context) {
+	if o.UserDeletion.Interval <= 0 || o.UserDeletion.Batch <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(o.UserDeletion.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			purgeCtx := mctx.WithAdminUser(mcontext.SetOperationID(ctx, "userDeletion"+strconv.FormatInt(time.Now().UnixMilli(), 10)), o.ChatAdminUserID)
+			ok, err := o.Database.TryLockJob(purgeCtx, userDeletionJob, o.UserDeletion.Interval-o.UserDeletion.Interval/10)
+			if err != nil {
+				log.ZError(purgeCtx, "lock user deletion failed", err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			purged, err := o.purgeDueUsers(purgeCtx)
+			if err != nil {
+				log.ZError(purgeCtx, "user deletion failed", err)
+				continue
+			}
+			if purged > 0 {
+				log.ZInfo(purgeCtx, "user deletion finished", "purged", purged)
+			}
+		}
+	}()
+}
+
+// purgeDueUsers purges one batch of due users. The chat data goes first, it is where a login in the
+// meantime cancels the deletion, the IM profile and the admin data of the user follow.
+// Failures of single users are logged, they are retried or cleaned up in the next run.
+func (o *adminServer) purgeDueUsers(ctx context.Context) (int, error) {
+	userIDs, err := o.Chat.FindDueUserDeletion(ctx, o.UserDeletion.Batch)
+	if err != nil {
+		return 0, err
+	}
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+	var purged int
+	for _, userID := range userIDs {
+		if err := o.Chat.PurgeUser(ctx, userID); err != nil {
+			log.ZWarn(ctx, "purge user failed", err, "userID", userID)
+			continue
+		}
+		purged++
+		if err := o.Database.PurgeUser(ctx, userID); err != nil {
+			log.ZError(ctx, "purge user admin data failed", err, "userID", userID)
+		}
+		if err := o.IM.ForceOffLine(imCtx, userID); err != nil {
+			log.ZWarn(ctx, "force offline purged user failed", err, "userID", userID)
+		}
+		if err := o.IM.UpdateUserInfo(imCtx, userID, deletedUserNickname, ""); err != nil {
+			log.ZError(ctx, "anonymize purged user failed", err, "userID", userID)
+		}
+	}
+	return purged, nil
+}
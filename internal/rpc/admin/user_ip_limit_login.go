@@ -20,14 +20,15 @@ import (
 
 	"github.com/openimsdk/tools/utils/datautil"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/iprange"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/tools/errs"
 )
 
 func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.SearchUserIPLimitLoginReq) (*admin.SearchUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
 		return nil, err
 	}
 	total, list, err := o.Database.SearchUserLimitLogin(ctx, req.Keyword, req.Pagination)
@@ -52,7 +53,7 @@ func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.Sea
 }
 
 func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUserIPLimitLoginReq) (*admin.AddUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -61,20 +62,30 @@ func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUse
 	now := time.Now()
 	ts := make([]*admindb.LimitUserLoginIP, 0, len(req.Limits))
 	for _, limit := range req.Limits {
+		if limit.UserID == "" {
+			return nil, errs.ErrArgs.WrapMsg("user_id is empty")
+		}
+		r, err := iprange.Parse(limit.Ip)
+		if err != nil {
+			return nil, err
+		}
 		ts = append(ts, &admindb.LimitUserLoginIP{
 			UserID:     limit.UserID,
-			IP:         limit.Ip,
+			IP:         r.Value,
+			RangeStart: r.Start,
+			RangeEnd:   r.End,
 			CreateTime: now,
 		})
 	}
 	if err := o.Database.AddUserLimitLogin(ctx, ts); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditUserIPLimitAdd, "", nil, ts)
 	return &admin.AddUserIPLimitLoginResp{}, nil
 }
 
 func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUserIPLimitLoginReq) (*admin.DelUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermissionSecurityManage); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -85,13 +96,16 @@ func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUse
 		if limit.UserID == "" || limit.Ip == "" {
 			return nil, errs.ErrArgs.WrapMsg("user_id or ip is empty")
 		}
-		ts = append(ts, &admindb.LimitUserLoginIP{
-			UserID: limit.UserID,
-			IP:     limit.Ip,
-		})
+		for _, ip := range ipValues([]string{limit.Ip}) {
+			ts = append(ts, &admindb.LimitUserLoginIP{
+				UserID: limit.UserID,
+				IP:     ip,
+			})
+		}
 	}
 	if err := o.Database.DelUserLimitLogin(ctx, ts); err != nil {
 		return nil, err
 	}
+	o.audit(ctx, constant.AuditUserIPLimitDel, "", req.Limits, nil)
 	return &admin.DelUserIPLimitLoginResp{}, nil
 }
@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// SubmitBanAppeal submits an appeal for a blocked user, who cannot log in and proves the account with a
+// verification code sent for constant.VerificationCodeForBanAppeal.
+func (o *chatSvr) SubmitBanAppeal(ctx context.Context, req *chat.SubmitBanAppealReq) (*chat.SubmitBanAppealResp, error) {
+	userID, verifyCodeID, err := o.verifyBanAppealUser(ctx, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode)
+	if err != nil {
+		return nil, err
+	}
+	appealID, err := o.Admin.SubmitBanAppeal(ctx, userID, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	if verifyCodeID != "" {
+		if err := o.Database.DelVerifyCode(ctx, verifyCodeID); err != nil {
+			return nil, err
+		}
+	}
+	return &chat.SubmitBanAppealResp{AppealID: appealID}, nil
+}
+
+// GetBanAppeal returns the block and the newest appeal of the user, the verification code stays valid
+// so that it can be used to submit an appeal afterwards.
+func (o *chatSvr) GetBanAppeal(ctx context.Context, req *chat.GetBanAppealReq) (*chat.GetBanAppealResp, error) {
+	userID, _, err := o.verifyBanAppealUser(ctx, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode)
+	if err != nil {
+		return nil, err
+	}
+	block, appeal, err := o.Admin.GetBanAppeal(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var resp chat.GetBanAppealResp
+	if block != nil {
+		resp.Blocked = true
+		resp.BanReason = block.Reason
+		resp.BanExpireTime = block.ExpireTime
+	}
+	if appeal != nil {
+		resp.AppealID = appeal.AppealID
+		resp.AppealStatus = appeal.Status
+		resp.AppealCreateTime = appeal.CreateTime
+		resp.AppealReviewTime = appeal.ReviewTime
+	}
+	return &resp, nil
+}
+
+// verifyBanAppealUser checks the verification code of the phone number or email and returns the user of it.
+func (o *chatSvr) verifyBanAppealUser(ctx context.Context, areaCode, phoneNumber, email, verifyCode string) (string, string, error) {
+	var (
+		account      string
+		verifyCodeID string
+		err          error
+	)
+	if email == "" {
+		if err := normalizePhone(&areaCode, &phoneNumber); err != nil {
+			return "", "", err
+		}
+		verifyCodeID, err = o.verifyCode(ctx, o.verifyCodeJoin(areaCode, phoneNumber), verifyCode, phone)
+		account = BuildCredentialPhone(areaCode, phoneNumber)
+	} else {
+		verifyCodeID, err = o.verifyCode(ctx, email, verifyCode, mail)
+		account = email
+	}
+	if err != nil {
+		return "", "", err
+	}
+	cred, err := o.Database.TakeCredentialByAccount(ctx, account)
+	if err != nil {
+		return "", "", err
+	}
+	return cred.UserID, verifyCodeID, nil
+}
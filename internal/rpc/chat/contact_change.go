@@ -0,0 +1,152 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// contactChange is a checked change of the email or phone number of a user to credential.
+type contactChange struct {
+	credential    *chatdb.Credential
+	verifyAccount string
+	verifyType    verifyType
+}
+
+// RequestContactChange sends a code to the new email or phone number, confirming it with
+// ConfirmContactChange proves the user owns it.
+func (o *chatSvr) RequestContactChange(ctx context.Context, req *chat.RequestContactChangeReq) (*chat.RequestContactChangeResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.checkContactChange(ctx, userID, req.Email, &req.AreaCode, &req.PhoneNumber); err != nil {
+		return nil, err
+	}
+	err = o.sendVerifyCode(ctx, &chat.SendVerifyCodeReq{
+		UsedFor:     constant.VerificationCodeForChangeContact,
+		Email:       req.Email,
+		AreaCode:    req.AreaCode,
+		PhoneNumber: req.PhoneNumber,
+		Platform:    req.Platform,
+		Language:    req.Language,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chat.RequestContactChangeResp{}, nil
+}
+
+// ConfirmContactChange replaces the email or phone number of the user after the code sent to the new one
+// is verified, and tells the email of the user about it.
+func (o *chatSvr) ConfirmContactChange(ctx context.Context, req *chat.ConfirmContactChangeReq) (*chat.ConfirmContactChangeResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := o.checkContactChange(ctx, userID, req.Email, &req.AreaCode, &req.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	verifyCodeID, err := o.verifyCode(ctx, change.verifyAccount, req.VerifyCode, change.verifyType)
+	if err != nil {
+		return nil, err
+	}
+	oldEmails, err := o.Database.FindCredentialsByType(ctx, userID, constant.CredentialEmail)
+	if err != nil {
+		return nil, err
+	}
+	update := make(map[string]any)
+	if change.credential.Type == constant.CredentialEmail {
+		update["email"] = req.Email
+	} else {
+		update["area_code"] = req.AreaCode
+		update["phone_number"] = req.PhoneNumber
+	}
+	if err := o.Database.UpdateUseInfo(ctx, userID, update, []*chatdb.Credential{change.credential}, nil); err != nil {
+		return nil, err
+	}
+	if verifyCodeID != "" {
+		if err := o.Database.DelVerifyCode(ctx, verifyCodeID); err != nil {
+			return nil, err
+		}
+	}
+	if o.conf.NotifyContactChange && o.Mail != nil {
+		for _, old := range oldEmails {
+			if err := o.Mail.SendContactChanged(ctx, old.Account, change.credential.Account, req.Language); err != nil {
+				log.ZWarn(ctx, "send contact change notice failed", err, "userID", userID)
+			}
+		}
+	}
+	return &chat.ConfirmContactChangeResp{}, nil
+}
+
+// checkContactChange normalizes the new email or phone number, checks that no user has it and that the
+// user may change the current one.
+func (o *chatSvr) checkContactChange(ctx context.Context, userID string, email string, areaCode *string, phoneNumber *string) (*contactChange, error) {
+	var change contactChange
+	if email != "" {
+		if err := chat.EmailCheck(email); err != nil {
+			return nil, err
+		}
+		change.credential = &chatdb.Credential{UserID: userID, Account: email, Type: constant.CredentialEmail, AllowChange: true}
+		change.verifyAccount = email
+		change.verifyType = mail
+	} else {
+		if err := normalizePhone(areaCode, phoneNumber); err != nil {
+			return nil, err
+		}
+		change.credential = &chatdb.Credential{UserID: userID, Account: BuildCredentialPhone(*areaCode, *phoneNumber), Type: constant.CredentialPhone, AllowChange: true}
+		change.verifyAccount = o.verifyCodeJoin(*areaCode, *phoneNumber)
+		change.verifyType = phone
+	}
+	if err := o.checkCredentialChangeable(ctx, userID, change.credential.Type); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.TakeCredentialByAccount(ctx, change.credential.Account); err == nil {
+		if change.credential.Type == constant.CredentialEmail {
+			return nil, eerrs.ErrEmailAlreadyRegister.Wrap()
+		}
+		return nil, eerrs.ErrPhoneAlreadyRegister.Wrap()
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// checkCredentialChangeable rejects changing the credential of the type if the user has one that does not
+// allow changes.
+func (o *chatSvr) checkCredentialChangeable(ctx context.Context, userID string, credentialType int) error {
+	credentials, err := o.Database.FindCredentialsByType(ctx, userID, credentialType)
+	if err != nil {
+		return err
+	}
+	for _, credential := range credentials {
+		if !credential.AllowChange {
+			return errs.ErrNoPermission.WrapMsg("the credential can not be changed", "type", credentialType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/directory"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+const ldapAccountPrefix = "ldap:"
+
+// ldapAccount is the credential account of a directory user, usernames are case-insensitive.
+func ldapAccount(username string) string {
+	return ldapAccountPrefix + strings.ToLower(username)
+}
+
+// loginAccount resolves the account a user logs in with, directory users log in with their username.
+func (o *chatSvr) loginAccount(ctx context.Context, account string) (string, error) {
+	if o.Directory == nil || strings.HasPrefix(account, ldapAccountPrefix) {
+		return account, nil
+	}
+	if _, err := o.Database.TakeCredentialByAccount(ctx, ldapAccount(account)); err == nil {
+		return ldapAccount(account), nil
+	} else if !dbutil.IsDBNotFound(err) {
+		return "", err
+	}
+	return account, nil
+}
+
+// checkLDAPPassword binds to the directory as the user instead of checking the stored password.
+func (o *chatSvr) checkLDAPPassword(ctx context.Context, account string, password string) error {
+	if _, err := o.Directory.Authenticate(ctx, strings.TrimPrefix(account, ldapAccountPrefix), password); err != nil {
+		if errors.Is(err, directory.ErrInvalidCredentials) {
+			return eerrs.ErrPassword.Wrap()
+		}
+		return err
+	}
+	return nil
+}
+
+func (o *chatSvr) FindLDAPUser(ctx context.Context, req *chat.FindLDAPUserReq) (*chat.FindLDAPUserResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	credentials, err := o.Database.FindAllCredentialsByType(ctx, constant.CredentialLDAP)
+	if err != nil {
+		return nil, err
+	}
+	usernameUserMap := make(map[string]string, len(credentials))
+	for _, c := range credentials {
+		usernameUserMap[strings.TrimPrefix(c.Account, ldapAccountPrefix)] = c.UserID
+	}
+	return &chat.FindLDAPUserResp{UsernameUserMap: usernameUserMap}, nil
+}
+
+func (o *chatSvr) checkLDAPUsername(ctx context.Context, username string) error {
+	if o.Directory == nil {
+		return errs.ErrArgs.WrapMsg("ldap is not enabled")
+	}
+	if _, err := o.Database.TakeCredentialByAccount(ctx, ldapAccount(username)); err == nil {
+		return eerrs.ErrAccountAlreadyRegister.WrapMsg("ldap user already registered", "username", username)
+	} else if !dbutil.IsDBNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
-	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +18,8 @@ import (
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/email"
+	"github.com/openimsdk/chat/pkg/password"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 )
 
@@ -33,24 +34,33 @@ func (o *chatSvr) verifyCodeJoin(areaCode, phoneNumber string) string {
 	return areaCode + " " + phoneNumber
 }
 
+func mailPurpose(usedFor int32) string {
+	switch int(usedFor) {
+	case constant.VerificationCodeForRegister:
+		return email.PurposeRegister
+	case constant.VerificationCodeForResetPassword:
+		return email.PurposeResetPassword
+	case constant.VerificationCodeForChangeContact:
+		return email.PurposeChangeContact
+	case constant.VerificationCodeForBanAppeal:
+		return email.PurposeBanAppeal
+	default:
+		return email.PurposeLogin
+	}
+}
+
 func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeReq) (*chat.SendVerifyCodeResp, error) {
+	if err := o.Admin.CheckCaptcha(ctx, req.Ip, req.CaptchaID, req.CaptchaAnswer); err != nil {
+		return nil, err
+	}
 	switch int(req.UsedFor) {
 	case constant.VerificationCodeForRegister:
 		if err := o.Admin.CheckRegister(ctx, req.Ip); err != nil {
 			return nil, err
 		}
 		if req.Email == "" {
-			if req.AreaCode == "" || req.PhoneNumber == "" {
-				return nil, errs.ErrArgs.WrapMsg("area code or phone number is empty")
-			}
-			if !strings.HasPrefix(req.AreaCode, "+") {
-				req.AreaCode = "+" + req.AreaCode
-			}
-			if _, err := strconv.ParseUint(req.AreaCode[1:], 10, 64); err != nil {
-				return nil, errs.ErrArgs.WrapMsg("area code must be number")
-			}
-			if _, err := strconv.ParseUint(req.PhoneNumber, 10, 64); err != nil {
-				return nil, errs.ErrArgs.WrapMsg("phone number must be number")
+			if err := normalizePhone(&req.AreaCode, &req.PhoneNumber); err != nil {
+				return nil, err
 			}
 		} else {
 			if err := chat.EmailCheck(req.Email); err != nil {
@@ -69,8 +79,11 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 				return nil, err
 			}
 		}
-	case constant.VerificationCodeForLogin, constant.VerificationCodeForResetPassword:
+	case constant.VerificationCodeForLogin, constant.VerificationCodeForResetPassword, constant.VerificationCodeForBanAppeal:
 		if req.Email == "" {
+			if err := normalizePhone(&req.AreaCode, &req.PhoneNumber); err != nil {
+				return nil, err
+			}
 			_, err := o.Database.TakeAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
 			if dbutil.IsDBNotFound(err) {
 				return nil, eerrs.ErrAccountNotFound.WrapMsg("phone unregistered")
@@ -86,56 +99,59 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 			}
 		}
 
+	case constant.VerificationCodeForLoginLink:
+		return o.sendLoginLink(ctx, req)
 	default:
 		return nil, errs.ErrArgs.WrapMsg("used unknown")
 	}
-	if o.SMS == nil && o.Mail == nil {
-		return &chat.SendVerifyCodeResp{}, nil // super code
-	}
-	if req.Email != "" {
-		switch o.conf.Mail.Use {
-		case constant.VerifySuperCode:
-			return &chat.SendVerifyCodeResp{}, nil // super code
-		case constant.VerifyMail:
-		default:
-			return nil, errs.ErrInternalServer.WrapMsg("email verification code is not enabled")
-		}
-	}
-
-	if req.AreaCode != "" {
-		switch o.conf.Phone.Use {
-		case constant.VerifySuperCode:
-			return &chat.SendVerifyCodeResp{}, nil // super code
-		case constant.VerifyALi:
-		default:
-			return nil, errs.ErrInternalServer.WrapMsg("phone verification code is not enabled")
-		}
+	if err := o.sendVerifyCode(ctx, req); err != nil {
+		return nil, err
 	}
+	return &chat.SendVerifyCodeResp{}, nil
+}
 
+// sendVerifyCode sends a code to the checked email or phone number of req, within the sending limit.
+func (o *chatSvr) sendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeReq) error {
 	isEmail := req.Email != ""
+	var account string
+	if isEmail {
+		account = req.Email
+	} else {
+		account = o.verifyCodeJoin(req.AreaCode, req.PhoneNumber)
+	}
+	testAccount, err := o.Admin.GetTestAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+	if testAccount != nil {
+		return nil // test accounts use the fixed code
+	}
 	var (
 		code     = o.genVerifyCode()
-		account  string
 		sendCode func() error
 	)
 	if isEmail {
+		if o.Mail == nil {
+			return errs.ErrInternalServer.WrapMsg("email verification code is not enabled", "use", o.conf.Mail.Use)
+		}
 		sendCode = func() error {
-			return o.Mail.SendMail(ctx, req.Email, code)
+			return o.Mail.SendMail(ctx, req.Email, code, mailPurpose(req.UsedFor), req.Language)
 		}
-		account = req.Email
 	} else {
+		if o.SMS == nil {
+			return errs.ErrInternalServer.WrapMsg("phone verification code is not enabled", "use", o.conf.Phone.Use)
+		}
 		sendCode = func() error {
 			return o.SMS.SendCode(ctx, req.AreaCode, req.PhoneNumber, code)
 		}
-		account = o.verifyCodeJoin(req.AreaCode, req.PhoneNumber)
 	}
 	now := time.Now()
 	count, err := o.Database.CountVerifyCodeRange(ctx, account, now.Add(-o.Code.UintTime), now)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if o.Code.MaxCount < int(count) {
-		return nil, eerrs.ErrVerifyCodeSendFrequently.Wrap()
+		return eerrs.ErrVerifyCodeSendFrequently.Wrap()
 	}
 	platformName := constantpb.PlatformIDToName(int(req.Platform))
 	if platformName == "" {
@@ -151,38 +167,31 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 		CreateTime: now,
 	}
 	if err := o.Database.AddVerifyCode(ctx, vc, sendCode); err != nil {
-		return nil, err
+		return err
 	}
 	log.ZDebug(ctx, "send code success", "account", account, "code", code, "platform", platformName)
-	return &chat.SendVerifyCodeResp{}, nil
+	return nil
 }
 
 func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode string, type_ verifyType) (string, error) {
 	if verifyCode == "" {
 		return "", errs.ErrArgs.WrapMsg("verify code is empty")
 	}
+	testAccount, err := o.Admin.CheckTestAccountCode(ctx, account, verifyCode)
+	if err != nil {
+		return "", err
+	}
+	if testAccount {
+		return "", nil
+	}
 	switch type_ {
 	case phone:
-		switch o.conf.Phone.Use {
-		case constant.VerifySuperCode:
-			if o.Code.SuperCode != verifyCode {
-				return "", eerrs.ErrVerifyCodeNotMatch.Wrap()
-			}
-			return "", nil
-		case constant.VerifyALi:
-		default:
+		if o.SMS == nil {
 			return "", errs.ErrInternalServer.WrapMsg("phone verification code is not enabled", "use", o.conf.Phone.Use)
 		}
 	case mail:
-		switch o.conf.Mail.Use {
-		case constant.VerifySuperCode:
-			if o.Code.SuperCode != verifyCode {
-				return "", eerrs.ErrVerifyCodeNotMatch.Wrap()
-			}
-			return "", nil
-		case constant.VerifyMail:
-		default:
-			return "", errs.ErrInternalServer.WrapMsg("email verification code is not enabled")
+		if o.Mail == nil {
+			return "", errs.ErrInternalServer.WrapMsg("email verification code is not enabled", "use", o.conf.Mail.Use)
 		}
 	}
 
@@ -218,6 +227,9 @@ func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode str
 func (o *chatSvr) VerifyCode(ctx context.Context, req *chat.VerifyCodeReq) (*chat.VerifyCodeResp, error) {
 	var account string
 	if req.PhoneNumber != "" {
+		if err := normalizePhone(&req.AreaCode, &req.PhoneNumber); err != nil {
+			return nil, err
+		}
 		account = o.verifyCodeJoin(req.AreaCode, req.PhoneNumber)
 		if _, err := o.verifyCode(ctx, account, req.VerifyCode, phone); err != nil {
 			return nil, err
@@ -258,12 +270,18 @@ func (o *chatSvr) genVerifyCode() string {
 }
 
 func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (*chat.RegisterUserResp, error) {
+	return o.registerUser(ctx, req, nil)
+}
+
+// registerUser registers a user, identity is the credential of an oauth identity the user is registered
+// with, which replaces the verification of the phone number or email.
+func (o *chatSvr) registerUser(ctx context.Context, req *chat.RegisterUserReq, identity *chatdb.Credential) (*chat.RegisterUserResp, error) {
 	isAdmin, err := o.Admin.CheckNilOrAdmin(ctx)
 	ctx = o.WithAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if err = o.checkRegisterInfo(ctx, req.User, isAdmin); err != nil {
+	if err = o.checkRegisterInfo(ctx, req.User, isAdmin, identity != nil); err != nil {
 		return nil, err
 	}
 	var usedInvitationCode bool
@@ -277,6 +295,12 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		if err := o.Admin.CheckRegister(ctx, req.Ip); err != nil {
 			return nil, err
 		}
+		// the provider of an oauth identity has vetted the user already
+		if identity == nil {
+			if err := o.Admin.CheckCaptcha(ctx, req.Ip, req.CaptchaID, req.CaptchaAnswer); err != nil {
+				return nil, err
+			}
+		}
 		conf, err := o.Admin.GetConfig(ctx)
 		if err != nil {
 			return nil, err
@@ -290,11 +314,13 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 				return nil, err
 			}
 		}
-		if req.User.Email == "" {
+		switch {
+		case identity != nil:
+		case req.User.Email == "":
 			if _, err := o.verifyCode(ctx, o.verifyCodeJoin(req.User.AreaCode, req.User.PhoneNumber), req.VerifyCode, phone); err != nil {
 				return nil, err
 			}
-		} else {
+		default:
 			if _, err := o.verifyCode(ctx, req.User.Email, req.VerifyCode, mail); err != nil {
 				return nil, err
 			}
@@ -358,6 +384,13 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 			AllowChange: true,
 		})
 	}
+	if identity != nil {
+		if len(credentials) == 0 {
+			registerType = constant.OAuthRegister
+		}
+		identity.UserID = req.User.UserID
+		credentials = append(credentials, identity)
+	}
 	register := &chatdb.Register{
 		UserID:      req.User.UserID,
 		DeviceID:    req.DeviceID,
@@ -367,9 +400,16 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		Mode:        constant.UserMode,
 		CreateTime:  time.Now(),
 	}
+	if err := o.checkNewPassword(ctx, req.User.Password); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := o.hashPassword(req.User.Password)
+	if err != nil {
+		return nil, err
+	}
 	account := &chatdb.Account{
 		UserID:         req.User.UserID,
-		Password:       req.User.Password,
+		Password:       hashedPassword,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		ChangeTime:     register.CreateTime,
 		CreateTime:     register.CreateTime,
@@ -402,9 +442,11 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 	}
 	var resp chat.RegisterUserResp
 	if req.AutoLogin {
-		chatToken, err := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser)
+		chatToken, err := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser, req.Platform, req.DeviceID, req.Ip)
 		if err == nil {
 			resp.ChatToken = chatToken.Token
+			resp.RefreshToken = chatToken.RefreshToken
+			resp.Expire = chatToken.Expire
 		} else {
 			log.ZError(ctx, "Admin CreateToken Failed", err, "userID", req.User.UserID, "platform", req.Platform)
 		}
@@ -413,8 +455,16 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 	return &resp, nil
 }
 
+// loginFailed reports a failed login to the admin service, which returns the lock error when the account
+// or the ip gets locked, otherwise cause is returned once the progressive delay has passed.
+func (o *chatSvr) loginFailed(ctx context.Context, userID string, ip string, cause error) error {
+	if err := o.Admin.LoginFailed(ctx, userID, constant.NormalUser, ip); err != nil {
+		return err
+	}
+	return cause
+}
+
 func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginResp, error) {
-	resp := &chat.LoginResp{}
 	if req.Password == "" && req.VerifyCode == "" {
 		return nil, errs.ErrArgs.WrapMsg("password or code must be set")
 	}
@@ -426,16 +476,13 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 
 	switch {
 	case req.Account != "":
-		acc = req.Account
-	case req.PhoneNumber != "":
-		if req.AreaCode == "" {
-			return nil, errs.ErrArgs.WrapMsg("area code must")
-		}
-		if !strings.HasPrefix(req.AreaCode, "+") {
-			req.AreaCode = "+" + req.AreaCode
+		acc, err = o.loginAccount(ctx, req.Account)
+		if err != nil {
+			return nil, err
 		}
-		if _, err := strconv.ParseUint(req.AreaCode[1:], 10, 64); err != nil {
-			return nil, errs.ErrArgs.WrapMsg("area code must be number")
+	case req.PhoneNumber != "":
+		if err := normalizePhone(&req.AreaCode, &req.PhoneNumber); err != nil {
+			return nil, err
 		}
 		acc = BuildCredentialPhone(req.AreaCode, req.PhoneNumber)
 	case req.Email != "":
@@ -446,14 +493,23 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 	credential, err = o.Database.TakeCredentialByAccount(ctx, acc)
 	if err != nil {
 		if dbutil.IsDBNotFound(err) {
-			return nil, eerrs.ErrAccountNotFound.WrapMsg("user unregistered")
+			return nil, o.loginFailed(ctx, "", req.Ip, eerrs.ErrAccountNotFound.WrapMsg("user unregistered"))
 		}
 		return nil, err
 	}
+	// oauth identities log in at their provider, directory users only while ldap is enabled
+	if credential.Type == constant.CredentialOAuth || (credential.Type == constant.CredentialLDAP && o.Directory == nil) {
+		return nil, o.loginFailed(ctx, "", req.Ip, eerrs.ErrAccountNotFound.WrapMsg("user unregistered"))
+	}
 	if err := o.Admin.CheckLogin(ctx, credential.UserID, req.Ip); err != nil {
 		return nil, err
 	}
-	var verifyCodeID *string
+	var (
+		verifyCodeID *string
+		// expiredAccount is set when the password has expired, the login replaces it with req.NewPassword
+		expiredAccount *chatdb.Account
+		policy         *password.Policy
+	)
 	if req.Password == "" {
 		var (
 			id string
@@ -476,25 +532,72 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 		if id != "" {
 			verifyCodeID = &id
 		}
+	} else if credential.Type == constant.CredentialLDAP {
+		if err := o.checkLDAPPassword(ctx, credential.Account, req.Password); err != nil {
+			if eerrs.ErrPassword.Is(err) {
+				return nil, o.loginFailed(ctx, credential.UserID, req.Ip, err)
+			}
+			return nil, err
+		}
 	} else {
 		account, err := o.Database.TakeAccount(ctx, credential.UserID)
 		if err != nil {
 			return nil, err
 		}
-		if account.Password != req.Password {
-			return nil, eerrs.ErrPassword.Wrap()
+		if err := o.checkPassword(ctx, account, req.Password); err != nil {
+			if eerrs.ErrPassword.Is(err) {
+				return nil, o.loginFailed(ctx, credential.UserID, req.Ip, err)
+			}
+			return nil, err
+		}
+		policy, err = o.passwordPolicy(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if policy.Expired(account.ChangeTime, time.Now()) {
+			if req.NewPassword == "" {
+				return nil, eerrs.ErrPasswordExpired.WrapMsg("password expired, log in again with a new password")
+			}
+			if err := o.checkExpiredPasswordChange(policy, account, req.Password, req.NewPassword); err != nil {
+				return nil, err
+			}
+			expiredAccount = account
+		}
+	}
+	if err := o.checkTwoFactor(ctx, credential.UserID, req.TwoFactorCode); err != nil {
+		if eerrs.ErrTwoFactorCodeInvalid.Is(err) {
+			return nil, o.loginFailed(ctx, credential.UserID, req.Ip, err)
+		}
+		return nil, err
+	}
+	if expiredAccount != nil {
+		if err := o.changeExpiredPassword(ctx, policy, expiredAccount, req.NewPassword); err != nil {
+			return nil, err
 		}
 	}
-	chatToken, err := o.Admin.CreateToken(ctx, credential.UserID, constant.NormalUser)
+	resp, err := o.finishLogin(ctx, credential.UserID, req.Platform, req.DeviceID, req.Ip, verifyCodeID)
+	if err != nil {
+		return nil, err
+	}
+	resp.PasswordChanged = expiredAccount != nil
+	return resp, nil
+}
+
+// finishLogin issues the chat token of a verified login and records it, the verify code is deleted.
+func (o *chatSvr) finishLogin(ctx context.Context, userID string, platform int32, deviceID string, ip string, verifyCodeID *string) (*chat.LoginResp, error) {
+	if err := o.Admin.LoginSucceeded(ctx, userID, constant.NormalUser); err != nil {
+		return nil, err
+	}
+	chatToken, err := o.Admin.CreateToken(ctx, userID, constant.NormalUser, platform, deviceID, ip)
 	if err != nil {
 		return nil, err
 	}
 	record := &chatdb.UserLoginRecord{
-		UserID:    credential.UserID,
+		UserID:    userID,
 		LoginTime: time.Now(),
-		IP:        req.Ip,
-		DeviceID:  req.DeviceID,
-		Platform:  constantpb.PlatformIDToName(int(req.Platform)),
+		IP:        ip,
+		DeviceID:  deviceID,
+		Platform:  constantpb.PlatformIDToName(int(platform)),
 	}
 	if err := o.Database.LoginRecord(ctx, record, verifyCodeID); err != nil {
 		return nil, err
@@ -504,7 +607,15 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 			return nil, err
 		}
 	}
-	resp.UserID = credential.UserID
-	resp.ChatToken = chatToken.Token
-	return resp, nil
+	deletionCancelled, err := o.cancelUserDeletion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.LoginResp{
+		UserID:            userID,
+		ChatToken:         chatToken.Token,
+		RefreshToken:      chatToken.RefreshToken,
+		Expire:            chatToken.Expire,
+		DeletionCancelled: deletionCancelled,
+	}, nil
 }
@@ -0,0 +1,144 @@
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	constantpb "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// A login link token is <base64url email>.<base64url secret>, only the sha256 of the secret is stored
+// in redis under the email until the link expires, so it is independent of the numeric codes of the email.
+
+const loginLinkSecretLen = 32
+
+func hashLoginLinkSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func parseLoginLinkToken(token string) (email string, secret string, err error) {
+	encodedEmail, secret, ok := strings.Cut(token, ".")
+	if !ok || secret == "" {
+		return "", "", errs.ErrArgs.WrapMsg("invalid login link token")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encodedEmail)
+	if err != nil || len(data) == 0 {
+		return "", "", errs.ErrArgs.WrapMsg("invalid login link token")
+	}
+	return string(data), secret, nil
+}
+
+func (o *chatSvr) loginLinkEnabled() error {
+	if o.Mail == nil || o.conf.Mail.LoginLink.URL == "" {
+		return errs.ErrInternalServer.WrapMsg("email login link is not enabled")
+	}
+	return nil
+}
+
+func (o *chatSvr) sendLoginLink(ctx context.Context, req *chat.SendVerifyCodeReq) (*chat.SendVerifyCodeResp, error) {
+	if req.Email == "" {
+		return nil, errs.ErrArgs.WrapMsg("email is empty")
+	}
+	if err := o.loginLinkEnabled(); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.TakeAttributeByEmail(ctx, req.Email); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.WrapMsg("email unregistered")
+		}
+		return nil, err
+	}
+	link, err := url.Parse(o.conf.Mail.LoginLink.URL)
+	if err != nil {
+		return nil, errs.ErrInternalServer.WrapMsg("invalid login link url", "url", o.conf.Mail.LoginLink.URL)
+	}
+	data := make([]byte, loginLinkSecretLen)
+	if _, err := rand.Read(data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(data)
+	query := link.Query()
+	query.Set("token", base64.RawURLEncoding.EncodeToString([]byte(req.Email))+"."+secret)
+	link.RawQuery = query.Encode()
+
+	count, err := o.Database.LoginLinkHit(ctx, req.Email, o.Code.UintTime)
+	if err != nil {
+		return nil, err
+	}
+	if int(count) > o.Code.MaxCount {
+		return nil, eerrs.ErrVerifyCodeSendFrequently.Wrap()
+	}
+	platformName := constantpb.PlatformIDToName(int(req.Platform))
+	if platformName == "" {
+		platformName = fmt.Sprintf("platform:%d", req.Platform)
+	}
+	validTime := time.Duration(o.conf.Mail.LoginLink.ValidTime) * time.Second
+	if err := o.Database.SetLoginLink(ctx, req.Email, hashLoginLinkSecret(secret), validTime); err != nil {
+		return nil, err
+	}
+	if err := o.Mail.SendLoginLink(ctx, req.Email, link.String(), validTime, req.Language); err != nil {
+		return nil, err
+	}
+	log.ZDebug(ctx, "send login link success", "email", req.Email, "platform", platformName)
+	return &chat.SendVerifyCodeResp{}, nil
+}
+
+// LoginByLink exchanges the token of a login link for a chat token, a link can only be used once.
+func (o *chatSvr) LoginByLink(ctx context.Context, req *chat.LoginByLinkReq) (*chat.LoginResp, error) {
+	if err := o.loginLinkEnabled(); err != nil {
+		return nil, err
+	}
+	email, secret, err := parseLoginLinkToken(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := o.Database.TakeCredentialByAccount(ctx, email)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, o.loginFailed(ctx, "", req.Ip, eerrs.ErrAccountNotFound.WrapMsg("user unregistered"))
+		}
+		return nil, err
+	}
+	if err := o.Admin.CheckLogin(ctx, credential.UserID, req.Ip); err != nil {
+		return nil, err
+	}
+	hash, err := o.Database.TakeLoginLink(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if hash == "" {
+		return nil, eerrs.ErrVerifyCodeExpired.Wrap()
+	}
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(hashLoginLinkSecret(secret))) != 1 {
+		return nil, o.loginFailed(ctx, credential.UserID, req.Ip, eerrs.ErrVerifyCodeNotMatch.Wrap())
+	}
+	if err := o.checkTwoFactor(ctx, credential.UserID, req.TwoFactorCode); err != nil {
+		if eerrs.ErrTwoFactorCodeInvalid.Is(err) {
+			return nil, o.loginFailed(ctx, credential.UserID, req.Ip, err)
+		}
+		return nil, err
+	}
+	used, err := o.Database.UseLoginLink(ctx, email, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		return nil, eerrs.ErrVerifyCodeUsed.Wrap()
+	}
+	return o.finishLogin(ctx, credential.UserID, req.Platform, req.DeviceID, req.Ip, nil)
+}
@@ -0,0 +1,255 @@
+package chat
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/oauth"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+const oauthAccountPrefix = "oauth:"
+
+// oauthAccount is the credential account of an identity, providers are named in the configuration.
+func oauthAccount(provider string, subject string) string {
+	return oauthAccountPrefix + provider + ":" + subject
+}
+
+func parseOAuthAccount(account string) (provider string, subject string, ok bool) {
+	if !strings.HasPrefix(account, oauthAccountPrefix) {
+		return "", "", false
+	}
+	return strings.Cut(account[len(oauthAccountPrefix):], ":")
+}
+
+func (o *chatSvr) oauthProvider(name string) (oauth.Provider, error) {
+	provider, ok := o.OAuth[strings.ToLower(name)]
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("unknown oauth provider", "provider", name)
+	}
+	return provider, nil
+}
+
+// oauthIdentity redeems the authorization code at the provider.
+func (o *chatSvr) oauthIdentity(ctx context.Context, name string, code string, codeVerifier string, nonce string) (*oauth.Identity, error) {
+	provider, err := o.oauthProvider(name)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Exchange(ctx, code, codeVerifier, nonce)
+}
+
+func (o *chatSvr) GetOAuthProviders(ctx context.Context, req *chat.GetOAuthProvidersReq) (*chat.GetOAuthProvidersResp, error) {
+	providers := make([]string, 0, len(o.OAuth))
+	for name := range o.OAuth {
+		providers = append(providers, name)
+	}
+	sort.Strings(providers)
+	return &chat.GetOAuthProvidersResp{Providers: providers}, nil
+}
+
+func (o *chatSvr) OAuthAuthorize(ctx context.Context, req *chat.OAuthAuthorizeReq) (*chat.OAuthAuthorizeResp, error) {
+	provider, err := o.oauthProvider(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	url, err := provider.AuthCodeURL(ctx, &oauth.AuthRequest{
+		State:         req.State,
+		CodeChallenge: req.CodeChallenge,
+		Nonce:         req.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chat.OAuthAuthorizeResp{Url: url}, nil
+}
+
+// OAuthLogin logs in the user linked to the identity, an identity that is not linked registers a new user
+// when auto registration is enabled.
+func (o *chatSvr) OAuthLogin(ctx context.Context, req *chat.OAuthLoginReq) (*chat.OAuthLoginResp, error) {
+	identity, err := o.oauthIdentity(ctx, req.Provider, req.Code, req.CodeVerifier, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	account := oauthAccount(identity.Provider, identity.Subject)
+	credential, err := o.Database.TakeCredentialByAccount(ctx, account)
+	if err == nil {
+		if err := o.Admin.CheckLogin(ctx, credential.UserID, req.Ip); err != nil {
+			return nil, err
+		}
+		if err := o.checkTwoFactor(ctx, credential.UserID, req.TwoFactorCode); err != nil {
+			if eerrs.ErrTwoFactorCodeInvalid.Is(err) {
+				return nil, o.loginFailed(ctx, credential.UserID, req.Ip, err)
+			}
+			return nil, err
+		}
+		resp, err := o.finishLogin(ctx, credential.UserID, req.Platform, req.DeviceID, req.Ip, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &chat.OAuthLoginResp{
+			ChatToken:    resp.ChatToken,
+			UserID:       resp.UserID,
+			RefreshToken: resp.RefreshToken,
+			Expire:       resp.Expire,
+		}, nil
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	if !o.OAuthAutoRegister {
+		return nil, eerrs.ErrAccountNotFound.WrapMsg("oauth identity is not linked to any user")
+	}
+	user := &chat.RegisterUserInfo{
+		Nickname: identity.Nickname,
+		FaceURL:  identity.FaceURL,
+	}
+	if identity.Email != "" && identity.EmailVerified {
+		// registering fails when the email belongs to a user already, who should log in and link the identity
+		user.Email = identity.Email
+	}
+	if user.Nickname == "" {
+		user.Nickname, _, _ = strings.Cut(identity.Email, "@")
+	}
+	registered, err := o.registerUser(ctx, &chat.RegisterUserReq{
+		InvitationCode: req.InvitationCode,
+		Ip:             req.Ip,
+		DeviceID:       req.DeviceID,
+		Platform:       req.Platform,
+		User:           user,
+	}, &chatdb.Credential{
+		Account:     account,
+		Type:        constant.CredentialOAuth,
+		AllowChange: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.finishLogin(ctx, registered.UserID, req.Platform, req.DeviceID, req.Ip, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.OAuthLoginResp{
+		ChatToken:    resp.ChatToken,
+		UserID:       resp.UserID,
+		RefreshToken: resp.RefreshToken,
+		Expire:       resp.Expire,
+		Registered:   true,
+		Nickname:     user.Nickname,
+		FaceURL:      user.FaceURL,
+	}, nil
+}
+
+// LinkOAuth links an identity to the current user, one identity per provider.
+func (o *chatSvr) LinkOAuth(ctx context.Context, req *chat.LinkOAuthReq) (*chat.LinkOAuthResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := o.oauthIdentity(ctx, req.Provider, req.Code, req.CodeVerifier, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	account := oauthAccount(identity.Provider, identity.Subject)
+	credential, err := o.Database.TakeCredentialByAccount(ctx, account)
+	if err == nil {
+		if credential.UserID == userID {
+			return &chat.LinkOAuthResp{}, nil
+		}
+		return nil, eerrs.ErrAccountAlreadyRegister.WrapMsg("oauth identity is linked to another user")
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	credentials, err := o.Database.FindCredentialsByType(ctx, userID, constant.CredentialOAuth)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range credentials {
+		if provider, _, _ := parseOAuthAccount(c.Account); provider == identity.Provider {
+			return nil, errs.ErrArgs.WrapMsg("an identity of the provider is linked already, unlink it first", "provider", provider)
+		}
+	}
+	err = o.Database.AddCredential(ctx, &chatdb.Credential{
+		UserID:      userID,
+		Account:     account,
+		Type:        constant.CredentialOAuth,
+		AllowChange: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chat.LinkOAuthResp{}, nil
+}
+
+// UnlinkOAuth removes the identity of a provider, the user must keep another way to log in.
+func (o *chatSvr) UnlinkOAuth(ctx context.Context, req *chat.UnlinkOAuthReq) (*chat.UnlinkOAuthResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := o.Database.TakeCredentialsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		unlink     *chatdb.Credential
+		canLogin   bool
+		hasAccount bool
+	)
+	for _, c := range credentials {
+		if provider, _, ok := parseOAuthAccount(c.Account); ok && c.Type == constant.CredentialOAuth && provider == strings.ToLower(req.Provider) {
+			unlink = c
+			continue
+		}
+		switch c.Type {
+		case constant.CredentialAccount:
+			hasAccount = true
+		default:
+			canLogin = true
+		}
+	}
+	if unlink == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("oauth identity is not linked", "provider", req.Provider)
+	}
+	if !canLogin && hasAccount {
+		account, err := o.Database.TakeAccount(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		canLogin = account.Password != ""
+	}
+	if !canLogin {
+		return nil, errs.ErrNoPermission.WrapMsg("the last way to log in can not be unlinked")
+	}
+	if err := o.Database.DelCredentialAccount(ctx, userID, unlink.Account); err != nil {
+		return nil, err
+	}
+	return &chat.UnlinkOAuthResp{}, nil
+}
+
+func (o *chatSvr) GetOAuthIdentities(ctx context.Context, req *chat.GetOAuthIdentitiesReq) (*chat.GetOAuthIdentitiesResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := o.Database.FindCredentialsByType(ctx, userID, constant.CredentialOAuth)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.GetOAuthIdentitiesResp{Identities: make([]*chat.OAuthIdentity, 0, len(credentials))}
+	for _, c := range credentials {
+		provider, subject, ok := parseOAuthAccount(c.Account)
+		if !ok {
+			continue
+		}
+		resp.Identities = append(resp.Identities, &chat.OAuthIdentity{Provider: provider, Subject: subject})
+	}
+	return resp, nil
+}
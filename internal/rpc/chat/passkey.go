@@ -0,0 +1,349 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// The session of a ceremony is kept in redis until the client returns the credential or the ceremony
+// times out, the challenge is the session id.
+
+// passkeyUser is a user with the passkeys, the user handle is the user id.
+type passkeyUser struct {
+	attribute   *chatdb.Attribute
+	credentials []webauthn.Credential
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(u.attribute.UserID)
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return twoFactorLabel(u.attribute)
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	if u.attribute.Nickname != "" {
+		return u.attribute.Nickname
+	}
+	return u.WebAuthnName()
+}
+
+func (u *passkeyUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
+
+func (o *chatSvr) passkeyEnabled() error {
+	if o.WebAuthn == nil {
+		return errs.ErrInternalServer.WrapMsg("passkey is not enabled")
+	}
+	return nil
+}
+
+func (o *chatSvr) takePasskeyUser(ctx context.Context, userID string) (*passkeyUser, error) {
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	passkeys, err := o.Database.FindPasskeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	user := &passkeyUser{attribute: attribute, credentials: make([]webauthn.Credential, 0, len(passkeys))}
+	for _, passkey := range passkeys {
+		credential, err := passkeyCredential(passkey)
+		if err != nil {
+			return nil, err
+		}
+		user.credentials = append(user.credentials, credential)
+	}
+	return user, nil
+}
+
+func passkeyCredential(passkey *chatdb.Passkey) (webauthn.Credential, error) {
+	id, err := base64.RawURLEncoding.DecodeString(passkey.CredentialID)
+	if err != nil {
+		return webauthn.Credential{}, errs.WrapMsg(err, "invalid passkey credential id", "credentialID", passkey.CredentialID)
+	}
+	return webauthn.Credential{
+		ID:              id,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Transport: datautil.Slice(passkey.Transports, func(t string) protocol.AuthenticatorTransport {
+			return protocol.AuthenticatorTransport(t)
+		}),
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: passkey.BackupEligible,
+			BackupState:    passkey.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    passkey.AAGUID,
+			SignCount: passkey.SignCount,
+		},
+	}, nil
+}
+
+func (o *chatSvr) savePasskeySession(ctx context.Context, session *webauthn.SessionData) (string, error) {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	expire := o.Code.ValidTime
+	if !session.Expires.IsZero() {
+		expire = time.Until(session.Expires)
+	}
+	if err := o.Database.SetPasskeySession(ctx, session.Challenge, data, expire); err != nil {
+		return "", err
+	}
+	return session.Challenge, nil
+}
+
+// takePasskeySession returns the session of a ceremony, a session can only be taken once whether the
+// ceremony succeeds or not.
+func (o *chatSvr) takePasskeySession(ctx context.Context, sessionID string) (*webauthn.SessionData, error) {
+	if sessionID == "" {
+		return nil, errs.ErrArgs.WrapMsg("sessionID is empty")
+	}
+	data, err := o.Database.TakePasskeySession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, eerrs.ErrVerifyCodeExpired.WrapMsg("passkey session not found or expired")
+	}
+	var session webauthn.SessionData
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, errs.WrapMsg(err, "invalid passkey session")
+	}
+	return &session, nil
+}
+
+func (o *chatSvr) BeginPasskeyRegistration(ctx context.Context, req *chat.BeginPasskeyRegistrationReq) (*chat.BeginPasskeyRegistrationResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.passkeyEnabled(); err != nil {
+		return nil, err
+	}
+	user, err := o.takePasskeyUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	exclusions := datautil.Slice(user.credentials, func(c webauthn.Credential) protocol.CredentialDescriptor {
+		return c.Descriptor()
+	})
+	creation, session, err := o.WebAuthn.BeginRegistration(user, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "begin passkey registration failed")
+	}
+	options, err := json.Marshal(creation)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sessionID, err := o.savePasskeySession(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.BeginPasskeyRegistrationResp{Options: string(options), SessionID: sessionID}, nil
+}
+
+func (o *chatSvr) FinishPasskeyRegistration(ctx context.Context, req *chat.FinishPasskeyRegistrationReq) (*chat.FinishPasskeyRegistrationResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.passkeyEnabled(); err != nil {
+		return nil, err
+	}
+	session, err := o.takePasskeySession(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(session.UserID, []byte(userID)) {
+		return nil, errs.ErrNoPermission.WrapMsg("passkey session of another user")
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBody(strings.NewReader(req.Credential))
+	if err != nil {
+		return nil, eerrs.ErrPasskeyInvalid.WrapMsg(protocolErrMsg(err))
+	}
+	user, err := o.takePasskeyUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := o.WebAuthn.CreateCredential(user, *session, parsed)
+	if err != nil {
+		return nil, eerrs.ErrPasskeyInvalid.WrapMsg(protocolErrMsg(err))
+	}
+	now := time.Now()
+	passkey := &chatdb.Passkey{
+		CredentialID:    base64.RawURLEncoding.EncodeToString(credential.ID),
+		UserID:          userID,
+		Name:            req.Name,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports: datautil.Slice(credential.Transport, func(t protocol.AuthenticatorTransport) string {
+			return string(t)
+		}),
+		AAGUID:         credential.Authenticator.AAGUID,
+		SignCount:      credential.Authenticator.SignCount,
+		BackupEligible: credential.Flags.BackupEligible,
+		BackupState:    credential.Flags.BackupState,
+		CreateTime:     now,
+		LastUsedTime:   now,
+	}
+	if _, err := o.Database.TakePasskey(ctx, passkey.CredentialID); err == nil {
+		return nil, eerrs.ErrPasskeyInvalid.WrapMsg("passkey is registered already")
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	if err := o.Database.AddPasskey(ctx, passkey); err != nil {
+		return nil, err
+	}
+	return &chat.FinishPasskeyRegistrationResp{Passkey: passkeyPb(passkey)}, nil
+}
+
+func (o *chatSvr) BeginPasskeyLogin(ctx context.Context, req *chat.BeginPasskeyLoginReq) (*chat.BeginPasskeyLoginResp, error) {
+	if err := o.passkeyEnabled(); err != nil {
+		return nil, err
+	}
+	assertion, session, err := o.WebAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "begin passkey login failed")
+	}
+	options, err := json.Marshal(assertion)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sessionID, err := o.savePasskeySession(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.BeginPasskeyLoginResp{Options: string(options), SessionID: sessionID}, nil
+}
+
+// PasskeyLogin logs in with the assertion of a discoverable passkey, which verifies the user on the
+// authenticator, so two-factor authentication is not asked for.
+func (o *chatSvr) PasskeyLogin(ctx context.Context, req *chat.PasskeyLoginReq) (*chat.LoginResp, error) {
+	if err := o.passkeyEnabled(); err != nil {
+		return nil, err
+	}
+	session, err := o.takePasskeySession(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBody(strings.NewReader(req.Credential))
+	if err != nil {
+		return nil, eerrs.ErrPasskeyInvalid.WrapMsg(protocolErrMsg(err))
+	}
+	credentialID := base64.RawURLEncoding.EncodeToString(parsed.RawID)
+	passkey, err := o.Database.TakePasskey(ctx, credentialID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, o.loginFailed(ctx, "", req.Ip, eerrs.ErrPasskeyInvalid.WrapMsg("passkey not registered"))
+		}
+		return nil, err
+	}
+	if string(parsed.Response.UserHandle) != passkey.UserID {
+		return nil, o.loginFailed(ctx, passkey.UserID, req.Ip, eerrs.ErrPasskeyInvalid.WrapMsg("user handle mismatch"))
+	}
+	if err := o.Admin.CheckLogin(ctx, passkey.UserID, req.Ip); err != nil {
+		return nil, err
+	}
+	user, err := o.takePasskeyUser(ctx, passkey.UserID)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := o.WebAuthn.ValidateDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+		return user, nil
+	}, *session, parsed)
+	if err != nil {
+		return nil, o.loginFailed(ctx, passkey.UserID, req.Ip, eerrs.ErrPasskeyInvalid.WrapMsg(protocolErrMsg(err)))
+	}
+	if credential.Authenticator.CloneWarning {
+		return nil, o.loginFailed(ctx, passkey.UserID, req.Ip, eerrs.ErrPasskeyInvalid.WrapMsg("signature counter of the passkey went backwards"))
+	}
+	ok, err := o.Database.UsePasskey(ctx, credentialID, credential.Authenticator.SignCount, credential.Flags.BackupState)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, o.loginFailed(ctx, passkey.UserID, req.Ip, eerrs.ErrPasskeyInvalid.WrapMsg("passkey was used concurrently"))
+	}
+	return o.finishLogin(ctx, passkey.UserID, req.Platform, req.DeviceID, req.Ip, nil)
+}
+
+func (o *chatSvr) GetPasskeys(ctx context.Context, req *chat.GetPasskeysReq) (*chat.GetPasskeysResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	passkeys, err := o.Database.FindPasskeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.GetPasskeysResp{Passkeys: datautil.Slice(passkeys, passkeyPb)}, nil
+}
+
+func (o *chatSvr) DelPasskey(ctx context.Context, req *chat.DelPasskeyReq) (*chat.DelPasskeyResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelPasskey(ctx, userID, req.CredentialID); err != nil {
+		return nil, err
+	}
+	return &chat.DelPasskeyResp{}, nil
+}
+
+func passkeyPb(passkey *chatdb.Passkey) *chat.Passkey {
+	return &chat.Passkey{
+		CredentialID: passkey.CredentialID,
+		Name:         passkey.Name,
+		Transports:   passkey.Transports,
+		BackupState:  passkey.BackupState,
+		CreateTime:   passkey.CreateTime.UnixMilli(),
+		LastUsedTime: passkey.LastUsedTime.UnixMilli(),
+	}
+}
+
+// protocolErrMsg returns the details of a webauthn error, which are meant for the client.
+func protocolErrMsg(err error) string {
+	if e, ok := err.(*protocol.Error); ok && e.Details != "" {
+		return e.Details
+	}
+	return err.Error()
+}
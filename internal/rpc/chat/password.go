@@ -16,11 +16,16 @@ package chat
 
 import (
 	"context"
+	"strings"
 
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/password"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 )
 
@@ -36,6 +41,9 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 	var verifyCodeID string
 	var err error
 	if req.Email == "" {
+		if err := normalizePhone(&req.AreaCode, &req.PhoneNumber); err != nil {
+			return nil, err
+		}
 		verifyCodeID, err = o.verifyCode(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), req.VerifyCode, phone)
 	} else {
 		verifyCodeID, err = o.verifyCode(ctx, req.Email, req.VerifyCode, mail)
@@ -54,7 +62,25 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 	if err != nil {
 		return nil, err
 	}
-	err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, cred.UserID, req.Password, verifyCodeID)
+	policy, err := o.passwordPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPasswordRules(policy, req.Password); err != nil {
+		return nil, err
+	}
+	user, err := o.Database.GetUser(ctx, cred.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkPasswordHistory(policy, user, req.Password); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := o.hashPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, cred.UserID, hashedPassword, passwordHistory(policy, user), verifyCodeID)
 	if err != nil {
 		return nil, err
 	}
@@ -92,12 +118,34 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		return nil, err
 	}
 	if userType != constant.AdminUser {
-		if user.Password != req.CurrentPassword {
+		match, err := o.Password.Verify(user.Password, req.CurrentPassword)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
 			return nil, errs.ErrNoPermission.WrapMsg("current password is wrong")
 		}
 	}
-	if user.Password != req.NewPassword {
-		if err := o.Database.UpdatePassword(ctx, req.UserID, req.NewPassword); err != nil {
+	policy, err := o.passwordPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPasswordRules(policy, req.NewPassword); err != nil {
+		return nil, err
+	}
+	if err := o.checkPasswordHistory(policy, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+	same, err := o.Password.Verify(user.Password, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	if !same {
+		hashedPassword, err := o.hashPassword(req.NewPassword)
+		if err != nil {
+			return nil, err
+		}
+		if err := o.Database.UpdatePassword(ctx, req.UserID, hashedPassword, passwordHistory(policy, user)); err != nil {
 			return nil, err
 		}
 	}
@@ -107,3 +155,141 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 
 	return &chat.ChangePasswordResp{}, nil
 }
+
+// checkExpiredPasswordChange checks the new password given with a login whose password has expired, before the
+// login is verified completely.
+func (o *chatSvr) checkExpiredPasswordChange(policy *password.Policy, account *chatdb.Account, currentPassword string, newPassword string) error {
+	if newPassword == currentPassword {
+		return eerrs.ErrPasswordReused.WrapMsg("new password == current password")
+	}
+	if err := checkPasswordRules(policy, newPassword); err != nil {
+		return err
+	}
+	return o.checkPasswordHistory(policy, account, newPassword)
+}
+
+// changeExpiredPassword replaces the expired password of a verified login and logs out the other sessions,
+// as changing the password does.
+func (o *chatSvr) changeExpiredPassword(ctx context.Context, policy *password.Policy, account *chatdb.Account, newPassword string) error {
+	hashedPassword, err := o.hashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	if err := o.Database.UpdatePassword(ctx, account.UserID, hashedPassword, passwordHistory(policy, account)); err != nil {
+		return err
+	}
+	return o.Admin.InvalidateToken(ctx, account.UserID)
+}
+
+// CheckPassword returns the rules of the password policy a plaintext password breaks, so clients hashing
+// passwords can enforce the policy before sending them.
+func (o *chatSvr) CheckPassword(ctx context.Context, req *chat.CheckPasswordReq) (*chat.CheckPasswordResp, error) {
+	policy, err := o.passwordPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.CheckPasswordResp{Violations: policy.Violations(req.Password)}, nil
+}
+
+func (o *chatSvr) passwordPolicy(ctx context.Context) (*password.Policy, error) {
+	conf, err := o.Admin.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return password.ParsePolicy(conf)
+}
+
+// checkNewPassword checks the password of a new user against the password policy.
+func (o *chatSvr) checkNewPassword(ctx context.Context, pwd string) error {
+	if pwd == "" {
+		return nil
+	}
+	policy, err := o.passwordPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	return checkPasswordRules(policy, pwd)
+}
+
+// checkPasswordRules enforces the length and character rules when clients send plaintext passwords.
+func checkPasswordRules(policy *password.Policy, pwd string) error {
+	if !policy.Plaintext || pwd == "" {
+		return nil
+	}
+	if rules := policy.Violations(pwd); len(rules) > 0 {
+		return eerrs.ErrPasswordPolicy.WrapMsg("password breaks the password policy", "rules", strings.Join(rules, ","))
+	}
+	return nil
+}
+
+// checkPasswordHistory rejects the current password and the previous ones kept by the policy.
+func (o *chatSvr) checkPasswordHistory(policy *password.Policy, account *chatdb.Account, pwd string) error {
+	if policy.History == 0 {
+		return nil
+	}
+	hashes := append([]string{account.Password}, account.PasswordHistory...)
+	if len(hashes) > policy.History {
+		hashes = hashes[:policy.History]
+	}
+	for _, hashed := range hashes {
+		if hashed == "" {
+			continue
+		}
+		match, err := o.Password.Verify(hashed, pwd)
+		if err != nil {
+			return err
+		}
+		if match {
+			return eerrs.ErrPasswordReused.WrapMsg("password was used recently", "history", policy.History)
+		}
+	}
+	return nil
+}
+
+// passwordHistory returns the previous passwords to keep once the current password of account is replaced.
+func passwordHistory(policy *password.Policy, account *chatdb.Account) []string {
+	if policy.History <= 1 {
+		return nil
+	}
+	history := make([]string, 0, policy.History-1)
+	if account.Password != "" {
+		history = append(history, account.Password)
+	}
+	for _, hashed := range account.PasswordHistory {
+		if len(history) == policy.History-1 {
+			break
+		}
+		history = append(history, hashed)
+	}
+	return history
+}
+
+// hashPassword returns the value to store for a client supplied password, an empty password stays empty.
+func (o *chatSvr) hashPassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	return o.Password.Hash(password)
+}
+
+// checkPassword verifies a login password and upgrades legacy or outdated hashes in place.
+func (o *chatSvr) checkPassword(ctx context.Context, account *chatdb.Account, password string) error {
+	match, err := o.Password.Verify(account.Password, password)
+	if err != nil {
+		return err
+	}
+	if !match {
+		return eerrs.ErrPassword.Wrap()
+	}
+	if o.Password.NeedsRehash(account.Password) {
+		hashed, err := o.Password.Hash(password)
+		if err != nil {
+			log.ZError(ctx, "rehash password failed", err, "userID", account.UserID)
+			return nil
+		}
+		if err := o.Database.UpdatePasswordHash(ctx, account.UserID, hashed); err != nil {
+			log.ZError(ctx, "update password hash failed", err, "userID", account.UserID)
+		}
+	}
+	return nil
+}
@@ -2,24 +2,33 @@ package chat
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/common/rtc"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mw"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/openimsdk/chat/pkg/common/config"
 	"github.com/openimsdk/chat/pkg/common/db/database"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/directory"
 	"github.com/openimsdk/chat/pkg/email"
+	"github.com/openimsdk/chat/pkg/oauth"
+	"github.com/openimsdk/chat/pkg/password"
 	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
 	"github.com/openimsdk/chat/pkg/sms"
 )
@@ -30,6 +39,7 @@ type Config struct {
 	MongodbConfig config.Mongo
 	Discovery     config.Discovery
 	Share         config.Share
+	ConfigPath    string
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
@@ -43,19 +53,51 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	var srv chatSvr
 	config.RpcConfig.VerifyCode.Phone.Use = strings.ToLower(config.RpcConfig.VerifyCode.Phone.Use)
 	config.RpcConfig.VerifyCode.Mail.Use = strings.ToLower(config.RpcConfig.VerifyCode.Mail.Use)
+	for _, use := range []*string{&config.RpcConfig.VerifyCode.Phone.Use, &config.RpcConfig.VerifyCode.Mail.Use} {
+		if *use == constant.VerifySuperCode {
+			log.ZWarn(ctx, "verifyCode use superCode is deprecated and works like none, the super code is no longer accepted, add test accounts instead", nil)
+			*use = constant.VerifyNone
+		}
+	}
 	srv.conf = config.RpcConfig.VerifyCode
-	switch config.RpcConfig.VerifyCode.Phone.Use {
-	case "ali":
-		ali := config.RpcConfig.VerifyCode.Phone.Ali
-		srv.SMS, err = sms.NewAli(ali.Endpoint, ali.AccessKeyID, ali.AccessKeySecret, ali.SignName, ali.VerificationCodeTemplateCode)
+	srv.SMS, err = config.RpcConfig.VerifyCode.Phone.Build()
+	if err != nil {
+		return err
+	}
+	if mail := config.RpcConfig.VerifyCode.Mail; mail.Use == constant.VerifyMail {
+		templateDir := mail.TemplateDir
+		if templateDir != "" && !filepath.IsAbs(templateDir) {
+			templateDir = filepath.Join(config.ConfigPath, templateDir)
+		}
+		templates, err := email.NewTemplates(templateDir, mail.DefaultLanguage)
 		if err != nil {
 			return err
 		}
+		validTime := time.Duration(config.RpcConfig.VerifyCode.ValidTime) * time.Second
+		srv.Mail = email.NewMail(mail.SMTPAddr, mail.SMTPPort, mail.SenderMail, mail.SenderAuthorizationCode, mail.Title, validTime, templates)
 	}
-	if mail := config.RpcConfig.VerifyCode.Mail; mail.Use == constant.VerifyMail {
-		srv.Mail = email.NewMail(mail.SMTPAddr, mail.SMTPPort, mail.SenderMail, mail.SenderAuthorizationCode, mail.Title)
+	srv.OAuth, err = config.RpcConfig.OAuth.Build()
+	if err != nil {
+		return err
 	}
-	srv.Database, err = database.NewChatDatabase(mgocli)
+	srv.OAuthAutoRegister = config.RpcConfig.OAuth.AutoRegister
+	srv.Directory, err = config.Share.LDAP.Build()
+	if err != nil {
+		return err
+	}
+	srv.WebAuthn, err = config.RpcConfig.WebAuthn.Build()
+	if err != nil {
+		return err
+	}
+	srv.Password, err = config.Share.PasswordHash.Build()
+	if err != nil {
+		return err
+	}
+	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	if err != nil {
+		return err
+	}
+	srv.Database, err = database.NewChatDatabase(mgocli, rdb)
 	if err != nil {
 		return err
 	}
@@ -68,12 +110,34 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		UintTime:   time.Duration(config.RpcConfig.VerifyCode.UintTime) * time.Second,
 		MaxCount:   config.RpcConfig.VerifyCode.MaxCount,
 		ValidCount: config.RpcConfig.VerifyCode.ValidCount,
-		SuperCode:  config.RpcConfig.VerifyCode.SuperCode,
 		ValidTime:  time.Duration(config.RpcConfig.VerifyCode.ValidTime) * time.Second,
 		Len:        config.RpcConfig.VerifyCode.Len,
 	}
 	srv.Livekit = rtc.NewLiveKit(config.RpcConfig.LiveKit.Key, config.RpcConfig.LiveKit.Secret, config.RpcConfig.LiveKit.URL)
 	srv.AllowRegister = config.RpcConfig.AllowRegister
+	srv.TwoFactorIssuer = config.RpcConfig.TwoFactor.Issuer
+	srv.DeletionCooldown = time.Duration(max(config.RpcConfig.UserDeletion.Cooldown, 0)) * 24 * time.Hour
+	if export := config.Share.UserExport; export.Enable {
+		if export.Dir == "" || export.URL == "" || export.Secret == "" || export.NotificationUserID == "" {
+			return errs.New("userExport requires dir, url, secret and notificationUserID")
+		}
+		if export.Expire <= 0 {
+			return errs.New("userExport expire must be positive")
+		}
+		if err := os.MkdirAll(export.Dir, 0o700); err != nil {
+			return errs.WrapMsg(err, "create user export dir failed", "dir", export.Dir)
+		}
+		srv.Export = &userExport{
+			Dir:                  export.Dir,
+			URL:                  strings.TrimSuffix(export.URL, "/"),
+			Secret:               export.Secret,
+			Expire:               time.Duration(export.Expire) * time.Hour,
+			NotificationUserID:   export.NotificationUserID,
+			NotificationNickname: export.NotificationNickname,
+		}
+		srv.IM = imapi.New(config.Share.OpenIM.ApiURL, config.Share.OpenIM.Secret, config.Share.OpenIM.AdminUserID)
+		srv.startUserExportCleanup(ctx)
+	}
 	chat.RegisterChatServer(server, &srv)
 	return nil
 }
@@ -85,10 +149,23 @@ type chatSvr struct {
 	Admin           *chatClient.AdminClient
 	SMS             sms.SMS
 	Mail            email.Mail
+	OAuth           map[string]oauth.Provider
+	Directory       *directory.Directory // nil when ldap is disabled
+	WebAuthn        *webauthn.WebAuthn   // nil when passkeys are disabled
+	Password        password.Hasher
 	Code            verifyCode
 	Livekit         *rtc.LiveKit
 	ChatAdminUserID string
 	AllowRegister   bool
+	TwoFactorIssuer string
+	// OAuthAutoRegister registers a user for an oauth identity that is not linked
+	OAuthAutoRegister bool
+	// DeletionCooldown is the time from a deletion request of a user to the purge
+	DeletionCooldown time.Duration
+	// Export is nil when personal data export is disabled
+	Export *userExport
+	// IM sends the export notifications, it is nil when export is disabled
+	IM imapi.CallerInterface
 }
 
 func (o *chatSvr) WithAdminUser(ctx context.Context) context.Context {
@@ -99,7 +176,6 @@ type verifyCode struct {
 	UintTime   time.Duration // sec
 	MaxCount   int
 	ValidCount int
-	SuperCode  string
 	ValidTime  time.Duration
 	Len        int
 }
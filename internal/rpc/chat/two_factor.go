@@ -0,0 +1,253 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/totp"
+)
+
+func (o *chatSvr) SetupTwoFactor(ctx context.Context, req *chat.SetupTwoFactorReq) (*chat.SetupTwoFactorResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf != nil && tf.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication already enabled")
+	}
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.SetTwoFactor(ctx, &chatdb.TwoFactor{
+		UserID:     userID,
+		Secret:     secret,
+		CreateTime: time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+	return &chat.SetupTwoFactorResp{
+		Secret: secret,
+		Uri:    totp.URI(o.TwoFactorIssuer, twoFactorLabel(attribute), secret),
+	}, nil
+}
+
+func (o *chatSvr) ConfirmTwoFactor(ctx context.Context, req *chat.ConfirmTwoFactorReq) (*chat.ConfirmTwoFactorResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication is not set up")
+	}
+	if tf.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication already enabled")
+	}
+	step, ok := totp.Validate(tf.Secret, req.Code, time.Now())
+	if !ok {
+		return nil, eerrs.ErrTwoFactorCodeInvalid.Wrap()
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTwoFactor(ctx, userID, map[string]any{
+		"enabled":        true,
+		"recovery_codes": hashes,
+		"last_step":      step,
+		"enable_time":    time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+	return &chat.ConfirmTwoFactorResp{RecoveryCodes: codes}, nil
+}
+
+func (o *chatSvr) DisableTwoFactor(ctx context.Context, req *chat.DisableTwoFactorReq) (*chat.DisableTwoFactorResp, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch userType {
+	case constant.NormalUser:
+		if req.UserID == "" {
+			req.UserID = opUserID
+		}
+		if req.UserID != opUserID {
+			return nil, errs.ErrNoPermission.WrapMsg("no permission disable other user two-factor authentication")
+		}
+		tf, err := o.takeTwoFactor(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if tf == nil {
+			return &chat.DisableTwoFactorResp{}, nil
+		}
+		if tf.Enabled {
+			if req.Code == "" {
+				return nil, eerrs.ErrTwoFactorRequired.Wrap()
+			}
+			if err := o.verifyTwoFactor(ctx, tf, req.Code); err != nil {
+				return nil, err
+			}
+		}
+	case constant.AdminUser:
+		if req.UserID == "" {
+			return nil, errs.ErrArgs.WrapMsg("user id must be set")
+		}
+	default:
+		return nil, errs.ErrInternalServer.WrapMsg("invalid user type")
+	}
+	if err := o.Database.DelTwoFactor(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	return &chat.DisableTwoFactorResp{}, nil
+}
+
+func (o *chatSvr) RegenerateRecoveryCodes(ctx context.Context, req *chat.RegenerateRecoveryCodesReq) (*chat.RegenerateRecoveryCodesResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil || !tf.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication is not enabled")
+	}
+	if err := o.verifyTwoFactor(ctx, tf, req.Code); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTwoFactor(ctx, userID, map[string]any{"recovery_codes": hashes}); err != nil {
+		return nil, err
+	}
+	return &chat.RegenerateRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (o *chatSvr) GetTwoFactor(ctx context.Context, req *chat.GetTwoFactorReq) (*chat.GetTwoFactorResp, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch userType {
+	case constant.NormalUser:
+		req.UserID = opUserID
+	case constant.AdminUser:
+		if req.UserID == "" {
+			return nil, errs.ErrArgs.WrapMsg("user id must be set")
+		}
+	default:
+		return nil, errs.ErrInternalServer.WrapMsg("invalid user type")
+	}
+	tf, err := o.takeTwoFactor(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil || !tf.Enabled {
+		return &chat.GetTwoFactorResp{}, nil
+	}
+	return &chat.GetTwoFactorResp{
+		Enabled:           true,
+		RecoveryCodeCount: int32(len(tf.RecoveryCodes)),
+		EnableTime:        tf.EnableTime.UnixMilli(),
+	}, nil
+}
+
+// checkTwoFactor is the second login step, it passes when the user has not enabled two-factor authentication.
+func (o *chatSvr) checkTwoFactor(ctx context.Context, userID string, code string) error {
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if tf == nil || !tf.Enabled {
+		return nil
+	}
+	if code == "" {
+		return eerrs.ErrTwoFactorRequired.Wrap()
+	}
+	return o.verifyTwoFactor(ctx, tf, code)
+}
+
+// verifyTwoFactor accepts a TOTP code that has not been used yet, or consumes a recovery code.
+func (o *chatSvr) verifyTwoFactor(ctx context.Context, tf *chatdb.TwoFactor, code string) error {
+	if step, ok := totp.Validate(tf.Secret, code, time.Now()); ok {
+		used, err := o.Database.UseTwoFactorStep(ctx, tf.UserID, step)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return eerrs.ErrTwoFactorCodeInvalid.WrapMsg("code already used")
+		}
+		return nil
+	}
+	used, err := o.Database.UseTwoFactorRecoveryCode(ctx, tf.UserID, totp.HashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return eerrs.ErrTwoFactorCodeInvalid.Wrap()
+	}
+	return nil
+}
+
+func (o *chatSvr) takeTwoFactor(ctx context.Context, userID string) (*chatdb.TwoFactor, error) {
+	tf, err := o.Database.TakeTwoFactor(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tf, nil
+}
+
+func twoFactorLabel(attribute *chatdb.Attribute) string {
+	switch {
+	case attribute.Account != "":
+		return attribute.Account
+	case attribute.Email != "":
+		return attribute.Email
+	case attribute.PhoneNumber != "":
+		return attribute.AreaCode + attribute.PhoneNumber
+	default:
+		return attribute.UserID
+	}
+}
@@ -20,8 +20,6 @@ import (
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/protocol/wrapperspb"
 	"github.com/openimsdk/tools/utils/stringutil"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
@@ -47,6 +45,11 @@ func (o *chatSvr) checkUpdateInfo(ctx context.Context, req *chat.UpdateUserInfoR
 				return errs.ErrArgs.WrapMsg("areaCode and phoneNumber must be set together")
 			}
 		}
+		if req.PhoneNumber.Value != "" {
+			if err := normalizePhone(&req.AreaCode.Value, &req.PhoneNumber.Value); err != nil {
+				return err
+			}
+		}
 	}
 	if req.UserID == "" {
 		return errs.ErrArgs.WrapMsg("user id is empty")
@@ -110,15 +113,6 @@ func (o *chatSvr) checkUpdateInfo(ctx context.Context, req *chat.UpdateUserInfoR
 	}
 
 	if req.PhoneNumber.GetValue() != "" {
-		if !strings.HasPrefix(req.AreaCode.GetValue(), "+") {
-			req.AreaCode.Value = "+" + req.AreaCode.Value
-		}
-		if _, err := strconv.ParseUint(req.AreaCode.Value[1:], 10, 64); err != nil {
-			return errs.ErrArgs.WrapMsg("area code must be number")
-		}
-		if _, err := strconv.ParseUint(req.PhoneNumber.GetValue(), 10, 64); err != nil {
-			return errs.ErrArgs.WrapMsg("phone number must be number")
-		}
 		_, err := o.Database.TakeCredentialByAccount(ctx, BuildCredentialPhone(req.AreaCode.GetValue(), req.PhoneNumber.GetValue()))
 		if err == nil {
 			return eerrs.ErrPhoneAlreadyRegister.Wrap()
@@ -169,6 +163,20 @@ func (o *chatSvr) UpdateUserInfo(ctx context.Context, req *chat.UpdateUserInfoRe
 		if req.UserID != opUserID {
 			return nil, errs.ErrNoPermission.WrapMsg("only admin can update other user info")
 		}
+		// checkUpdateInfo dropped the unchanged ones, a new value has to be confirmed with a code sent to it
+		if req.Email.GetValue() != "" || req.PhoneNumber.GetValue() != "" {
+			return nil, errs.ErrNoPermission.WrapMsg("email and phone number are changed with RequestContactChange and ConfirmContactChange")
+		}
+		if req.Email != nil {
+			if err := o.checkCredentialChangeable(ctx, req.UserID, constant.CredentialEmail); err != nil {
+				return nil, err
+			}
+		}
+		if req.PhoneNumber != nil {
+			if err := o.checkCredentialChangeable(ctx, req.UserID, constant.CredentialPhone); err != nil {
+				return nil, err
+			}
+		}
 
 	case constant.AdminUser:
 	default:
@@ -209,9 +217,17 @@ func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountRe
 		return nil, err
 	}
 
-	if err := o.checkRegisterInfo(ctx, req.User, true); err != nil {
+	if err := o.checkRegisterInfo(ctx, req.User, true, req.LdapUsername != ""); err != nil {
 		return nil, err
 	}
+	if req.LdapUsername != "" {
+		if _, err := mctx.CheckAdmin(ctx); err != nil {
+			return nil, err
+		}
+		if err := o.checkLDAPUsername(ctx, req.LdapUsername); err != nil {
+			return nil, err
+		}
+	}
 
 	if req.User.UserID == "" {
 		for i := 0; i < 20; i++ {
@@ -269,6 +285,14 @@ func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountRe
 		})
 	}
 
+	if req.LdapUsername != "" {
+		credentials = append(credentials, &chatdb.Credential{
+			UserID:  req.User.UserID,
+			Account: ldapAccount(req.LdapUsername),
+			Type:    constant.CredentialLDAP,
+		})
+	}
+
 	register := &chatdb.Register{
 		UserID:      req.User.UserID,
 		DeviceID:    req.DeviceID,
@@ -278,9 +302,16 @@ func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountRe
 		Mode:        constant.UserMode,
 		CreateTime:  time.Now(),
 	}
+	if err := o.checkNewPassword(ctx, req.User.Password); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := o.hashPassword(req.User.Password)
+	if err != nil {
+		return nil, err
+	}
 	account := &chatdb.Account{
 		UserID:         req.User.UserID,
-		Password:       req.User.Password,
+		Password:       hashedPassword,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		ChangeTime:     register.CreateTime,
 		CreateTime:     register.CreateTime,
@@ -305,7 +336,7 @@ func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountRe
 	if err := o.Database.RegisterUser(ctx, register, account, attribute, credentials); err != nil {
 		return nil, err
 	}
-	return &chat.AddUserAccountResp{}, nil
+	return &chat.AddUserAccountResp{UserID: req.User.UserID}, nil
 }
 
 func (o *chatSvr) SearchUserPublicInfo(ctx context.Context, req *chat.SearchUserPublicInfoReq) (*chat.SearchUserPublicInfoResp, error) {
@@ -405,6 +436,9 @@ func (o *chatSvr) CheckUserExist(ctx context.Context, req *chat.CheckUserExistRe
 		return nil, errs.ErrArgs.WrapMsg("user is nil")
 	}
 	if req.User.PhoneNumber != "" {
+		if err := normalizePhone(&req.User.AreaCode, &req.User.PhoneNumber); err != nil {
+			return nil, err
+		}
 		account, err := o.Database.TakeCredentialByAccount(ctx, BuildCredentialPhone(req.User.AreaCode, req.User.PhoneNumber))
 		// err != nil is not found User
 		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
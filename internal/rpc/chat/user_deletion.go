@@ -0,0 +1,121 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// RequestUserDeletion schedules the purge of the user after the cooldown and logs the user out,
+// logging in again before the purge cancels the request.
+func (o *chatSvr) RequestUserDeletion(ctx context.Context, req *chat.RequestUserDeletionReq) (*chat.RequestUserDeletionResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	deletion := &chatdb.UserDeletion{
+		UserID:     userID,
+		Reason:     req.Reason,
+		CreateTime: now,
+		PurgeTime:  now.Add(o.DeletionCooldown),
+	}
+	if err := o.Database.SetUserDeletion(ctx, deletion); err != nil {
+		return nil, err
+	}
+	if err := o.Admin.InvalidateToken(ctx, userID); err != nil {
+		return nil, err
+	}
+	return &chat.RequestUserDeletionResp{PurgeTime: deletion.PurgeTime.UnixMilli()}, nil
+}
+
+// cancelUserDeletion cancels a pending deletion of the user, it reports whether there was one.
+func (o *chatSvr) cancelUserDeletion(ctx context.Context, userID string) (bool, error) {
+	if _, err := o.Database.TakeUserDeletion(ctx, userID); err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := o.Database.DelUserDeletion(ctx, userID); err != nil {
+		return false, err
+	}
+	log.ZInfo(ctx, "user deletion cancelled by login", "userID", userID)
+	return true, nil
+}
+
+func (o *chatSvr) FindDueUserDeletion(ctx context.Context, req *chat.FindDueUserDeletionReq) (*chat.FindDueUserDeletionResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	deletions, err := o.Database.FindDueUserDeletion(ctx, time.Now(), int64(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(deletions))
+	for _, deletion := range deletions {
+		userIDs = append(userIDs, deletion.UserID)
+	}
+	return &chat.FindDueUserDeletionResp{UserIDs: userIDs}, nil
+}
+
+// PurgeUser deletes the user whose deletion is due and leaves a tombstone, a user who logged in
+// meanwhile has no pending deletion anymore and is kept.
+func (o *chatSvr) PurgeUser(ctx context.Context, req *chat.PurgeUserReq) (*chat.PurgeUserResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	deletion, err := o.Database.TakeUserDeletion(ctx, req.UserID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("user has no pending deletion", "userID", req.UserID)
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if deletion.PurgeTime.After(now) {
+		return nil, errs.ErrArgs.WrapMsg("user deletion is not due", "userID", req.UserID, "purgeTime", deletion.PurgeTime)
+	}
+	tombstone := &chatdb.UserTombstone{
+		UserID:      req.UserID,
+		RequestTime: deletion.CreateTime,
+		DeleteTime:  now,
+	}
+	if attribute, err := o.Database.TakeAttributeByUserID(ctx, req.UserID); err == nil {
+		tombstone.RegisterTime = attribute.CreateTime
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	purged, err := o.Database.PurgeUser(ctx, tombstone)
+	if err != nil {
+		return nil, err
+	}
+	if !purged {
+		return nil, errs.ErrArgs.WrapMsg("user deletion is no longer pending", "userID", req.UserID)
+	}
+	return &chat.PurgeUserResp{}, nil
+}
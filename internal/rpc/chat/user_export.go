@@ -0,0 +1,367 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	constantpb "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+
+	"github.com/openimsdk/chat/pkg/common/apistruct"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/userexport"
+)
+
+const (
+	// userExportTimeout bounds the writing of one archive, a pending export older than it was lost with its rpc instance.
+	userExportTimeout = 10 * time.Minute
+	// userExportCleanupInterval is how often expired archives and archives without an export are deleted.
+	userExportCleanupInterval = time.Hour
+	userExportCleanupBatch    = 1000
+)
+
+type userExport struct {
+	Dir                  string
+	URL                  string
+	Secret               string
+	Expire               time.Duration
+	NotificationUserID   string
+	NotificationNickname string
+}
+
+// RequestUserExport starts writing the archive of the user in the background. While an archive is being
+// written or a written one has not expired, that export is returned instead of starting another.
+func (o *chatSvr) RequestUserExport(ctx context.Context, req *chat.RequestUserExportReq) (*chat.RequestUserExportResp, error) {
+	if o.Export == nil {
+		return nil, errs.ErrArgs.WrapMsg("user export is not enabled")
+	}
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	latest, err := o.Database.TakeLatestUserExport(ctx, userID)
+	if err == nil {
+		pending := latest.Status == constant.UserExportPending && now.Sub(latest.CreateTime) < userExportTimeout
+		ready := latest.Status == constant.UserExportReady && latest.ExpireTime.After(now)
+		if pending || ready {
+			return &chat.RequestUserExportResp{Export: o.userExportDB2PB(latest)}, nil
+		}
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	exportID, err := userexport.NewExportID()
+	if err != nil {
+		return nil, err
+	}
+	export := &chatdb.UserExport{
+		ExportID:   exportID,
+		UserID:     userID,
+		Status:     constant.UserExportPending,
+		CreateTime: now,
+		ExpireTime: now.Add(o.Export.Expire),
+	}
+	if err := o.Database.CreateUserExport(ctx, export); err != nil {
+		return nil, err
+	}
+	go o.runUserExport(context.WithoutCancel(ctx), export)
+	return &chat.RequestUserExportResp{Export: o.userExportDB2PB(export)}, nil
+}
+
+func (o *chatSvr) GetUserExport(ctx context.Context, req *chat.GetUserExportReq) (*chat.GetUserExportResp, error) {
+	if o.Export == nil {
+		return nil, errs.ErrArgs.WrapMsg("user export is not enabled")
+	}
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export, err := o.Database.TakeLatestUserExport(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return &chat.GetUserExportResp{}, nil
+		}
+		return nil, err
+	}
+	if !export.ExpireTime.After(time.Now()) {
+		return &chat.GetUserExportResp{}, nil
+	}
+	return &chat.GetUserExportResp{Export: o.userExportDB2PB(export)}, nil
+}
+
+func (o *chatSvr) userExportDB2PB(export *chatdb.UserExport) *chat.UserExport {
+	res := &chat.UserExport{
+		ExportID:   export.ExportID,
+		Status:     export.Status,
+		Size:       export.Size,
+		CreateTime: export.CreateTime.UnixMilli(),
+		ExpireTime: export.ExpireTime.UnixMilli(),
+	}
+	if !export.FinishTime.IsZero() {
+		res.FinishTime = export.FinishTime.UnixMilli()
+	}
+	if export.Status == constant.UserExportReady {
+		res.Url = userexport.DownloadURL(o.Export.URL, o.Export.Secret, export.ExportID, export.ExpireTime.Unix())
+	}
+	return res
+}
+
+// runUserExport writes the archive and tells the user it is ready, failures are recorded on the export.
+func (o *chatSvr) runUserExport(ctx context.Context, export *chatdb.UserExport) {
+	ctx, cancel := context.WithTimeout(ctx, userExportTimeout)
+	defer cancel()
+	size, err := o.writeUserExport(ctx, export)
+	if err != nil {
+		log.ZError(ctx, "user export failed", err, "userID", export.UserID, "exportID", export.ExportID)
+		if err := o.Database.UpdateUserExport(ctx, export.ExportID, map[string]any{"status": constant.UserExportFailed, "finish_time": time.Now()}); err != nil {
+			log.ZError(ctx, "update user export failed", err, "exportID", export.ExportID)
+		}
+		return
+	}
+	export.Status = constant.UserExportReady
+	export.Size = size
+	export.FinishTime = time.Now()
+	if err := o.Database.UpdateUserExport(ctx, export.ExportID, map[string]any{"status": export.Status, "size": export.Size, "finish_time": export.FinishTime}); err != nil {
+		log.ZError(ctx, "update user export failed", err, "exportID", export.ExportID)
+		return
+	}
+	if err := o.notifyUserExport(ctx, export); err != nil {
+		log.ZWarn(ctx, "notify user export failed", err, "userID", export.UserID, "exportID", export.ExportID)
+	}
+}
+
+// writeUserExport writes the archive to a temporary file first, so a download never sees half an archive.
+func (o *chatSvr) writeUserExport(ctx context.Context, export *chatdb.UserExport) (int64, error) {
+	tables, err := o.userExportTables(ctx, export.UserID)
+	if err != nil {
+		return 0, err
+	}
+	path, err := userexport.Path(o.Export.Dir, export.ExportID)
+	if err != nil {
+		return 0, err
+	}
+	f, err := os.CreateTemp(o.Export.Dir, export.ExportID+"-*.tmp")
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	defer os.Remove(f.Name())
+	if err := userexport.WriteArchive(f, tables); err != nil {
+		_ = f.Close()
+		return 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return 0, errs.Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return info.Size(), nil
+}
+
+// userExportTables gathers what is stored about the user. Passwords, two-factor secrets and passkeys are
+// credentials rather than personal data and are left out.
+func (o *chatSvr) userExportTables(ctx context.Context, userID string) ([]userexport.Table, error) {
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := o.Database.TakeCredentialsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	registers, err := o.Database.FindRegister(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := o.Database.FindUserLoginRecord(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := o.Database.FindUsedInvitationRegister(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	profile := userexport.Table{
+		Name: "profile",
+		Columns: []string{"userID", "account", "areaCode", "phoneNumber", "email", "nickname", "faceURL", "gender", "birth",
+			"level", "allowVibration", "allowBeep", "allowAddFriend", "globalRecvMsgOpt", "registerType", "createTime", "changeTime"},
+		Rows: [][]any{{attribute.UserID, attribute.Account, attribute.AreaCode, attribute.PhoneNumber, attribute.Email, attribute.Nickname,
+			attribute.FaceURL, attribute.Gender, attribute.BirthTime, attribute.Level, attribute.AllowVibration, attribute.AllowBeep,
+			attribute.AllowAddFriend, attribute.GlobalRecvMsgOpt, attribute.RegisterType, attribute.CreateTime, attribute.ChangeTime}},
+	}
+	credentialTable := userexport.Table{Name: "credentials", Columns: []string{"account", "type", "allowChange"}}
+	for _, credential := range credentials {
+		credentialTable.Rows = append(credentialTable.Rows, []any{credential.Account, credentialTypeName(credential.Type), credential.AllowChange})
+	}
+	registerTable := userexport.Table{Name: "registrations", Columns: []string{"createTime", "ip", "deviceID", "platform", "accountType", "mode"}}
+	for _, register := range registers {
+		registerTable.Rows = append(registerTable.Rows, []any{register.CreateTime, register.IP, register.DeviceID, register.Platform, register.AccountType, register.Mode})
+	}
+	loginTable := userexport.Table{Name: "logins", Columns: []string{"loginTime", "ip", "deviceID", "platform"}}
+	for _, record := range records {
+		loginTable.Rows = append(loginTable.Rows, []any{record.LoginTime, record.IP, record.DeviceID, record.Platform})
+	}
+	invitationTable := userexport.Table{Name: "invitations", Columns: []string{"invitationCode", "createTime"}}
+	for _, invitation := range invitations {
+		invitationTable.Rows = append(invitationTable.Rows, []any{invitation.InvitationCode, invitation.CreateTime})
+	}
+	blockTable := userexport.Table{Name: "blocks", Columns: []string{"reason", "createTime"}}
+	if block, err := o.Database.TakeForbiddenAccount(ctx, userID); err == nil {
+		blockTable.Rows = append(blockTable.Rows, []any{block.Reason, block.CreateTime})
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	return []userexport.Table{profile, credentialTable, registerTable, loginTable, invitationTable, blockTable}, nil
+}
+
+func credentialTypeName(credentialType int) string {
+	switch credentialType {
+	case constant.CredentialAccount:
+		return "account"
+	case constant.CredentialPhone:
+		return "phone"
+	case constant.CredentialEmail:
+		return "email"
+	case constant.CredentialOAuth:
+		return "oauth"
+	case constant.CredentialLDAP:
+		return "ldap"
+	default:
+		return strconv.Itoa(credentialType)
+	}
+}
+
+// notifyUserExport sends the download link from the notification account, which is created on first use.
+func (o *chatSvr) notifyUserExport(ctx context.Context, export *chatdb.UserExport) error {
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+	users, err := o.IM.GetUsersInfo(imCtx, []string{o.Export.NotificationUserID})
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		if err := o.IM.AddNotificationAccount(imCtx, &user.AddNotificationAccountReq{
+			UserID:         o.Export.NotificationUserID,
+			NickName:       o.Export.NotificationNickname,
+			AppMangerLevel: constantpb.AppNotificationAdmin,
+		}); err != nil {
+			return err
+		}
+	}
+	return o.IM.SendMsg(imCtx, &apistruct.SendMsgReq{
+		RecvID: export.UserID,
+		SendMsg: apistruct.SendMsg{
+			SendID:         o.Export.NotificationUserID,
+			SenderNickname: o.Export.NotificationNickname,
+			ContentType:    constantpb.OANotification,
+			SessionType:    constantpb.NotificationChatType,
+			Content: map[string]any{
+				"notificationName": o.Export.NotificationNickname,
+				"notificationType": 1,
+				"text":             "Your personal data export is ready. The download link expires at " + export.ExpireTime.UTC().Format(time.RFC1123) + ".",
+				"externalUrl":      userexport.DownloadURL(o.Export.URL, o.Export.Secret, export.ExportID, export.ExpireTime.Unix()),
+			},
+		},
+	})
+}
+
+// startUserExportCleanup deletes expired archives every interval. Every instance runs it, deleting is idempotent.
+func (o *chatSvr) startUserExportCleanup(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(userExportCleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			cleanupCtx := mcontext.SetOperationID(ctx, "userExportCleanup"+strconv.FormatInt(time.Now().UnixMilli(), 10))
+			if err := o.cleanUserExports(cleanupCtx); err != nil {
+				log.ZError(cleanupCtx, "user export cleanup failed", err)
+			}
+		}
+	}()
+}
+
+// cleanUserExports deletes the expired exports with their archives, then the archives left without an
+// export by deleted users and the temporary files of interrupted writes.
+func (o *chatSvr) cleanUserExports(ctx context.Context) error {
+	now := time.Now()
+	expired, err := o.Database.FindExpiredUserExport(ctx, now, userExportCleanupBatch)
+	if err != nil {
+		return err
+	}
+	exportIDs := make([]string, 0, len(expired))
+	for _, export := range expired {
+		if path, err := userexport.Path(o.Export.Dir, export.ExportID); err == nil {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				log.ZWarn(ctx, "remove user export failed", err, "path", path)
+				continue
+			}
+		}
+		exportIDs = append(exportIDs, export.ExportID)
+	}
+	if err := o.Database.DelUserExport(ctx, exportIDs); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(o.Export.Dir)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		path := filepath.Join(o.Export.Dir, name)
+		switch {
+		case strings.HasSuffix(name, ".tmp"):
+			if info, err := entry.Info(); err != nil || now.Sub(info.ModTime()) < userExportTimeout {
+				continue
+			}
+		case strings.HasSuffix(name, userexport.FileExt):
+			if _, err := o.Database.TakeUserExport(ctx, strings.TrimSuffix(name, userexport.FileExt)); err == nil {
+				continue
+			} else if !dbutil.IsDBNotFound(err) {
+				return err
+			}
+		default:
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.ZWarn(ctx, "remove user export failed", err, "path", path)
+		}
+	}
+	return nil
+}
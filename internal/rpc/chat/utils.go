@@ -2,12 +2,12 @@ package chat
 
 import (
 	"context"
-	"strconv"
 	"strings"
 
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	table "github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/phonenumber"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/chat/pkg/protocol/common"
 	"github.com/openimsdk/tools/errs"
@@ -63,23 +63,29 @@ func BuildCredentialPhone(areaCode, phone string) string {
 	return areaCode + " " + phone
 }
 
-func (o *chatSvr) checkRegisterInfo(ctx context.Context, user *chat.RegisterUserInfo, isAdmin bool) error {
+// normalizePhone rewrites an area code and phone number to the E.164 form credentials are stored in.
+func normalizePhone(areaCode *string, phoneNumber *string) error {
+	a, p, err := phonenumber.Normalize(*areaCode, *phoneNumber)
+	if err != nil {
+		return err
+	}
+	*areaCode, *phoneNumber = a, p
+	return nil
+}
+
+// checkRegisterInfo checks the accounts of a new user, an administrator may register a user with only an
+// account and a user registering with an oauth identity or a directory user needs no account at all.
+func (o *chatSvr) checkRegisterInfo(ctx context.Context, user *chat.RegisterUserInfo, isAdmin bool, external bool) error {
 	if user == nil {
 		return errs.ErrArgs.WrapMsg("user is nil")
 	}
 	user.Account = strings.TrimSpace(user.Account)
-	if user.Email == "" && !(user.PhoneNumber != "" && user.AreaCode != "") && (!isAdmin || user.Account == "") {
+	if !external && user.Email == "" && !(user.PhoneNumber != "" && user.AreaCode != "") && (!isAdmin || user.Account == "") {
 		return errs.ErrArgs.WrapMsg("at least one valid account is required")
 	}
 	if user.PhoneNumber != "" {
-		if !strings.HasPrefix(user.AreaCode, "+") {
-			user.AreaCode = "+" + user.AreaCode
-		}
-		if _, err := strconv.ParseUint(user.AreaCode[1:], 10, 64); err != nil {
-			return errs.ErrArgs.WrapMsg("area code must be number")
-		}
-		if _, err := strconv.ParseUint(user.PhoneNumber, 10, 64); err != nil {
-			return errs.ErrArgs.WrapMsg("phone number must be number")
+		if err := normalizePhone(&user.AreaCode, &user.PhoneNumber); err != nil {
+			return err
 		}
 		_, err := o.Database.TakeAttributeByPhone(ctx, user.AreaCode, user.PhoneNumber)
 		if err == nil {
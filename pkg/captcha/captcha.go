@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package captcha issues and verifies the challenges that gate unauthenticated endpoints: a built-in
+// image CAPTCHA, a hashcash-style proof-of-work, and hosted widgets verified at their provider.
+package captcha
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	TypeImage     = "image"
+	TypePoW       = "pow"
+	TypeReCAPTCHA = "recaptcha"
+	TypeHCaptcha  = "hcaptcha"
+	TypeTurnstile = "turnstile"
+)
+
+const defaultExpire = 5 * time.Minute
+
+// Challenge holds what a client needs to answer, depending on the type.
+type Challenge struct {
+	Type string
+	// ID is sent back with the answer, empty for hosted providers whose widget token is the answer
+	ID         string
+	ExpireTime int64 // unix milli, 0 for hosted providers
+	// Image is a data url of the png to read the code from
+	Image string
+	// Data and Difficulty of a proof-of-work, the answer is a string such that sha256(Data + answer)
+	// starts with Difficulty zero bits
+	Data       string
+	Difficulty int
+	// SiteKey of the widget of a hosted provider
+	SiteKey string
+}
+
+// Store keeps the expected answers of issued challenges.
+type Store interface {
+	Set(ctx context.Context, id string, value string, expire time.Duration) error
+	// Take returns and deletes the value, empty when it does not exist or has expired.
+	Take(ctx context.Context, id string) (string, error)
+}
+
+// Provider issues and verifies challenges, hosted providers are plugged in by implementing it.
+type Provider interface {
+	Type() string
+	New(ctx context.Context) (*Challenge, error)
+	// Verify checks the answer of a challenge, every challenge can be answered once.
+	Verify(ctx context.Context, id string, answer string, ip string) (bool, error)
+}
+
+func newID() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func expireTime(expire time.Duration) int64 {
+	return time.Now().Add(expire).UnixMilli()
+}
@@ -0,0 +1,118 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memStore struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (s *memStore) Set(ctx context.Context, id string, value string, expire time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.values == nil {
+		s.values = make(map[string]string)
+	}
+	s.values[id] = value
+	return nil
+}
+
+func (s *memStore) Take(ctx context.Context, id string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value := s.values[id]
+	delete(s.values, id)
+	return value, nil
+}
+
+func TestPoW(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewPoW(&memStore{}, 8, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := p.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	answer := Solve(c.Data, c.Difficulty)
+	if ok, err := p.Verify(ctx, c.ID, answer, ""); err != nil || !ok {
+		t.Fatalf("verify solved challenge: %v %v", ok, err)
+	}
+	if ok, _ := p.Verify(ctx, c.ID, answer, ""); ok {
+		t.Fatal("challenge answered twice")
+	}
+}
+
+func TestImage(t *testing.T) {
+	ctx := context.Background()
+	store := &memStore{}
+	p, err := NewImage(store, 0, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := p.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := store.values[c.ID]
+	if len(code) != defaultImageLength || c.Image == "" {
+		t.Fatalf("code %q image %d bytes", code, len(c.Image))
+	}
+	if ok, _ := p.Verify(ctx, c.ID, code+"0", ""); ok {
+		t.Fatal("wrong code accepted")
+	}
+	// a wrong answer consumes the challenge
+	if ok, _ := p.Verify(ctx, c.ID, code, ""); ok {
+		t.Fatal("challenge answered twice")
+	}
+}
+
+func TestHosted(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("secret") != "secret" || r.PostFormValue("remoteip") != "10.0.0.1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.PostFormValue("response") {
+		case "good":
+			_, _ = w.Write([]byte(`{"success":true,"score":0.9}`))
+		case "bot":
+			_, _ = w.Write([]byte(`{"success":true,"score":0.1}`))
+		default:
+			_, _ = w.Write([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`))
+		}
+	}))
+	defer srv.Close()
+	p, err := NewHosted(HostedConfig{Type: TypeReCAPTCHA, SiteKey: "site", Secret: "secret", VerifyURL: srv.URL, MinScore: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for answer, want := range map[string]bool{"good": true, "bot": false, "bad": false} {
+		ok, err := p.Verify(context.Background(), "", answer, "10.0.0.1")
+		if err != nil || ok != want {
+			t.Errorf("%s: got %v %v, want %v", answer, ok, err, want)
+		}
+	}
+}
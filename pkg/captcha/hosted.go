@@ -0,0 +1,123 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// verifyURLs of the hosted providers, they share the siteverify protocol.
+var verifyURLs = map[string]string{
+	TypeReCAPTCHA: "https://www.google.com/recaptcha/api/siteverify",
+	TypeHCaptcha:  "https://api.hcaptcha.com/siteverify",
+	TypeTurnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+}
+
+type HostedConfig struct {
+	Type    string
+	SiteKey string
+	Secret  string
+	// VerifyURL overrides the siteverify endpoint of the provider
+	VerifyURL string
+	// MinScore rejects reCAPTCHA v3 tokens scored below it, 0 accepts any score
+	MinScore float64
+	Timeout  time.Duration
+}
+
+type hostedProvider struct {
+	conf   HostedConfig
+	client *http.Client
+}
+
+// NewHosted verifies the widget tokens of reCAPTCHA, hCaptcha or Turnstile, or of any provider with
+// the same siteverify protocol when VerifyURL is set.
+func NewHosted(conf HostedConfig) (Provider, error) {
+	if conf.VerifyURL == "" {
+		conf.VerifyURL = verifyURLs[conf.Type]
+	}
+	if conf.VerifyURL == "" {
+		return nil, errs.New("unknown captcha provider", "type", conf.Type)
+	}
+	if conf.SiteKey == "" || conf.Secret == "" {
+		return nil, errs.New("captcha siteKey and secret must be set", "type", conf.Type)
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	return &hostedProvider{conf: conf, client: &http.Client{Timeout: conf.Timeout}}, nil
+}
+
+func (p *hostedProvider) Type() string {
+	return p.conf.Type
+}
+
+func (p *hostedProvider) New(ctx context.Context) (*Challenge, error) {
+	return &Challenge{Type: p.conf.Type, SiteKey: p.conf.SiteKey}, nil
+}
+
+type siteVerifyResp struct {
+	Success    bool     `json:"success"`
+	Score      *float64 `json:"score"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (p *hostedProvider) Verify(ctx context.Context, id string, answer string, ip string) (bool, error) {
+	if answer == "" {
+		return false, nil
+	}
+	form := url.Values{"secret": {p.conf.Secret}, "response": {answer}}
+	if ip != "" {
+		form.Set("remoteip", ip)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.conf.VerifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return false, errs.WrapMsg(err, "captcha siteverify failed", "type", p.conf.Type)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, errs.New("captcha siteverify failed", "type", p.conf.Type, "status", resp.StatusCode, "body", string(data))
+	}
+	var result siteVerifyResp
+	if err := json.Unmarshal(data, &result); err != nil {
+		return false, errs.WrapMsg(err, "invalid captcha siteverify response", "type", p.conf.Type)
+	}
+	if !result.Success {
+		log.ZDebug(ctx, "captcha rejected", "type", p.conf.Type, "errorCodes", result.ErrorCodes)
+		return false, nil
+	}
+	if p.conf.MinScore > 0 && result.Score != nil && *result.Score < p.conf.MinScore {
+		log.ZDebug(ctx, "captcha score too low", "type", p.conf.Type, "score", *result.Score)
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,232 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	mrand "math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	defaultImageLength = 5
+	maxImageLength     = 8
+	glyphScale         = 4
+	glyphWidth         = 5
+	glyphHeight        = 7
+	cellWidth          = 26
+	imagePadding       = 10
+	imageHeight        = 50
+)
+
+// digitGlyphs are 5x7 bitmaps of the digits, one row per string.
+var digitGlyphs = [10][glyphHeight]string{
+	{".###.", "#...#", "#..##", "#.#.#", "##..#", "#...#", ".###."},
+	{"..#..", ".##..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	{".###.", "#...#", "....#", "...#.", "..#..", ".#...", "#####"},
+	{"#####", "...#.", "..#..", "...#.", "....#", "#...#", ".###."},
+	{"...#.", "..##.", ".#.#.", "#..#.", "#####", "...#.", "...#."},
+	{"#####", "#....", "####.", "....#", "....#", "#...#", ".###."},
+	{"..##.", ".#...", "#....", "####.", "#...#", "#...#", ".###."},
+	{"#####", "....#", "...#.", "..#..", ".#...", ".#...", ".#..."},
+	{".###.", "#...#", "#...#", ".###.", "#...#", "#...#", ".###."},
+	{".###.", "#...#", "#...#", ".####", "....#", "...#.", ".##.."},
+}
+
+type imageProvider struct {
+	store  Store
+	length int
+	expire time.Duration
+}
+
+// NewImage creates image CAPTCHAs of length digits, drawn with jitter, a wave and noise.
+func NewImage(store Store, length int, expire time.Duration) (Provider, error) {
+	if length == 0 {
+		length = defaultImageLength
+	}
+	if length < 0 || length > maxImageLength {
+		return nil, errs.New("invalid captcha length", "length", length)
+	}
+	if expire <= 0 {
+		expire = defaultExpire
+	}
+	return &imageProvider{store: store, length: length, expire: expire}, nil
+}
+
+func (p *imageProvider) Type() string {
+	return TypeImage
+}
+
+func (p *imageProvider) New(ctx context.Context) (*Challenge, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	code, err := randomDigits(p.length)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, drawCode(code)); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := p.store.Set(ctx, id, code, p.expire); err != nil {
+		return nil, err
+	}
+	return &Challenge{
+		Type:       TypeImage,
+		ID:         id,
+		ExpireTime: expireTime(p.expire),
+		Image:      "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+func (p *imageProvider) Verify(ctx context.Context, id string, answer string, ip string) (bool, error) {
+	answer = strings.TrimSpace(answer)
+	if id == "" || answer == "" {
+		return false, nil
+	}
+	code, err := p.store.Take(ctx, id)
+	if err != nil || code == "" {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(code), []byte(answer)) == 1, nil
+}
+
+func randomDigits(n int) (string, error) {
+	digits := make([]byte, 0, n)
+	buf := make([]byte, n)
+	for len(digits) < n {
+		if _, err := rand.Read(buf); err != nil {
+			return "", errs.Wrap(err)
+		}
+		for _, b := range buf {
+			// 250 is a multiple of 10, larger bytes would bias the low digits
+			if b < 250 && len(digits) < n {
+				digits = append(digits, '0'+b%10)
+			}
+		}
+	}
+	return string(digits), nil
+}
+
+// drawCode renders the digits of code, each with its own offset, shear and color, then bends the image
+// along a sine wave and adds lines and dots.
+func drawCode(code string) image.Image {
+	width := len(code)*cellWidth + 2*imagePadding
+	src := image.NewRGBA(image.Rect(0, 0, width, imageHeight))
+	background := color.RGBA{R: uint8(225 + mrand.IntN(30)), G: uint8(225 + mrand.IntN(30)), B: uint8(225 + mrand.IntN(30)), A: 255}
+	for y := 0; y < imageHeight; y++ {
+		for x := 0; x < width; x++ {
+			src.SetRGBA(x, y, background)
+		}
+	}
+	for i, c := range code {
+		ink := darkColor()
+		x0 := imagePadding + i*cellWidth + mrand.IntN(5) - 2
+		y0 := (imageHeight-glyphHeight*glyphScale)/2 + mrand.IntN(9) - 4
+		shear := mrand.Float64() - 0.5
+		for row, line := range digitGlyphs[c-'0'] {
+			shift := int(float64(row-glyphHeight/2) * shear * glyphScale / 2)
+			for col, bit := range line {
+				if bit != '#' {
+					continue
+				}
+				fillRect(src, x0+col*glyphScale-shift, y0+row*glyphScale, glyphScale, glyphScale, ink)
+			}
+		}
+	}
+	for i := 0; i < 4; i++ {
+		drawLine(src, mrand.IntN(width), mrand.IntN(imageHeight), mrand.IntN(width), mrand.IntN(imageHeight), darkColor())
+	}
+	for i := 0; i < width*imageHeight/20; i++ {
+		src.SetRGBA(mrand.IntN(width), mrand.IntN(imageHeight), darkColor())
+	}
+
+	dst := image.NewRGBA(src.Bounds())
+	amplitude := 2 + mrand.Float64()*2
+	period := 20 + mrand.Float64()*20
+	phase := mrand.Float64() * 2 * math.Pi
+	for y := 0; y < imageHeight; y++ {
+		dx := int(amplitude * math.Sin(2*math.Pi*float64(y)/period+phase))
+		for x := 0; x < width; x++ {
+			sx := x + dx
+			if sx < 0 || sx >= width {
+				dst.SetRGBA(x, y, background)
+				continue
+			}
+			dst.SetRGBA(x, y, src.RGBAAt(sx, y))
+		}
+	}
+	return dst
+}
+
+func darkColor() color.RGBA {
+	return color.RGBA{R: uint8(mrand.IntN(120)), G: uint8(mrand.IntN(120)), B: uint8(mrand.IntN(120)), A: 255}
+}
+
+func fillRect(img *image.RGBA, x int, y int, w int, h int, c color.RGBA) {
+	for dy := 0; dy < h; dy++ {
+		for dx := 0; dx < w; dx++ {
+			img.SetRGBA(x+dx, y+dy, c)
+		}
+	}
+}
+
+// drawLine draws a line with the Bresenham algorithm.
+func drawLine(img *image.RGBA, x0 int, y0 int, x1 int, y1 int, c color.RGBA) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx + dy
+	for {
+		img.SetRGBA(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
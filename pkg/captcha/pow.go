@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"math/bits"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	maxPoWDifficulty = 32
+	maxPoWAnswerLen  = 64
+)
+
+type powProvider struct {
+	store      Store
+	difficulty int
+	expire     time.Duration
+}
+
+// NewPoW creates proof-of-work challenges of difficulty leading zero bits, each extra bit doubles the
+// expected work of the client while verifying stays a single hash.
+func NewPoW(store Store, difficulty int, expire time.Duration) (Provider, error) {
+	if difficulty <= 0 || difficulty > maxPoWDifficulty {
+		return nil, errs.New("invalid proof-of-work difficulty", "difficulty", difficulty)
+	}
+	if expire <= 0 {
+		expire = defaultExpire
+	}
+	return &powProvider{store: store, difficulty: difficulty, expire: expire}, nil
+}
+
+func (p *powProvider) Type() string {
+	return TypePoW
+}
+
+func (p *powProvider) New(ctx context.Context) (*Challenge, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	data := base64.RawURLEncoding.EncodeToString(nonce)
+	if err := p.store.Set(ctx, id, data, p.expire); err != nil {
+		return nil, err
+	}
+	return &Challenge{
+		Type:       TypePoW,
+		ID:         id,
+		ExpireTime: expireTime(p.expire),
+		Data:       data,
+		Difficulty: p.difficulty,
+	}, nil
+}
+
+func (p *powProvider) Verify(ctx context.Context, id string, answer string, ip string) (bool, error) {
+	if id == "" || answer == "" || len(answer) > maxPoWAnswerLen {
+		return false, nil
+	}
+	data, err := p.store.Take(ctx, id)
+	if err != nil || data == "" {
+		return false, err
+	}
+	return leadingZeroBits(data, answer) >= p.difficulty, nil
+}
+
+func leadingZeroBits(data string, answer string) int {
+	sum := sha256.Sum256([]byte(data + answer))
+	n := 0
+	for _, b := range sum {
+		if b != 0 {
+			return n + bits.LeadingZeros8(b)
+		}
+		n += 8
+	}
+	return n
+}
+
+// Solve finds the answer of a proof-of-work, it is what clients implement.
+func Solve(data string, difficulty int) string {
+	for i := 0; ; i++ {
+		answer := strconv.Itoa(i)
+		if leadingZeroBits(data, answer) >= difficulty {
+			return answer
+		}
+	}
+}
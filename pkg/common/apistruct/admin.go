@@ -25,6 +25,13 @@ type AdminLoginResp struct {
 	AdminUserID  string `json:"adminUserID"`
 	ImUserID     string `json:"imUserID"`
 	ImToken      string `json:"imToken"`
+	RefreshToken string `json:"refreshToken"`
+	Expire       int64  `json:"expire"`
+
+	TwoFactorSetupRequired bool     `json:"twoFactorSetupRequired"`
+	TwoFactorSecret        string   `json:"twoFactorSecret"`
+	TwoFactorURI           string   `json:"twoFactorURI"`
+	RecoveryCodes          []string `json:"recoveryCodes"`
 }
 
 type SearchDefaultGroupResp struct {
@@ -36,3 +43,11 @@ type NewUserCountResp struct {
 	Total     int64            `json:"total"`
 	DateCount map[string]int64 `json:"date_count"`
 }
+
+type ExportAuditLogReq struct {
+	UserID    string `json:"userID"`
+	Operation string `json:"operation"`
+	Target    string `json:"target"`
+	StartTime int64  `json:"startTime"`
+	EndTime   int64  `json:"endTime"`
+}
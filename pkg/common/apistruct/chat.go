@@ -17,15 +17,27 @@ package apistruct
 import "github.com/openimsdk/protocol/sdkws"
 
 type UserRegisterResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	UserID       string `json:"userID"`
+	RefreshToken string `json:"refreshToken"`
+	Expire       int64  `json:"expire"`
 }
 
 type LoginResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken         string `json:"imToken"`
+	ChatToken       string `json:"chatToken"`
+	UserID          string `json:"userID"`
+	RefreshToken    string `json:"refreshToken"`
+	Expire          int64  `json:"expire"`
+	PasswordChanged bool   `json:"passwordChanged"`
+	// a pending deletion of the user was cancelled by this login
+	DeletionCancelled bool `json:"deletionCancelled"`
+}
+
+type OAuthLoginResp struct {
+	LoginResp
+	Registered bool `json:"registered"`
 }
 
 type UpdateUserInfoResp struct{}
@@ -43,6 +43,7 @@ func NewChatRpcCmd() *ChatRpcCmd {
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
 	ret.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		ret.chatConfig.ConfigPath = ret.configPath
 		return ret.runE()
 	}
 	return &ret
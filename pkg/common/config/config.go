@@ -2,9 +2,22 @@ package config
 
 import (
 	_ "embed"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/openimsdk/chat/pkg/captcha"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/directory"
+	"github.com/openimsdk/chat/pkg/oauth"
+	"github.com/openimsdk/chat/pkg/password"
+	"github.com/openimsdk/chat/pkg/sms"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
 )
 
@@ -22,8 +35,126 @@ type Share struct {
 		AdminUserID          string `mapstructure:"adminUserID"`
 		TokenRefreshInterval int    `mapstructure:"tokenRefreshInterval"`
 	} `mapstructure:"openIM"`
-	ChatAdmin   []string `mapstructure:"chatAdmin"`
-	ProxyHeader string   `mapstructure:"proxyHeader"`
+	ChatAdmin    []string     `mapstructure:"chatAdmin"`
+	ProxyHeader  string       `mapstructure:"proxyHeader"`
+	PasswordHash PasswordHash `mapstructure:"passwordHash"`
+	LDAP         LDAP         `mapstructure:"ldap"`
+	OIDCProvider OIDCProvider `mapstructure:"oidcProvider"`
+	UserExport   UserExport   `mapstructure:"userExport"`
+}
+
+type UserExport struct {
+	Enable               bool   `mapstructure:"enable"`
+	Dir                  string `mapstructure:"dir"`
+	URL                  string `mapstructure:"url"`
+	Secret               string `mapstructure:"secret"`
+	Expire               int    `mapstructure:"expire"`
+	NotificationUserID   string `mapstructure:"notificationUserID"`
+	NotificationNickname string `mapstructure:"notificationNickname"`
+}
+
+type OIDCProvider struct {
+	Issuer      string `mapstructure:"issuer"`
+	CodeExpire  int    `mapstructure:"codeExpire"`
+	TokenExpire int    `mapstructure:"tokenExpire"`
+}
+
+type LDAP struct {
+	Enable             bool   `mapstructure:"enable"`
+	URL                string `mapstructure:"url"`
+	StartTLS           bool   `mapstructure:"startTLS"`
+	InsecureSkipVerify bool   `mapstructure:"insecureSkipVerify"`
+	BindDN             string `mapstructure:"bindDN"`
+	BindPassword       string `mapstructure:"bindPassword"`
+	BaseDN             string `mapstructure:"baseDN"`
+	UserFilter         string `mapstructure:"userFilter"`
+	MemberFilter       string `mapstructure:"memberFilter"`
+	Attributes         struct {
+		Username string `mapstructure:"username"`
+		Nickname string `mapstructure:"nickname"`
+		Email    string `mapstructure:"email"`
+	} `mapstructure:"attributes"`
+	Timeout int `mapstructure:"timeout"`
+}
+
+// Build returns nil when LDAP is disabled.
+func (l *LDAP) Build() (*directory.Directory, error) {
+	if !l.Enable {
+		return nil, nil
+	}
+	return directory.New(directory.Config{
+		URL:                l.URL,
+		StartTLS:           l.StartTLS,
+		InsecureSkipVerify: l.InsecureSkipVerify,
+		BindDN:             l.BindDN,
+		BindPassword:       l.BindPassword,
+		BaseDN:             l.BaseDN,
+		UserFilter:         l.UserFilter,
+		MemberFilter:       l.MemberFilter,
+		Attributes: directory.Attributes{
+			Username: l.Attributes.Username,
+			Nickname: l.Attributes.Nickname,
+			Email:    l.Attributes.Email,
+		},
+		Timeout: time.Duration(l.Timeout) * time.Second,
+	})
+}
+
+type PasswordHash struct {
+	Algorithm  string `mapstructure:"algorithm"`
+	BcryptCost int    `mapstructure:"bcryptCost"`
+	Argon2     struct {
+		Time    uint32 `mapstructure:"time"`
+		Memory  uint32 `mapstructure:"memory"`
+		Threads uint8  `mapstructure:"threads"`
+	} `mapstructure:"argon2"`
+}
+
+func (p *PasswordHash) Build() (password.Hasher, error) {
+	return password.New(p.Algorithm, p.BcryptCost, password.Argon2{
+		Time:    p.Argon2.Time,
+		Memory:  p.Argon2.Memory,
+		Threads: p.Argon2.Threads,
+	})
+}
+
+type SigningKeys struct {
+	Active string `mapstructure:"active"`
+	Keys   []struct {
+		ID   string `mapstructure:"id"`
+		File string `mapstructure:"file"`
+		PEM  string `mapstructure:"pem"`
+	} `mapstructure:"keys"`
+}
+
+func (s *SigningKeys) Build() ([]*tokenverify.SigningKey, error) {
+	keys := make([]*tokenverify.SigningKey, 0, len(s.Keys))
+	active := s.Active == ""
+	for _, k := range s.Keys {
+		pemData := []byte(k.PEM)
+		if len(pemData) == 0 {
+			data, err := os.ReadFile(k.File)
+			if err != nil {
+				return nil, errs.WrapMsg(err, "read signing key failed", "kid", k.ID, "file", k.File)
+			}
+			pemData = data
+		}
+		key, err := tokenverify.NewSigningKey(k.ID, pemData)
+		if err != nil {
+			return nil, err
+		}
+		for _, prev := range keys {
+			if prev.ID == key.ID {
+				return nil, errs.New("duplicate signing key id", "kid", key.ID)
+			}
+		}
+		keys = append(keys, key)
+		active = active || key.ID == s.Active
+	}
+	if !active {
+		return nil, errs.New("active signing key not configured", "kid", s.Active)
+	}
+	return keys, nil
 }
 
 type RpcService struct {
@@ -129,6 +260,139 @@ type Chat struct {
 		Secret string `mapstructure:"secret"`
 	} `mapstructure:"liveKit"`
 	AllowRegister bool `mapstructure:"allowRegister"`
+	TwoFactor     struct {
+		Issuer string `mapstructure:"issuer"`
+	} `mapstructure:"twoFactor"`
+	OAuth        OAuth    `mapstructure:"oauth"`
+	WebAuthn     WebAuthn `mapstructure:"webauthn"`
+	UserDeletion struct {
+		Cooldown int `mapstructure:"cooldown"`
+	} `mapstructure:"userDeletion"`
+}
+
+type WebAuthn struct {
+	RPID          string   `mapstructure:"rpID"`
+	RPDisplayName string   `mapstructure:"rpDisplayName"`
+	RPOrigins     []string `mapstructure:"rpOrigins"`
+	Timeout       int      `mapstructure:"timeout"`
+}
+
+// Build creates the relying party of passkeys, nil when rpID is empty.
+func (w *WebAuthn) Build() (*webauthn.WebAuthn, error) {
+	if w.RPID == "" {
+		return nil, nil
+	}
+	if len(w.RPOrigins) == 0 {
+		return nil, errs.New("webauthn rpOrigins is empty")
+	}
+	displayName := w.RPDisplayName
+	if displayName == "" {
+		displayName = w.RPID
+	}
+	timeout := time.Duration(w.Timeout) * time.Second
+	rp, err := webauthn.New(&webauthn.Config{
+		RPID:          w.RPID,
+		RPDisplayName: displayName,
+		RPOrigins:     w.RPOrigins,
+		// passkeys are discoverable and verify the user, so they replace the password and the second factor
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			UserVerification:   protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        webauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout},
+			Registration: webauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout},
+		},
+	})
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid webauthn config", "rpID", w.RPID)
+	}
+	return rp, nil
+}
+
+type OAuth struct {
+	AutoRegister bool            `mapstructure:"autoRegister"`
+	Providers    []OAuthProvider `mapstructure:"providers"`
+}
+
+type OAuthProvider struct {
+	Name         string   `mapstructure:"name"`
+	Type         string   `mapstructure:"type"`
+	ClientID     string   `mapstructure:"clientID"`
+	ClientSecret string   `mapstructure:"clientSecret"`
+	RedirectURL  string   `mapstructure:"redirectURL"`
+	Scopes       []string `mapstructure:"scopes"`
+	// oidc
+	Issuer string `mapstructure:"issuer"`
+	// github
+	AuthURL  string `mapstructure:"authURL"`
+	TokenURL string `mapstructure:"tokenURL"`
+	APIURL   string `mapstructure:"apiURL"`
+	// apple
+	TeamID         string `mapstructure:"teamID"`
+	KeyID          string `mapstructure:"keyID"`
+	PrivateKeyFile string `mapstructure:"privateKeyFile"`
+	PrivateKey     string `mapstructure:"privateKey"`
+}
+
+// Build creates the providers by name.
+func (o *OAuth) Build() (map[string]oauth.Provider, error) {
+	providers := make(map[string]oauth.Provider)
+	for _, p := range o.Providers {
+		name := strings.ToLower(p.Name)
+		if name == "" || strings.Contains(name, ":") {
+			return nil, errs.New("invalid oauth provider name", "name", p.Name)
+		}
+		if _, ok := providers[name]; ok {
+			return nil, errs.New("duplicate oauth provider", "name", name)
+		}
+		var (
+			provider oauth.Provider
+			err      error
+		)
+		switch strings.ToLower(p.Type) {
+		case oauth.TypeOIDC:
+			provider, err = oauth.NewOIDC(oauth.OIDCConfig{
+				Name:         name,
+				Issuer:       p.Issuer,
+				ClientID:     p.ClientID,
+				ClientSecret: p.ClientSecret,
+				RedirectURL:  p.RedirectURL,
+				Scopes:       p.Scopes,
+			})
+		case oauth.TypeGoogle:
+			provider, err = oauth.NewGoogle(name, p.ClientID, p.ClientSecret, p.RedirectURL, p.Scopes)
+		case oauth.TypeGitHub:
+			provider, err = oauth.NewGitHub(oauth.GitHubConfig{
+				Name:         name,
+				ClientID:     p.ClientID,
+				ClientSecret: p.ClientSecret,
+				RedirectURL:  p.RedirectURL,
+				Scopes:       p.Scopes,
+				AuthURL:      p.AuthURL,
+				TokenURL:     p.TokenURL,
+				APIURL:       p.APIURL,
+			})
+		case oauth.TypeApple:
+			pemData := []byte(p.PrivateKey)
+			if len(pemData) == 0 {
+				data, err := os.ReadFile(p.PrivateKeyFile)
+				if err != nil {
+					return nil, errs.WrapMsg(err, "read apple private key failed", "name", name, "file", p.PrivateKeyFile)
+				}
+				pemData = data
+			}
+			provider, err = oauth.NewApple(name, p.ClientID, p.TeamID, p.KeyID, pemData, p.RedirectURL, p.Scopes)
+		default:
+			return nil, errs.New("unknown oauth provider type", "name", name, "type", p.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+		providers[name] = provider
+	}
+	return providers, nil
 }
 
 type Bot struct {
@@ -140,32 +404,100 @@ type Bot struct {
 	Timeout int `mapstructure:"timeout"`
 }
 type VerifyCode struct {
-	ValidTime  int    `mapstructure:"validTime"`
-	ValidCount int    `mapstructure:"validCount"`
-	UintTime   int    `mapstructure:"uintTime"`
-	MaxCount   int    `mapstructure:"maxCount"`
-	SuperCode  string `mapstructure:"superCode"`
-	Len        int    `mapstructure:"len"`
-	Phone      struct {
-		Use string `mapstructure:"use"`
-		Ali struct {
-			Endpoint                     string `mapstructure:"endpoint"`
-			AccessKeyID                  string `mapstructure:"accessKeyId"`
-			AccessKeySecret              string `mapstructure:"accessKeySecret"`
-			SignName                     string `mapstructure:"signName"`
-			VerificationCodeTemplateCode string `mapstructure:"verificationCodeTemplateCode"`
-		} `mapstructure:"ali"`
-	} `mapstructure:"phone"`
-	Mail struct {
+	ValidTime           int   `mapstructure:"validTime"`
+	ValidCount          int   `mapstructure:"validCount"`
+	UintTime            int   `mapstructure:"uintTime"`
+	MaxCount            int   `mapstructure:"maxCount"`
+	Len                 int   `mapstructure:"len"`
+	NotifyContactChange bool  `mapstructure:"notifyContactChange"`
+	Phone               Phone `mapstructure:"phone"`
+	Mail                struct {
 		Use                     string `mapstructure:"use"`
 		Title                   string `mapstructure:"title"`
 		SenderMail              string `mapstructure:"senderMail"`
 		SenderAuthorizationCode string `mapstructure:"senderAuthorizationCode"`
 		SMTPAddr                string `mapstructure:"smtpAddr"`
 		SMTPPort                int    `mapstructure:"smtpPort"`
+		TemplateDir             string `mapstructure:"templateDir"`
+		DefaultLanguage         string `mapstructure:"defaultLanguage"`
+		LoginLink               struct {
+			URL       string `mapstructure:"url"`
+			ValidTime int    `mapstructure:"validTime"`
+		} `mapstructure:"loginLink"`
 	} `mapstructure:"mail"`
 }
 
+type Phone struct {
+	Use     string            `mapstructure:"use"`
+	Routes  map[string]string `mapstructure:"routes"`
+	Message string            `mapstructure:"message"`
+	Ali     struct {
+		Endpoint                     string `mapstructure:"endpoint"`
+		AccessKeyID                  string `mapstructure:"accessKeyId"`
+		AccessKeySecret              string `mapstructure:"accessKeySecret"`
+		SignName                     string `mapstructure:"signName"`
+		VerificationCodeTemplateCode string `mapstructure:"verificationCodeTemplateCode"`
+	} `mapstructure:"ali"`
+	Twilio struct {
+		Endpoint   string `mapstructure:"endpoint"`
+		AccountSID string `mapstructure:"accountSid"`
+		AuthToken  string `mapstructure:"authToken"`
+		From       string `mapstructure:"from"`
+	} `mapstructure:"twilio"`
+	SNS struct {
+		Endpoint        string `mapstructure:"endpoint"`
+		Region          string `mapstructure:"region"`
+		AccessKeyID     string `mapstructure:"accessKeyId"`
+		SecretAccessKey string `mapstructure:"secretAccessKey"`
+		SessionToken    string `mapstructure:"sessionToken"`
+		SenderID        string `mapstructure:"senderId"`
+		SMSType         string `mapstructure:"smsType"`
+	} `mapstructure:"sns"`
+	Vonage struct {
+		Endpoint  string `mapstructure:"endpoint"`
+		APIKey    string `mapstructure:"apiKey"`
+		APISecret string `mapstructure:"apiSecret"`
+		From      string `mapstructure:"from"`
+	} `mapstructure:"vonage"`
+	HTTP struct {
+		URL     string            `mapstructure:"url"`
+		Method  string            `mapstructure:"method"`
+		Headers map[string]string `mapstructure:"headers"`
+		Body    string            `mapstructure:"body"`
+	} `mapstructure:"http"`
+}
+
+// Build returns the sms sender picked by use and routes, nil when phone verification is disabled
+// or is not configured. The deprecated superCode disables it like none.
+func (p *Phone) Build() (sms.SMS, error) {
+	if strings.EqualFold(p.Use, constant.VerifyNone) || strings.EqualFold(p.Use, constant.VerifySuperCode) || (p.Use == "" && len(p.Routes) == 0) {
+		return nil, nil
+	}
+	registry := sms.NewRegistry()
+	registry.Register(sms.ProviderAli, func() (sms.SMS, error) {
+		return sms.NewAli(p.Ali.Endpoint, p.Ali.AccessKeyID, p.Ali.AccessKeySecret, p.Ali.SignName, p.Ali.VerificationCodeTemplateCode)
+	})
+	registry.Register(sms.ProviderTwilio, func() (sms.SMS, error) {
+		return sms.NewTwilio(p.Twilio.Endpoint, p.Twilio.AccountSID, p.Twilio.AuthToken, p.Twilio.From, p.Message)
+	})
+	registry.Register(sms.ProviderSNS, func() (sms.SMS, error) {
+		return sms.NewSNS(p.SNS.Endpoint, p.SNS.Region, p.SNS.AccessKeyID, p.SNS.SecretAccessKey, p.SNS.SessionToken, p.SNS.SenderID, p.SNS.SMSType, p.Message)
+	})
+	registry.Register(sms.ProviderVonage, func() (sms.SMS, error) {
+		return sms.NewVonage(p.Vonage.Endpoint, p.Vonage.APIKey, p.Vonage.APISecret, p.Vonage.From, p.Message)
+	})
+	registry.Register(sms.ProviderHTTP, func() (sms.SMS, error) {
+		return sms.NewHTTP(sms.HTTPConfig{
+			URL:     p.HTTP.URL,
+			Method:  p.HTTP.Method,
+			Headers: p.HTTP.Headers,
+			Body:    p.HTTP.Body,
+			Message: p.Message,
+		})
+	})
+	return registry.Build(p.Use, p.Routes)
+}
+
 type Admin struct {
 	RPC struct {
 		RegisterIP string `mapstructure:"registerIP"`
@@ -173,9 +505,81 @@ type Admin struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	TokenPolicy struct {
-		Expire int `mapstructure:"expire"`
+		Expire       int `mapstructure:"expire"`
+		AccessExpire int `mapstructure:"accessExpire"`
 	} `mapstructure:"tokenPolicy"`
-	Secret string `mapstructure:"secret"`
+	Secret      string      `mapstructure:"secret"`
+	SigningKeys SigningKeys `mapstructure:"signingKeys"`
+	TwoFactor   struct {
+		Issuer        string `mapstructure:"issuer"`
+		RequiredLevel int32  `mapstructure:"requiredLevel"`
+	} `mapstructure:"twoFactor"`
+	LoginLimit struct {
+		Window         int `mapstructure:"window"`
+		AccountMaxFail int `mapstructure:"accountMaxFail"`
+		IPMaxFail      int `mapstructure:"ipMaxFail"`
+		LockTime       int `mapstructure:"lockTime"`
+		DelayAfter     int `mapstructure:"delayAfter"`
+		DelayBase      int `mapstructure:"delayBase"`
+		DelayMax       int `mapstructure:"delayMax"`
+	} `mapstructure:"loginLimit"`
+	LDAPSync struct {
+		Interval     int  `mapstructure:"interval"`
+		BlockRemoved bool `mapstructure:"blockRemoved"`
+		Groups       []struct {
+			DN         string   `mapstructure:"dn"`
+			IMGroupIDs []string `mapstructure:"imGroupIDs"`
+		} `mapstructure:"groups"`
+	} `mapstructure:"ldapSync"`
+	Captcha      Captcha `mapstructure:"captcha"`
+	UserDeletion struct {
+		Interval int `mapstructure:"interval"`
+		Batch    int `mapstructure:"batch"`
+	} `mapstructure:"userDeletion"`
+	BanExpiry struct {
+		Interval int `mapstructure:"interval"`
+		Batch    int `mapstructure:"batch"`
+	} `mapstructure:"banExpiry"`
+}
+
+type Captcha struct {
+	Type      string `mapstructure:"type"`
+	Threshold int    `mapstructure:"threshold"`
+	Window    int    `mapstructure:"window"`
+	Expire    int    `mapstructure:"expire"`
+	Image     struct {
+		Length int `mapstructure:"length"`
+	} `mapstructure:"image"`
+	PoW struct {
+		Difficulty int `mapstructure:"difficulty"`
+	} `mapstructure:"pow"`
+	Hosted struct {
+		SiteKey   string  `mapstructure:"siteKey"`
+		Secret    string  `mapstructure:"secret"`
+		VerifyURL string  `mapstructure:"verifyURL"`
+		MinScore  float64 `mapstructure:"minScore"`
+	} `mapstructure:"hosted"`
+}
+
+// Build creates the challenge provider, nil when type is empty.
+func (c *Captcha) Build(store captcha.Store) (captcha.Provider, error) {
+	expire := time.Duration(c.Expire) * time.Second
+	switch typ := strings.ToLower(c.Type); typ {
+	case "":
+		return nil, nil
+	case captcha.TypeImage:
+		return captcha.NewImage(store, c.Image.Length, expire)
+	case captcha.TypePoW:
+		return captcha.NewPoW(store, c.PoW.Difficulty, expire)
+	default:
+		return captcha.NewHosted(captcha.HostedConfig{
+			Type:      typ,
+			SiteKey:   c.Hosted.SiteKey,
+			Secret:    c.Hosted.Secret,
+			VerifyURL: c.Hosted.VerifyURL,
+			MinScore:  c.Hosted.MinScore,
+		})
+	}
 }
 
 type Log struct {
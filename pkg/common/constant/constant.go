@@ -13,6 +13,9 @@ const (
 	VerificationCodeForRegister      = 1 // Register
 	VerificationCodeForResetPassword = 2 // Reset password
 	VerificationCodeForLogin         = 3 // Login
+	VerificationCodeForLoginLink     = 4 // Login by a link sent to the email
+	VerificationCodeForChangeContact = 5 // Change the email or phone number of the user to the one the code is sent to
+	VerificationCodeForBanAppeal     = 6 // Appeal against the block of the user, who cannot log in
 )
 
 const LogFileName = "chat.log"
@@ -44,6 +47,40 @@ const (
 	AdvancedUserLevel = 100
 )
 
+// Admin permissions, granted to normal admins by their roles. The super admin has all of them.
+const (
+	PermissionUserRead          = "user.read"          // View users, their sessions and two-factor status
+	PermissionUserWrite         = "user.write"         // Add and import users, reset passwords, two-factor and sessions, sync the directory
+	PermissionUserBlock         = "user.block"         // Block and unblock users, review their appeals
+	PermissionRegisterManage    = "register.manage"    // Registration switch, invitation codes, default friends and groups
+	PermissionSecurityManage    = "security.manage"    // Forbidden IPs, login IP limits, login locks and test accounts
+	PermissionAppletManage      = "applet.manage"      // Applets and their OpenID Connect clients
+	PermissionAgentManage       = "agent.manage"       // Bot agents
+	PermissionApplicationManage = "application.manage" // Application versions
+	PermissionStatisticRead     = "statistic.read"     // User statistics
+	PermissionConfigRead        = "config.read"        // Service and client configuration
+	PermissionConfigWrite       = "config.write"       // Change the service and client configuration
+	PermissionServiceRestart    = "service.restart"    // Restart the services
+	PermissionAuditRead         = "audit.read"         // Search and export the audit log
+)
+
+// Permissions lists all admin permissions.
+var Permissions = []string{
+	PermissionUserRead,
+	PermissionUserWrite,
+	PermissionUserBlock,
+	PermissionRegisterManage,
+	PermissionSecurityManage,
+	PermissionAppletManage,
+	PermissionAgentManage,
+	PermissionApplicationManage,
+	PermissionStatisticRead,
+	PermissionConfigRead,
+	PermissionConfigWrite,
+	PermissionServiceRestart,
+	PermissionAuditRead,
+}
+
 // AddFriendCtrl.
 const (
 	OrdinaryUserAddFriendEnable  = 1  // Allow ordinary users to add friends
@@ -80,12 +117,26 @@ const (
 const (
 	RpcOpUserID   = constant.OpUserID
 	RpcOpUserType = "opUserType"
+	RpcOpIP       = "opIP"     // ip of the admin, for the audit log
+	RpcOpAPIKey   = "opAPIKey" // set when the admin is authenticated by an API key
 )
 
 const RpcCustomHeader = constant.RpcCustomHeader
 
 const NeedInvitationCodeRegisterConfigKey = "needInvitationCodeRegister"
 
+// Client config keys of the password policy.
+const (
+	PasswordMinLengthConfigKey     = "passwordMinLength"
+	PasswordRequireLowerConfigKey  = "passwordRequireLower"
+	PasswordRequireUpperConfigKey  = "passwordRequireUpper"
+	PasswordRequireDigitConfigKey  = "passwordRequireDigit"
+	PasswordRequireSymbolConfigKey = "passwordRequireSymbol"
+	PasswordHistoryConfigKey       = "passwordHistory" // number of latest passwords that cannot be reused
+	PasswordMaxAgeConfigKey        = "passwordMaxAge"  // days, a password login after it must set a new password
+	PasswordPlaintextConfigKey     = "passwordPlaintext"
+)
+
 const (
 	DefaultAllowVibration = 1
 	DefaultAllowBeep      = 1
@@ -103,6 +154,7 @@ const (
 	AccountRegister = iota
 	EmailRegister
 	PhoneRegister
+	OAuthRegister
 )
 
 const (
@@ -116,11 +168,87 @@ const (
 	CredentialAccount = iota
 	CredentialPhone
 	CredentialEmail
+	CredentialOAuth // account is oauth:<provider>:<subject>, a user may have several
+	CredentialLDAP  // account is ldap:<username>, checked by a bind to the directory
 )
 
 // verifyCode use
 const (
+	VerifyNone = "none" // only test accounts get verify codes
+	VerifyALi  = "ali"
+	VerifyMail = "mail"
+	// VerifySuperCode is the former name of VerifyNone, the global super code was replaced by test accounts.
+	//
+	// Deprecated: use VerifyNone.
 	VerifySuperCode = "supercode"
-	VerifyALi       = "ali"
-	VerifyMail      = "mail"
+)
+
+// User export status
+const (
+	UserExportPending = 1 // The archive is being written
+	UserExportReady   = 2 // The archive can be downloaded
+	UserExportFailed  = 3 // Writing the archive failed
+)
+
+// Ban appeal status
+const (
+	BanAppealPending  = 1 // Waiting for review
+	BanAppealApproved = 2 // The block was lifted
+	BanAppealRejected = 3 // The block stays
+)
+
+// Audit log operations
+const (
+	AuditAdminAdd                 = "admin.add"
+	AuditAdminDel                 = "admin.del"
+	AuditAdminUpdate              = "admin.update"
+	AuditAdminPasswordChange      = "admin.password_change"
+	AuditAdminSetRole             = "admin.set_role"
+	AuditAdminTwoFactorRequired   = "admin.2fa_required"
+	AuditAdminTwoFactorReset      = "admin.2fa_reset"
+	AuditRoleAdd                  = "role.add"
+	AuditRoleUpdate               = "role.update"
+	AuditRoleDel                  = "role.del"
+	AuditAPIKeyAdd                = "api_key.add"
+	AuditAPIKeyDel                = "api_key.del"
+	AuditUserAdd                  = "user.add"
+	AuditUserCancel               = "user.cancel"
+	AuditUserBlock                = "user.block"
+	AuditUserUnblock              = "user.unblock"
+	AuditBanAppealComment         = "ban_appeal.comment"
+	AuditBanAppealReview          = "ban_appeal.review"
+	AuditUserPasswordReset        = "user.password_reset"
+	AuditUserTwoFactorReset       = "user.2fa_reset"
+	AuditAllowRegisterSet         = "allow_register.set"
+	AuditSessionRevoke            = "session.revoke"
+	AuditLDAPSync                 = "ldap.sync"
+	AuditInvitationCodeAdd        = "invitation_code.add"
+	AuditInvitationCodeDel        = "invitation_code.del"
+	AuditDefaultFriendAdd         = "default_friend.add"
+	AuditDefaultFriendDel         = "default_friend.del"
+	AuditDefaultGroupAdd          = "default_group.add"
+	AuditDefaultGroupDel          = "default_group.del"
+	AuditIPForbiddenAdd           = "ip_forbidden.add"
+	AuditIPForbiddenDel           = "ip_forbidden.del"
+	AuditUserIPLimitAdd           = "user_ip_limit.add"
+	AuditUserIPLimitDel           = "user_ip_limit.del"
+	AuditTestAccountSet           = "test_account.set"
+	AuditTestAccountDel           = "test_account.del"
+	AuditLoginLockClear           = "login_lock.clear"
+	AuditAppletAdd                = "applet.add"
+	AuditAppletUpdate             = "applet.update"
+	AuditAppletDel                = "applet.del"
+	AuditAppletClientSet          = "applet.client_set"
+	AuditClientConfigSet          = "client_config.set"
+	AuditClientConfigDel          = "client_config.del"
+	AuditApplicationVersionAdd    = "application_version.add"
+	AuditApplicationVersionUpdate = "application_version.update"
+	AuditApplicationVersionDel    = "application_version.del"
+	AuditAgentCreate              = "agent.create"
+	AuditAgentUpdate              = "agent.update"
+	AuditAgentDelete              = "agent.delete"
+	AuditConfigSet                = "config.set"
+	AuditConfigReset              = "config.reset"
+	AuditConfigManagerSet         = "config.manager_set"
+	AuditServiceRestart           = "service.restart"
 )
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	captcha    = chatPrefix + "CAPTCHA:"
+	captchaHit = chatPrefix + "CAPTCHA_HIT:"
+)
+
+// captchaHitScript counts a request in the fixed window of a key, the window starts with the first request.
+var captchaHitScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`)
+
+type CaptchaInterface interface {
+	Set(ctx context.Context, id string, answer string, expire time.Duration) error
+	// Take returns and deletes the answer of a challenge, empty when it does not exist.
+	Take(ctx context.Context, id string) (string, error)
+	// Hit counts a gated request of the ip and returns the count within window.
+	Hit(ctx context.Context, ip string, window time.Duration) (int64, error)
+	// Count returns the requests of the ip within the current window.
+	Count(ctx context.Context, ip string) (int64, error)
+}
+
+type captchaCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewCaptchaInterface(rdb redis.UniversalClient) CaptchaInterface {
+	return &captchaCacheRedis{rdb: rdb}
+}
+
+func (c *captchaCacheRedis) Set(ctx context.Context, id string, answer string, expire time.Duration) error {
+	return errs.Wrap(c.rdb.Set(ctx, captcha+id, answer, expire).Err())
+}
+
+func (c *captchaCacheRedis) Take(ctx context.Context, id string) (string, error) {
+	answer, err := c.rdb.GetDel(ctx, captcha+id).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", errs.Wrap(err)
+	}
+	return answer, nil
+}
+
+func (c *captchaCacheRedis) Hit(ctx context.Context, ip string, window time.Duration) (int64, error) {
+	count, err := captchaHitScript.Run(ctx, c.rdb, []string{captchaHit + ip}, window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return count, nil
+}
+
+func (c *captchaCacheRedis) Count(ctx context.Context, ip string) (int64, error) {
+	count, err := c.rdb.Get(ctx, captchaHit+ip).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, errs.Wrap(err)
+	}
+	return count, nil
+}
@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const jobLock = chatPrefix + "JOB_LOCK:"
+
+// JobLockInterface lets one of several instances run a scheduled job.
+type JobLockInterface interface {
+	// TryLock reports whether the caller acquired the job for ttl, the lock is not released early
+	// so the job runs at most once per ttl.
+	TryLock(ctx context.Context, name string, ttl time.Duration) (bool, error)
+}
+
+type jobLockCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewJobLockInterface(rdb redis.UniversalClient) JobLockInterface {
+	return &jobLockCacheRedis{rdb: rdb}
+}
+
+func (j *jobLockCacheRedis) TryLock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	ok, err := j.rdb.SetNX(ctx, jobLock+name, time.Now().UnixMilli(), ttl).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return ok, nil
+}
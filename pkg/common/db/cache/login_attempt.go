@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginFail  = chatPrefix + "LOGIN_FAIL:"
+	loginLock  = chatPrefix + "LOGIN_LOCK:"
+	loginLocks = chatPrefix + "LOGIN_LOCKS"
+)
+
+// Kinds of login attempt targets.
+const (
+	LoginTargetUser  = "user"
+	LoginTargetAdmin = "admin"
+	LoginTargetIP    = "ip"
+	// LoginTargetTestAccount counts wrong codes typed for a test account, its id is the account.
+	LoginTargetTestAccount = "test_account"
+)
+
+// loginFailScript appends a failure to the sliding window of a target and locks it once the window is full.
+// The failure and lock keys of a target share a hash tag, so the script also works in cluster mode.
+var loginFailScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+redis.call('ZADD', KEYS[1], now, ARGV[5])
+redis.call('PEXPIRE', KEYS[1], window)
+local count = redis.call('ZCARD', KEYS[1])
+local max = tonumber(ARGV[3])
+if max > 0 and count >= max then
+	redis.call('SET', KEYS[2], count, 'PX', ARGV[4])
+	redis.call('DEL', KEYS[1])
+	return {count, 1}
+end
+return {count, 0}
+`)
+
+func getLoginTarget(kind string, id string) string {
+	return "{" + kind + ":" + id + "}"
+}
+
+func getLoginFailKey(kind string, id string) string {
+	return loginFail + getLoginTarget(kind, id)
+}
+
+func getLoginLockKey(kind string, id string) string {
+	return loginLock + getLoginTarget(kind, id)
+}
+
+type LoginLock struct {
+	Kind       string
+	ID         string
+	UnlockTime int64 // unix milli
+}
+
+type LoginAttemptInterface interface {
+	// Fail records a failed login of the target within window, and locks the target for lock
+	// when it has failed maxFail times. maxFail 0 only counts.
+	Fail(ctx context.Context, kind string, id string, window time.Duration, maxFail int, lock time.Duration) (int64, bool, error)
+	// LockTTL returns how long the target stays locked, 0 if it is not locked.
+	LockTTL(ctx context.Context, kind string, id string) (time.Duration, error)
+	// Reset forgets the failures of the target, it does not unlock it.
+	Reset(ctx context.Context, kind string, id string) error
+	// Unlock forgets the failures of the target and lifts its lock.
+	Unlock(ctx context.Context, kind string, id string) error
+	SearchLocks(ctx context.Context, offset int64, count int64) (int64, []*LoginLock, error)
+}
+
+type loginAttemptCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLoginAttemptInterface(rdb redis.UniversalClient) LoginAttemptInterface {
+	return &loginAttemptCacheRedis{rdb: rdb}
+}
+
+func (l *loginAttemptCacheRedis) Fail(ctx context.Context, kind string, id string, window time.Duration, maxFail int, lock time.Duration) (int64, bool, error) {
+	now := time.Now()
+	member := strconv.FormatInt(now.UnixNano(), 10) + "." + strconv.Itoa(rand.Int())
+	keys := []string{getLoginFailKey(kind, id), getLoginLockKey(kind, id)}
+	res, err := loginFailScript.Run(ctx, l.rdb, keys, now.UnixMilli(), window.Milliseconds(), maxFail, lock.Milliseconds(), member).Int64Slice()
+	if err != nil {
+		return 0, false, errs.Wrap(err)
+	}
+	locked := res[1] == 1
+	if locked {
+		unlock := now.Add(lock).UnixMilli()
+		if err := l.rdb.ZAdd(ctx, loginLocks, redis.Z{Score: float64(unlock), Member: kind + ":" + id}).Err(); err != nil {
+			return 0, false, errs.Wrap(err)
+		}
+	}
+	return res[0], locked, nil
+}
+
+func (l *loginAttemptCacheRedis) LockTTL(ctx context.Context, kind string, id string) (time.Duration, error) {
+	ttl, err := l.rdb.PTTL(ctx, getLoginLockKey(kind, id)).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if ttl < 0 {
+		// -2 the key does not exist, -1 it has no expiration which the lock always sets
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (l *loginAttemptCacheRedis) Reset(ctx context.Context, kind string, id string) error {
+	return errs.Wrap(l.rdb.Del(ctx, getLoginFailKey(kind, id)).Err())
+}
+
+func (l *loginAttemptCacheRedis) Unlock(ctx context.Context, kind string, id string) error {
+	if err := l.rdb.Del(ctx, getLoginFailKey(kind, id), getLoginLockKey(kind, id)).Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(l.rdb.ZRem(ctx, loginLocks, kind+":"+id).Err())
+}
+
+func (l *loginAttemptCacheRedis) SearchLocks(ctx context.Context, offset int64, count int64) (int64, []*LoginLock, error) {
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	if err := l.rdb.ZRemRangeByScore(ctx, loginLocks, "-inf", now).Err(); err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	total, err := l.rdb.ZCard(ctx, loginLocks).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	if total == 0 || count <= 0 {
+		return total, nil, nil
+	}
+	res, err := l.rdb.ZRangeWithScores(ctx, loginLocks, offset, offset+count-1).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	locks := make([]*LoginLock, 0, len(res))
+	for _, z := range res {
+		member, _ := z.Member.(string)
+		kind, id, _ := strings.Cut(member, ":")
+		locks = append(locks, &LoginLock{Kind: kind, ID: id, UnlockTime: int64(z.Score)})
+	}
+	return total, locks, nil
+}
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginLink     = chatPrefix + "LOGIN_LINK:"
+	loginLinkSent = chatPrefix + "LOGIN_LINK_SENT:"
+)
+
+// delIfEqualScript deletes a key only if it still holds the value, so a value can be used once.
+var delIfEqualScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type LoginLinkInterface interface {
+	// Hit counts a link sent to the email and returns the count within window.
+	Hit(ctx context.Context, email string, window time.Duration) (int64, error)
+	// Set replaces the link of the email, only the latest link of an email can be used.
+	Set(ctx context.Context, email string, hash string, expire time.Duration) error
+	// Get returns the hash of the link of the email, empty when there is none or it expired.
+	Get(ctx context.Context, email string) (string, error)
+	// Use deletes the link of the email if it is still hash, false when it was used or replaced meanwhile.
+	Use(ctx context.Context, email string, hash string) (bool, error)
+}
+
+type loginLinkCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLoginLinkInterface(rdb redis.UniversalClient) LoginLinkInterface {
+	return &loginLinkCacheRedis{rdb: rdb}
+}
+
+func (l *loginLinkCacheRedis) Hit(ctx context.Context, email string, window time.Duration) (int64, error) {
+	count, err := captchaHitScript.Run(ctx, l.rdb, []string{loginLinkSent + email}, window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return count, nil
+}
+
+func (l *loginLinkCacheRedis) Set(ctx context.Context, email string, hash string, expire time.Duration) error {
+	return errs.Wrap(l.rdb.Set(ctx, loginLink+email, hash, expire).Err())
+}
+
+func (l *loginLinkCacheRedis) Get(ctx context.Context, email string) (string, error) {
+	hash, err := l.rdb.Get(ctx, loginLink+email).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", errs.Wrap(err)
+	}
+	return hash, nil
+}
+
+func (l *loginLinkCacheRedis) Use(ctx context.Context, email string, hash string) (bool, error) {
+	deleted, err := delIfEqualScript.Run(ctx, l.rdb, []string{loginLink + email}, hash).Int64()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return deleted == 1, nil
+}
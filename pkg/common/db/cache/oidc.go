@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	oidcCode        = chatPrefix + "OIDC_CODE:"
+	oidcAccessToken = chatPrefix + "OIDC_ACCESS_TOKEN:"
+)
+
+// OIDCGrant is what a user granted to an OpenID Connect client, kept under the hash of the authorization
+// code and then of the access token.
+type OIDCGrant struct {
+	ClientID      string `json:"client_id"`
+	UserID        string `json:"user_id"`
+	RedirectURI   string `json:"redirect_uri"`
+	Scope         string `json:"scope"`
+	Nonce         string `json:"nonce,omitempty"`
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	AuthTime      int64  `json:"auth_time"` // unix second
+}
+
+type OIDCInterface interface {
+	SetCode(ctx context.Context, hash string, grant *OIDCGrant, expire time.Duration) error
+	// TakeCode returns and deletes the grant of a code, so a code can only be redeemed once.
+	TakeCode(ctx context.Context, hash string) (*OIDCGrant, error)
+	SetAccessToken(ctx context.Context, hash string, grant *OIDCGrant, expire time.Duration) error
+	GetAccessToken(ctx context.Context, hash string) (*OIDCGrant, error)
+}
+
+type oidcCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewOIDCInterface(rdb redis.UniversalClient) OIDCInterface {
+	return &oidcCacheRedis{rdb: rdb}
+}
+
+func (o *oidcCacheRedis) set(ctx context.Context, key string, grant *OIDCGrant, expire time.Duration) error {
+	data, err := json.Marshal(grant)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(o.rdb.Set(ctx, key, data, expire).Err())
+}
+
+func (o *oidcCacheRedis) decode(data string, err error) (*OIDCGrant, error) {
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var grant OIDCGrant
+	if err := json.Unmarshal([]byte(data), &grant); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &grant, nil
+}
+
+func (o *oidcCacheRedis) SetCode(ctx context.Context, hash string, grant *OIDCGrant, expire time.Duration) error {
+	return o.set(ctx, oidcCode+hash, grant, expire)
+}
+
+func (o *oidcCacheRedis) TakeCode(ctx context.Context, hash string) (*OIDCGrant, error) {
+	return o.decode(o.rdb.GetDel(ctx, oidcCode+hash).Result())
+}
+
+func (o *oidcCacheRedis) SetAccessToken(ctx context.Context, hash string, grant *OIDCGrant, expire time.Duration) error {
+	return o.set(ctx, oidcAccessToken+hash, grant, expire)
+}
+
+func (o *oidcCacheRedis) GetAccessToken(ctx context.Context, hash string) (*OIDCGrant, error) {
+	return o.decode(o.rdb.Get(ctx, oidcAccessToken+hash).Result())
+}
@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const passkeySession = chatPrefix + "PASSKEY_SESSION:"
+
+type PasskeySessionInterface interface {
+	Set(ctx context.Context, sessionID string, session []byte, expire time.Duration) error
+	// Take returns and deletes the session of a ceremony, nil when it does not exist or expired.
+	Take(ctx context.Context, sessionID string) ([]byte, error)
+}
+
+type passkeySessionCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewPasskeySessionInterface(rdb redis.UniversalClient) PasskeySessionInterface {
+	return &passkeySessionCacheRedis{rdb: rdb}
+}
+
+func (p *passkeySessionCacheRedis) Set(ctx context.Context, sessionID string, session []byte, expire time.Duration) error {
+	return errs.Wrap(p.rdb.Set(ctx, passkeySession+sessionID, session, expire).Err())
+}
+
+func (p *passkeySessionCacheRedis) Take(ctx context.Context, sessionID string) ([]byte, error) {
+	session, err := p.rdb.GetDel(ctx, passkeySession+sessionID).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	return session, nil
+}
@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	refreshTokenFamily = chatPrefix + "REFRESH_TOKEN_FAMILY:"
+	refreshTokenUser   = chatPrefix + "REFRESH_TOKEN_USER:"
+)
+
+const (
+	RefreshRotated = iota
+	RefreshNotFound
+	RefreshReused
+)
+
+// rotateRefreshScript swaps the current refresh token of a family only if the presented one is still current,
+// so two concurrent refreshes with the same token can not both succeed.
+var rotateRefreshScript = redis.NewScript(`
+local current = redis.call('HGET', KEYS[1], 'current')
+if not current then
+	return {1, ''}
+end
+if current ~= ARGV[1] then
+	return {2, ''}
+end
+local access = redis.call('HGET', KEYS[1], 'access') or ''
+redis.call('HSET', KEYS[1], 'current', ARGV[2], 'access', ARGV[3], 'last_seen', ARGV[5])
+if ARGV[6] ~= '' then
+	redis.call('HSET', KEYS[1], 'ip', ARGV[6])
+end
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+return {0, access}
+`)
+
+func getRefreshTokenFamilyKey(familyID string) string {
+	return refreshTokenFamily + familyID
+}
+
+func getRefreshTokenUserKey(userID string) string {
+	return refreshTokenUser + userID
+}
+
+// RefreshFamily is the chain of refresh tokens issued from one login, only the latest one is usable.
+// It is also the login session of a device.
+type RefreshFamily struct {
+	FamilyID    string `redis:"-"`
+	SessionID   string `redis:"session_id"` // public ID of the session, the family ID is part of the refresh token
+	UserID      string `redis:"user_id"`
+	UserType    int32  `redis:"user_type"`
+	Current     string `redis:"current"` // hash of the current refresh token
+	AccessToken string `redis:"access"`  // access token issued together with the current refresh token
+	PlatformID  int32  `redis:"platform_id"`
+	DeviceID    string `redis:"device_id"`
+	IP          string `redis:"ip"`
+	CreateTime  int64  `redis:"create_time"` // unix milli
+	LastSeen    int64  `redis:"last_seen"`   // unix milli of the last login or refresh
+}
+
+type RefreshTokenInterface interface {
+	SetFamily(ctx context.Context, family *RefreshFamily, expire time.Duration) error
+	GetFamily(ctx context.Context, familyID string) (*RefreshFamily, error)
+	GetUserFamilies(ctx context.Context, userID string) ([]*RefreshFamily, error)
+	// Rotate replaces the current refresh token hash and returns the access token issued with the previous one.
+	Rotate(ctx context.Context, familyID string, oldHash string, newHash string, accessToken string, ip string, expire time.Duration) (int, string, error)
+	DelFamily(ctx context.Context, userID string, familyID string) error
+	DelUserFamilies(ctx context.Context, userID string) error
+}
+
+type refreshTokenCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewRefreshTokenInterface(rdb redis.UniversalClient) RefreshTokenInterface {
+	return &refreshTokenCacheRedis{rdb: rdb}
+}
+
+func (r *refreshTokenCacheRedis) SetFamily(ctx context.Context, family *RefreshFamily, expire time.Duration) error {
+	key := getRefreshTokenFamilyKey(family.FamilyID)
+	userKey := getRefreshTokenUserKey(family.UserID)
+	pipe := r.rdb.TxPipeline()
+	pipe.HSet(ctx, key, family)
+	pipe.Expire(ctx, key, expire)
+	pipe.SAdd(ctx, userKey, family.FamilyID)
+	pipe.Expire(ctx, userKey, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (r *refreshTokenCacheRedis) GetFamily(ctx context.Context, familyID string) (*RefreshFamily, error) {
+	res := r.rdb.HGetAll(ctx, getRefreshTokenFamilyKey(familyID))
+	if err := res.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(res.Val()) == 0 {
+		return nil, errs.Wrap(redis.Nil)
+	}
+	var family RefreshFamily
+	if err := res.Scan(&family); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	family.FamilyID = familyID
+	return &family, nil
+}
+
+func (r *refreshTokenCacheRedis) GetUserFamilies(ctx context.Context, userID string) ([]*RefreshFamily, error) {
+	userKey := getRefreshTokenUserKey(userID)
+	familyIDs, err := r.rdb.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	families := make([]*RefreshFamily, 0, len(familyIDs))
+	var expired []any
+	for _, familyID := range familyIDs {
+		family, err := r.GetFamily(ctx, familyID)
+		if err != nil {
+			if errs.Unwrap(err) == redis.Nil {
+				expired = append(expired, familyID)
+				continue
+			}
+			return nil, err
+		}
+		families = append(families, family)
+	}
+	if len(expired) > 0 {
+		if err := r.rdb.SRem(ctx, userKey, expired...).Err(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+	return families, nil
+}
+
+func (r *refreshTokenCacheRedis) Rotate(ctx context.Context, familyID string, oldHash string, newHash string, accessToken string, ip string, expire time.Duration) (int, string, error) {
+	res, err := rotateRefreshScript.Run(ctx, r.rdb, []string{getRefreshTokenFamilyKey(familyID)}, oldHash, newHash, accessToken,
+		int64(expire/time.Second), time.Now().UnixMilli(), ip).Slice()
+	if err != nil {
+		return 0, "", errs.Wrap(err)
+	}
+	if len(res) != 2 {
+		return 0, "", errs.New("invalid rotate refresh token result").Wrap()
+	}
+	state, _ := res[0].(int64)
+	previous, _ := res[1].(string)
+	return int(state), previous, nil
+}
+
+func (r *refreshTokenCacheRedis) DelFamily(ctx context.Context, userID string, familyID string) error {
+	pipe := r.rdb.TxPipeline()
+	pipe.Del(ctx, getRefreshTokenFamilyKey(familyID))
+	pipe.SRem(ctx, getRefreshTokenUserKey(userID), familyID)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (r *refreshTokenCacheRedis) DelUserFamilies(ctx context.Context, userID string) error {
+	userKey := getRefreshTokenUserKey(userID)
+	familyIDs, err := r.rdb.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	keys := make([]string, 0, len(familyIDs)+1)
+	for _, familyID := range familyIDs {
+		keys = append(keys, getRefreshTokenFamilyKey(familyID))
+	}
+	keys = append(keys, userKey)
+	// keys are deleted one by one so that they can live in different cluster slots
+	for _, key := range keys {
+		if err := r.rdb.Del(ctx, key).Err(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
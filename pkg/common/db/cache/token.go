@@ -31,6 +31,8 @@ const (
 type TokenInterface interface {
 	AddTokenFlag(ctx context.Context, userID string, token string, flag int) error
 	AddTokenFlagNXEx(ctx context.Context, userID string, token string, flag int, expire time.Duration) (bool, error)
+	AddTokenFlagEx(ctx context.Context, userID string, token string, flag int, expire time.Duration) error
+	DeleteTokenFlag(ctx context.Context, userID string, tokens ...string) error
 	GetTokensWithoutError(ctx context.Context, userID string) (map[string]int32, error)
 	DeleteTokenByUid(ctx context.Context, userID string) error
 }
@@ -65,6 +67,24 @@ func (t *TokenCacheRedis) AddTokenFlagNXEx(ctx context.Context, userID string, t
 	return isSet, nil
 }
 
+// AddTokenFlagEx adds the token and extends the expiration of the user's tokens to the expiration of the new one.
+func (t *TokenCacheRedis) AddTokenFlagEx(ctx context.Context, userID string, token string, flag int, expire time.Duration) error {
+	key := chatToken + userID
+	pipe := t.rdb.TxPipeline()
+	pipe.HSet(ctx, key, token, flag)
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) DeleteTokenFlag(ctx context.Context, userID string, tokens ...string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	key := chatToken + userID
+	return errs.Wrap(t.rdb.HDel(ctx, key, tokens...).Err())
+}
+
 func (t *TokenCacheRedis) GetTokensWithoutError(ctx context.Context, userID string) (map[string]int32, error) {
 	key := chatToken + userID
 	m, err := t.rdb.HGetAll(ctx, key).Result()
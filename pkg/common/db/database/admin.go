@@ -24,8 +24,10 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 
+	chatconstant "github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/model/admin"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 )
@@ -38,12 +40,30 @@ type AdminDatabaseInterface interface {
 	AddAdminAccount(ctx context.Context, admin []*admindb.Admin) error
 	DelAdminAccount(ctx context.Context, userIDs []string) error
 	SearchAdminAccount(ctx context.Context, pagination pagination.Pagination) (int64, []*admindb.Admin, error)
+	AddRole(ctx context.Context, roles []*admindb.Role) error
+	TakeRole(ctx context.Context, roleID string) (*admindb.Role, error)
+	FindRole(ctx context.Context, roleIDs []string) ([]*admindb.Role, error)
+	UpdateRole(ctx context.Context, roleID string, update map[string]any) error
+	// DelRole deletes the roles and removes them from the admins.
+	DelRole(ctx context.Context, roleIDs []string) error
+	SearchRole(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.Role, error)
+	AddAuditLog(ctx context.Context, logs []*admindb.AuditLog) error
+	SearchAuditLog(ctx context.Context, userID string, operation string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.AuditLog, error)
+	AddAPIKey(ctx context.Context, keys []*admindb.APIKey) error
+	TakeAPIKeyByHash(ctx context.Context, hash string) (*admindb.APIKey, error)
+	FindAPIKey(ctx context.Context, keyIDs []string) ([]*admindb.APIKey, error)
+	SearchAPIKey(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*admindb.APIKey, error)
+	SetAPIKeyLastUsed(ctx context.Context, keyID string, ip string, lastUsedTime time.Time) error
+	DelAPIKey(ctx context.Context, keyIDs []string) error
+	// InitRole creates the role if there are normal admins from before roles existed, and assigns it to them.
+	InitRole(ctx context.Context, role *admindb.Role) error
 	CreateApplet(ctx context.Context, applets []*admindb.Applet) error
 	DelApplet(ctx context.Context, appletIDs []string) error
 	GetApplet(ctx context.Context, appletID string) (*admindb.Applet, error)
 	FindApplet(ctx context.Context, appletIDs []string) ([]*admindb.Applet, error)
 	SearchApplet(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.Applet, error)
 	FindOnShelf(ctx context.Context) ([]*admindb.Applet, error)
+	FindAppletByAppID(ctx context.Context, appID string) ([]*admindb.Applet, error)
 	UpdateApplet(ctx context.Context, appletID string, update map[string]any) error
 	GetConfig(ctx context.Context) (map[string]string, error)
 	SetConfig(ctx context.Context, cs map[string]string) error
@@ -55,7 +75,7 @@ type AdminDatabaseInterface interface {
 	SearchInvitationRegister(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error)
 	SearchIPForbidden(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*admindb.IPForbidden, error)
 	AddIPForbidden(ctx context.Context, ms []*admindb.IPForbidden) error
-	FindIPForbidden(ctx context.Context, ms []string) ([]*admindb.IPForbidden, error)
+	MatchIPForbidden(ctx context.Context, ip string) ([]*admindb.IPForbidden, error)
 	DelIPForbidden(ctx context.Context, ips []string) error
 	FindDefaultFriend(ctx context.Context, userIDs []string) ([]string, error)
 	AddDefaultFriend(ctx context.Context, ms []*admindb.RegisterAddFriend) error
@@ -67,23 +87,67 @@ type AdminDatabaseInterface interface {
 	SearchDefaultGroup(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.RegisterAddGroup, error)
 	FindBlockInfo(ctx context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error)
 	GetBlockInfo(ctx context.Context, userID string) (*admindb.ForbiddenAccount, error)
+	// BlockUser replaces the expired blocks of the users with f.
 	BlockUser(ctx context.Context, f []*admindb.ForbiddenAccount) error
 	DelBlockUser(ctx context.Context, userID []string) error
 	SearchBlockUser(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccount, error)
 	FindBlockUser(ctx context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error)
+	FindExpiredBlock(ctx context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error)
+	DelExpiredBlock(ctx context.Context, userIDs []string, now time.Time) error
+	AddBanAppeal(ctx context.Context, appeal *admindb.BanAppeal) error
+	TakeBanAppeal(ctx context.Context, appealID string) (*admindb.BanAppeal, error)
+	TakeLatestBanAppeal(ctx context.Context, userID string) (*admindb.BanAppeal, error)
+	SearchBanAppeal(ctx context.Context, userID string, status int32, pagination pagination.Pagination) (int64, []*admindb.BanAppeal, error)
+	AddBanAppealComment(ctx context.Context, appealID string, comment *admindb.BanAppealComment) error
+	// ReviewBanAppeal sets the status of a pending appeal and lifts the block when it is approved, it
+	// returns false when the appeal is not pending.
+	ReviewBanAppeal(ctx context.Context, appeal *admindb.BanAppeal, status int32, reviewerUserID string, reviewTime time.Time) (bool, error)
 	SearchUserLimitLogin(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.LimitUserLoginIP, error)
 	AddUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error
 	DelUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error
 	CountLimitUserLoginIP(ctx context.Context, userID string) (uint32, error)
-	GetLimitUserLoginIP(ctx context.Context, userID string, ip string) (*admindb.LimitUserLoginIP, error)
+	MatchLimitUserLoginIP(ctx context.Context, userID string, ip string) (*admindb.LimitUserLoginIP, error)
 	CacheToken(ctx context.Context, userID string, token string, expire time.Duration) error
 	GetTokens(ctx context.Context, userID string) (map[string]int32, error)
 	DeleteToken(ctx context.Context, userID string) error
+	DeleteTokenFlag(ctx context.Context, userID string, tokens ...string) error
+	CreateRefreshFamily(ctx context.Context, family *cache.RefreshFamily, expire time.Duration) error
+	GetRefreshFamily(ctx context.Context, familyID string) (*cache.RefreshFamily, error)
+	GetRefreshFamilies(ctx context.Context, userID string) ([]*cache.RefreshFamily, error)
+	RotateRefreshToken(ctx context.Context, familyID string, oldHash string, newHash string, accessToken string, ip string, expire time.Duration) (int, string, error)
+	DelRefreshFamily(ctx context.Context, userID string, familyID string) error
+	LoginFail(ctx context.Context, kind string, id string, window time.Duration, maxFail int, lock time.Duration) (int64, bool, error)
+	LoginLockTTL(ctx context.Context, kind string, id string) (time.Duration, error)
+	ResetLoginFail(ctx context.Context, kind string, id string) error
+	UnlockLogin(ctx context.Context, kind string, id string) error
+	SearchLoginLocks(ctx context.Context, page pagination.Pagination) (int64, []*cache.LoginLock, error)
+	TryLockJob(ctx context.Context, name string, ttl time.Duration) (bool, error)
+	SetOIDCCode(ctx context.Context, hash string, grant *cache.OIDCGrant, expire time.Duration) error
+	TakeOIDCCode(ctx context.Context, hash string) (*cache.OIDCGrant, error)
+	SetOIDCAccessToken(ctx context.Context, hash string, grant *cache.OIDCGrant, expire time.Duration) error
+	GetOIDCAccessToken(ctx context.Context, hash string) (*cache.OIDCGrant, error)
+	SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error
+	TakeCaptcha(ctx context.Context, id string) (string, error)
+	CaptchaHit(ctx context.Context, ip string, window time.Duration) (int64, error)
+	CaptchaCount(ctx context.Context, ip string) (int64, error)
+	SetTestAccount(ctx context.Context, ts []*admindb.TestAccount) error
+	TakeTestAccount(ctx context.Context, account string) (*admindb.TestAccount, error)
+	SearchTestAccount(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.TestAccount, error)
+	DelTestAccount(ctx context.Context, accounts []string) error
+	// PurgeUser deletes the login restrictions, the block, the ban appeals and the tokens of a deleted user.
+	PurgeUser(ctx context.Context, userID string) error
 	LatestVersion(ctx context.Context, platform string) (*admindb.Application, error)
 	AddVersion(ctx context.Context, val *admindb.Application) error
 	UpdateVersion(ctx context.Context, id primitive.ObjectID, update map[string]any) error
 	DeleteVersion(ctx context.Context, id []primitive.ObjectID) error
 	PageVersion(ctx context.Context, platforms []string, page pagination.Pagination) (int64, []*admindb.Application, error)
+	FindVersion(ctx context.Context, id []primitive.ObjectID) ([]*admindb.Application, error)
+	SetTwoFactor(ctx context.Context, twoFactor *admindb.TwoFactor) error
+	TakeTwoFactor(ctx context.Context, userID string) (*admindb.TwoFactor, error)
+	UpdateTwoFactor(ctx context.Context, userID string, data map[string]any) error
+	UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseTwoFactorRecoveryCode(ctx context.Context, userID string, hash string) (bool, error)
+	DelTwoFactor(ctx context.Context, userIDs []string) error
 }
 
 func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDatabaseInterface, error) {
@@ -127,6 +191,30 @@ func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDa
 	if err != nil {
 		return nil, err
 	}
+	twoFactor, err := admin.NewTwoFactor(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	testAccount, err := admin.NewTestAccount(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	role, err := admin.NewRole(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := admin.NewAuditLog(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := admin.NewAPIKey(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	banAppeal, err := admin.NewBanAppeal(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	return &AdminDatabase{
 		tx:                 cli.GetTx(),
 		admin:              a,
@@ -139,7 +227,18 @@ func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDa
 		applet:             applet,
 		clientConfig:       clientConfig,
 		application:        application,
+		twoFactor:          twoFactor,
+		testAccount:        testAccount,
+		role:               role,
+		auditLog:           auditLog,
+		apiKey:             apiKey,
+		banAppeal:          banAppeal,
 		cache:              cache.NewTokenInterface(rdb),
+		refreshToken:       cache.NewRefreshTokenInterface(rdb),
+		loginAttempt:       cache.NewLoginAttemptInterface(rdb),
+		jobLock:            cache.NewJobLockInterface(rdb),
+		oidc:               cache.NewOIDCInterface(rdb),
+		captcha:            cache.NewCaptchaInterface(rdb),
 	}, nil
 }
 
@@ -155,7 +254,18 @@ type AdminDatabase struct {
 	applet             admindb.AppletInterface
 	clientConfig       admindb.ClientConfigInterface
 	application        admindb.ApplicationInterface
+	twoFactor          admindb.TwoFactorInterface
+	testAccount        admindb.TestAccountInterface
+	role               admindb.RoleInterface
+	auditLog           admindb.AuditLogInterface
+	apiKey             admindb.APIKeyInterface
+	banAppeal          admindb.BanAppealInterface
 	cache              cache.TokenInterface
+	refreshToken       cache.RefreshTokenInterface
+	loginAttempt       cache.LoginAttemptInterface
+	jobLock            cache.JobLockInterface
+	oidc               cache.OIDCInterface
+	captcha            cache.CaptchaInterface
 }
 
 func (o *AdminDatabase) GetAdmin(ctx context.Context, account string) (*admindb.Admin, error) {
@@ -179,13 +289,95 @@ func (o *AdminDatabase) AddAdminAccount(ctx context.Context, admins []*admindb.A
 }
 
 func (o *AdminDatabase) DelAdminAccount(ctx context.Context, userIDs []string) error {
-	return o.admin.Delete(ctx, userIDs)
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.admin.Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.apiKey.DeleteByUserID(ctx, userIDs); err != nil {
+			return err
+		}
+		return o.twoFactor.Delete(ctx, userIDs)
+	})
 }
 
 func (o *AdminDatabase) SearchAdminAccount(ctx context.Context, pagination pagination.Pagination) (int64, []*admindb.Admin, error) {
 	return o.admin.Search(ctx, pagination)
 }
 
+func (o *AdminDatabase) AddRole(ctx context.Context, roles []*admindb.Role) error {
+	return o.role.Create(ctx, roles)
+}
+
+func (o *AdminDatabase) TakeRole(ctx context.Context, roleID string) (*admindb.Role, error) {
+	return o.role.Take(ctx, roleID)
+}
+
+func (o *AdminDatabase) FindRole(ctx context.Context, roleIDs []string) ([]*admindb.Role, error) {
+	return o.role.Find(ctx, roleIDs)
+}
+
+func (o *AdminDatabase) UpdateRole(ctx context.Context, roleID string, update map[string]any) error {
+	return o.role.Update(ctx, roleID, update)
+}
+
+func (o *AdminDatabase) DelRole(ctx context.Context, roleIDs []string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.role.Delete(ctx, roleIDs); err != nil {
+			return err
+		}
+		return o.admin.PullRole(ctx, roleIDs)
+	})
+}
+
+func (o *AdminDatabase) SearchRole(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.Role, error) {
+	return o.role.Search(ctx, keyword, pagination)
+}
+
+func (o *AdminDatabase) AddAuditLog(ctx context.Context, logs []*admindb.AuditLog) error {
+	return o.auditLog.Create(ctx, logs)
+}
+
+func (o *AdminDatabase) SearchAuditLog(ctx context.Context, userID string, operation string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.AuditLog, error) {
+	return o.auditLog.Search(ctx, userID, operation, target, start, end, pagination)
+}
+
+func (o *AdminDatabase) AddAPIKey(ctx context.Context, keys []*admindb.APIKey) error {
+	return o.apiKey.Create(ctx, keys)
+}
+
+func (o *AdminDatabase) TakeAPIKeyByHash(ctx context.Context, hash string) (*admindb.APIKey, error) {
+	return o.apiKey.TakeByHash(ctx, hash)
+}
+
+func (o *AdminDatabase) FindAPIKey(ctx context.Context, keyIDs []string) ([]*admindb.APIKey, error) {
+	return o.apiKey.Find(ctx, keyIDs)
+}
+
+func (o *AdminDatabase) SearchAPIKey(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*admindb.APIKey, error) {
+	return o.apiKey.Search(ctx, userID, pagination)
+}
+
+func (o *AdminDatabase) SetAPIKeyLastUsed(ctx context.Context, keyID string, ip string, lastUsedTime time.Time) error {
+	return o.apiKey.SetLastUsed(ctx, keyID, ip, lastUsedTime)
+}
+
+func (o *AdminDatabase) DelAPIKey(ctx context.Context, keyIDs []string) error {
+	return o.apiKey.Delete(ctx, keyIDs)
+}
+
+func (o *AdminDatabase) InitRole(ctx context.Context, role *admindb.Role) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		count, err := o.admin.SetRoleIfUnset(ctx, []string{role.RoleID})
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		return o.role.Create(ctx, []*admindb.Role{role})
+	})
+}
+
 func (o *AdminDatabase) CreateApplet(ctx context.Context, applets []*admindb.Applet) error {
 	return o.applet.Create(ctx, applets)
 }
@@ -210,6 +402,10 @@ func (o *AdminDatabase) FindOnShelf(ctx context.Context) ([]*admindb.Applet, err
 	return o.applet.FindOnShelf(ctx)
 }
 
+func (o *AdminDatabase) FindAppletByAppID(ctx context.Context, appID string) ([]*admindb.Applet, error) {
+	return o.applet.FindAppID(ctx, appID)
+}
+
 func (o *AdminDatabase) UpdateApplet(ctx context.Context, appletID string, update map[string]any) error {
 	return o.applet.Update(ctx, appletID, update)
 }
@@ -254,8 +450,8 @@ func (o *AdminDatabase) AddIPForbidden(ctx context.Context, ms []*admindb.IPForb
 	return o.ipForbidden.Create(ctx, ms)
 }
 
-func (o *AdminDatabase) FindIPForbidden(ctx context.Context, ms []string) ([]*admindb.IPForbidden, error) {
-	return o.ipForbidden.Find(ctx, ms)
+func (o *AdminDatabase) MatchIPForbidden(ctx context.Context, ip string) ([]*admindb.IPForbidden, error) {
+	return o.ipForbidden.Match(ctx, ip)
 }
 
 func (o *AdminDatabase) DelIPForbidden(ctx context.Context, ips []string) error {
@@ -303,7 +499,13 @@ func (o *AdminDatabase) GetBlockInfo(ctx context.Context, userID string) (*admin
 }
 
 func (o *AdminDatabase) BlockUser(ctx context.Context, f []*admindb.ForbiddenAccount) error {
-	return o.forbiddenAccount.Create(ctx, f)
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		userIDs := datautil.Slice(f, func(e *admindb.ForbiddenAccount) string { return e.UserID })
+		if err := o.forbiddenAccount.DeleteExpired(ctx, userIDs, time.Now()); err != nil {
+			return err
+		}
+		return o.forbiddenAccount.Create(ctx, f)
+	})
 }
 
 func (o *AdminDatabase) DelBlockUser(ctx context.Context, userID []string) error {
@@ -318,6 +520,47 @@ func (o *AdminDatabase) FindBlockUser(ctx context.Context, userIDs []string) ([]
 	return o.forbiddenAccount.Find(ctx, userIDs)
 }
 
+func (o *AdminDatabase) FindExpiredBlock(ctx context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error) {
+	return o.forbiddenAccount.FindExpired(ctx, now, limit)
+}
+
+func (o *AdminDatabase) DelExpiredBlock(ctx context.Context, userIDs []string, now time.Time) error {
+	return o.forbiddenAccount.DeleteExpired(ctx, userIDs, now)
+}
+
+func (o *AdminDatabase) AddBanAppeal(ctx context.Context, appeal *admindb.BanAppeal) error {
+	return o.banAppeal.Create(ctx, appeal)
+}
+
+func (o *AdminDatabase) TakeBanAppeal(ctx context.Context, appealID string) (*admindb.BanAppeal, error) {
+	return o.banAppeal.Take(ctx, appealID)
+}
+
+func (o *AdminDatabase) TakeLatestBanAppeal(ctx context.Context, userID string) (*admindb.BanAppeal, error) {
+	return o.banAppeal.TakeLatest(ctx, userID)
+}
+
+func (o *AdminDatabase) SearchBanAppeal(ctx context.Context, userID string, status int32, pagination pagination.Pagination) (int64, []*admindb.BanAppeal, error) {
+	return o.banAppeal.Search(ctx, userID, status, pagination)
+}
+
+func (o *AdminDatabase) AddBanAppealComment(ctx context.Context, appealID string, comment *admindb.BanAppealComment) error {
+	return o.banAppeal.AddComment(ctx, appealID, comment)
+}
+
+func (o *AdminDatabase) ReviewBanAppeal(ctx context.Context, appeal *admindb.BanAppeal, status int32, reviewerUserID string, reviewTime time.Time) (bool, error) {
+	var ok bool
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		ok, err = o.banAppeal.Review(ctx, appeal.AppealID, status, reviewerUserID, reviewTime)
+		if err != nil || !ok || status != chatconstant.BanAppealApproved {
+			return err
+		}
+		return o.forbiddenAccount.Delete(ctx, []string{appeal.UserID})
+	})
+	return ok, err
+}
+
 func (o *AdminDatabase) SearchUserLimitLogin(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.LimitUserLoginIP, error) {
 	return o.limitUserLoginIP.Search(ctx, keyword, pagination)
 }
@@ -334,22 +577,12 @@ func (o *AdminDatabase) CountLimitUserLoginIP(ctx context.Context, userID string
 	return o.limitUserLoginIP.Count(ctx, userID)
 }
 
-func (o *AdminDatabase) GetLimitUserLoginIP(ctx context.Context, userID string, ip string) (*admindb.LimitUserLoginIP, error) {
-	return o.limitUserLoginIP.Take(ctx, userID, ip)
+func (o *AdminDatabase) MatchLimitUserLoginIP(ctx context.Context, userID string, ip string) (*admindb.LimitUserLoginIP, error) {
+	return o.limitUserLoginIP.Match(ctx, userID, ip)
 }
 
 func (o *AdminDatabase) CacheToken(ctx context.Context, userID string, token string, expire time.Duration) error {
-	isSet, err := o.cache.AddTokenFlagNXEx(ctx, userID, token, constant.NormalToken, expire)
-	if err != nil {
-		return err
-	}
-	if !isSet {
-		// already exists, update
-		if err = o.cache.AddTokenFlag(ctx, userID, token, constant.NormalToken); err != nil {
-			return err
-		}
-	}
-	return nil
+	return o.cache.AddTokenFlagEx(ctx, userID, token, constant.NormalToken, expire)
 }
 
 func (o *AdminDatabase) GetTokens(ctx context.Context, userID string) (map[string]int32, error) {
@@ -357,9 +590,60 @@ func (o *AdminDatabase) GetTokens(ctx context.Context, userID string) (map[strin
 }
 
 func (o *AdminDatabase) DeleteToken(ctx context.Context, userID string) error {
+	if err := o.refreshToken.DelUserFamilies(ctx, userID); err != nil {
+		return err
+	}
 	return o.cache.DeleteTokenByUid(ctx, userID)
 }
 
+func (o *AdminDatabase) DeleteTokenFlag(ctx context.Context, userID string, tokens ...string) error {
+	return o.cache.DeleteTokenFlag(ctx, userID, tokens...)
+}
+
+func (o *AdminDatabase) CreateRefreshFamily(ctx context.Context, family *cache.RefreshFamily, expire time.Duration) error {
+	return o.refreshToken.SetFamily(ctx, family, expire)
+}
+
+func (o *AdminDatabase) GetRefreshFamily(ctx context.Context, familyID string) (*cache.RefreshFamily, error) {
+	return o.refreshToken.GetFamily(ctx, familyID)
+}
+
+func (o *AdminDatabase) GetRefreshFamilies(ctx context.Context, userID string) ([]*cache.RefreshFamily, error) {
+	return o.refreshToken.GetUserFamilies(ctx, userID)
+}
+
+func (o *AdminDatabase) RotateRefreshToken(ctx context.Context, familyID string, oldHash string, newHash string, accessToken string, ip string, expire time.Duration) (int, string, error) {
+	return o.refreshToken.Rotate(ctx, familyID, oldHash, newHash, accessToken, ip, expire)
+}
+
+func (o *AdminDatabase) DelRefreshFamily(ctx context.Context, userID string, familyID string) error {
+	return o.refreshToken.DelFamily(ctx, userID, familyID)
+}
+
+func (o *AdminDatabase) LoginFail(ctx context.Context, kind string, id string, window time.Duration, maxFail int, lock time.Duration) (int64, bool, error) {
+	return o.loginAttempt.Fail(ctx, kind, id, window, maxFail, lock)
+}
+
+func (o *AdminDatabase) LoginLockTTL(ctx context.Context, kind string, id string) (time.Duration, error) {
+	return o.loginAttempt.LockTTL(ctx, kind, id)
+}
+
+func (o *AdminDatabase) ResetLoginFail(ctx context.Context, kind string, id string) error {
+	return o.loginAttempt.Reset(ctx, kind, id)
+}
+
+func (o *AdminDatabase) UnlockLogin(ctx context.Context, kind string, id string) error {
+	return o.loginAttempt.Unlock(ctx, kind, id)
+}
+
+func (o *AdminDatabase) SearchLoginLocks(ctx context.Context, page pagination.Pagination) (int64, []*cache.LoginLock, error) {
+	offset := int64(page.GetPageNumber()-1) * int64(page.GetShowNumber())
+	if offset < 0 {
+		offset = 0
+	}
+	return o.loginAttempt.SearchLocks(ctx, offset, int64(page.GetShowNumber()))
+}
+
 func (o *AdminDatabase) LatestVersion(ctx context.Context, platform string) (*admindb.Application, error) {
 	return o.application.LatestVersion(ctx, platform)
 }
@@ -379,3 +663,99 @@ func (o *AdminDatabase) DeleteVersion(ctx context.Context, id []primitive.Object
 func (o *AdminDatabase) PageVersion(ctx context.Context, platforms []string, page pagination.Pagination) (int64, []*admindb.Application, error) {
 	return o.application.PageVersion(ctx, platforms, page)
 }
+
+func (o *AdminDatabase) FindVersion(ctx context.Context, id []primitive.ObjectID) ([]*admindb.Application, error) {
+	return o.application.FindVersion(ctx, id)
+}
+
+func (o *AdminDatabase) SetTwoFactor(ctx context.Context, twoFactor *admindb.TwoFactor) error {
+	return o.twoFactor.Set(ctx, twoFactor)
+}
+
+func (o *AdminDatabase) TakeTwoFactor(ctx context.Context, userID string) (*admindb.TwoFactor, error) {
+	return o.twoFactor.Take(ctx, userID)
+}
+
+func (o *AdminDatabase) UpdateTwoFactor(ctx context.Context, userID string, data map[string]any) error {
+	return o.twoFactor.Update(ctx, userID, data)
+}
+
+func (o *AdminDatabase) UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return o.twoFactor.UseStep(ctx, userID, step)
+}
+
+func (o *AdminDatabase) UseTwoFactorRecoveryCode(ctx context.Context, userID string, hash string) (bool, error) {
+	return o.twoFactor.UseRecoveryCode(ctx, userID, hash)
+}
+
+func (o *AdminDatabase) DelTwoFactor(ctx context.Context, userIDs []string) error {
+	return o.twoFactor.Delete(ctx, userIDs)
+}
+
+func (o *AdminDatabase) TryLockJob(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	return o.jobLock.TryLock(ctx, name, ttl)
+}
+
+func (o *AdminDatabase) SetOIDCCode(ctx context.Context, hash string, grant *cache.OIDCGrant, expire time.Duration) error {
+	return o.oidc.SetCode(ctx, hash, grant, expire)
+}
+
+func (o *AdminDatabase) TakeOIDCCode(ctx context.Context, hash string) (*cache.OIDCGrant, error) {
+	return o.oidc.TakeCode(ctx, hash)
+}
+
+func (o *AdminDatabase) SetOIDCAccessToken(ctx context.Context, hash string, grant *cache.OIDCGrant, expire time.Duration) error {
+	return o.oidc.SetAccessToken(ctx, hash, grant, expire)
+}
+
+func (o *AdminDatabase) GetOIDCAccessToken(ctx context.Context, hash string) (*cache.OIDCGrant, error) {
+	return o.oidc.GetAccessToken(ctx, hash)
+}
+
+func (o *AdminDatabase) SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error {
+	return o.captcha.Set(ctx, id, answer, expire)
+}
+
+func (o *AdminDatabase) TakeCaptcha(ctx context.Context, id string) (string, error) {
+	return o.captcha.Take(ctx, id)
+}
+
+func (o *AdminDatabase) CaptchaHit(ctx context.Context, ip string, window time.Duration) (int64, error) {
+	return o.captcha.Hit(ctx, ip, window)
+}
+
+func (o *AdminDatabase) CaptchaCount(ctx context.Context, ip string) (int64, error) {
+	return o.captcha.Count(ctx, ip)
+}
+
+func (o *AdminDatabase) SetTestAccount(ctx context.Context, ts []*admindb.TestAccount) error {
+	return o.testAccount.Set(ctx, ts)
+}
+
+func (o *AdminDatabase) TakeTestAccount(ctx context.Context, account string) (*admindb.TestAccount, error) {
+	return o.testAccount.Take(ctx, account)
+}
+
+func (o *AdminDatabase) SearchTestAccount(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.TestAccount, error) {
+	return o.testAccount.Search(ctx, keyword, pagination)
+}
+
+func (o *AdminDatabase) DelTestAccount(ctx context.Context, accounts []string) error {
+	return o.testAccount.Delete(ctx, accounts)
+}
+
+func (o *AdminDatabase) PurgeUser(ctx context.Context, userID string) error {
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.limitUserLoginIP.DeleteByUserID(ctx, []string{userID}); err != nil {
+			return err
+		}
+		if err := o.banAppeal.DeleteByUserID(ctx, []string{userID}); err != nil {
+			return err
+		}
+		return o.forbiddenAccount.Delete(ctx, []string{userID})
+	})
+	if err != nil {
+		return err
+	}
+	return o.DeleteToken(ctx, userID)
+}
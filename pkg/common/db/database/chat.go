@@ -21,8 +21,10 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	"github.com/openimsdk/tools/db/tx"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
 	admindb "github.com/openimsdk/chat/pkg/common/db/model/admin"
 	"github.com/openimsdk/chat/pkg/common/db/model/chat"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
@@ -41,6 +43,10 @@ type ChatDatabaseInterface interface {
 	TakeAccount(ctx context.Context, userID string) (*chatdb.Account, error)
 	TakeCredentialByAccount(ctx context.Context, account string) (*chatdb.Credential, error)
 	TakeCredentialsByUserID(ctx context.Context, userID string) ([]*chatdb.Credential, error)
+	FindCredentialsByType(ctx context.Context, userID string, credentialType int) ([]*chatdb.Credential, error)
+	FindAllCredentialsByType(ctx context.Context, credentialType int) ([]*chatdb.Credential, error)
+	AddCredential(ctx context.Context, credential *chatdb.Credential) error
+	DelCredentialAccount(ctx context.Context, userID string, account string) error
 	TakeLastVerifyCode(ctx context.Context, account string) (*chatdb.VerifyCode, error)
 	Search(ctx context.Context, normalUser int32, keyword string, gender int32, pagination pagination.Pagination) (int64, []*chatdb.Attribute, error)
 	SearchUser(ctx context.Context, keyword string, userIDs []string, genders []int32, pagination pagination.Pagination) (int64, []*chatdb.Attribute, error)
@@ -50,15 +56,53 @@ type ChatDatabaseInterface interface {
 	DelVerifyCode(ctx context.Context, id string) error
 	RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute, credentials []*chatdb.Credential) error
 	LoginRecord(ctx context.Context, record *chatdb.UserLoginRecord, verifyCodeID *string) error
-	UpdatePassword(ctx context.Context, userID string, password string) error
-	UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, codeID string) error
+	UpdatePassword(ctx context.Context, userID string, password string, history []string) error
+	UpdatePasswordHash(ctx context.Context, userID string, password string) error
+	UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, history []string, codeID string) error
 	NewUserCountTotal(ctx context.Context, before *time.Time) (int64, error)
 	UserLoginCountTotal(ctx context.Context, before *time.Time) (int64, error)
 	UserLoginCountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
 	DelUserAccount(ctx context.Context, userIDs []string) error
+	SetTwoFactor(ctx context.Context, twoFactor *chatdb.TwoFactor) error
+	TakeTwoFactor(ctx context.Context, userID string) (*chatdb.TwoFactor, error)
+	UpdateTwoFactor(ctx context.Context, userID string, data map[string]any) error
+	UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseTwoFactorRecoveryCode(ctx context.Context, userID string, hash string) (bool, error)
+	DelTwoFactor(ctx context.Context, userIDs []string) error
+	AddPasskey(ctx context.Context, passkey *chatdb.Passkey) error
+	TakePasskey(ctx context.Context, credentialID string) (*chatdb.Passkey, error)
+	FindPasskeys(ctx context.Context, userID string) ([]*chatdb.Passkey, error)
+	UsePasskey(ctx context.Context, credentialID string, signCount uint32, backupState bool) (bool, error)
+	DelPasskey(ctx context.Context, userID string, credentialID string) error
+	SetUserDeletion(ctx context.Context, deletion *chatdb.UserDeletion) error
+	TakeUserDeletion(ctx context.Context, userID string) (*chatdb.UserDeletion, error)
+	DelUserDeletion(ctx context.Context, userID string) error
+	FindDueUserDeletion(ctx context.Context, now time.Time, limit int64) ([]*chatdb.UserDeletion, error)
+	// PurgeUser deletes everything stored of the user and leaves the tombstone, as long as the deletion
+	// of the user is still due at the delete time of the tombstone. False when it is not.
+	PurgeUser(ctx context.Context, tombstone *chatdb.UserTombstone) (bool, error)
+	FindRegister(ctx context.Context, userID string) ([]*chatdb.Register, error)
+	FindUserLoginRecord(ctx context.Context, userID string) ([]*chatdb.UserLoginRecord, error)
+	FindUsedInvitationRegister(ctx context.Context, userID string) ([]*admin.InvitationRegister, error)
+	TakeForbiddenAccount(ctx context.Context, userID string) (*admin.ForbiddenAccount, error)
+	CreateUserExport(ctx context.Context, export *chatdb.UserExport) error
+	TakeUserExport(ctx context.Context, exportID string) (*chatdb.UserExport, error)
+	TakeLatestUserExport(ctx context.Context, userID string) (*chatdb.UserExport, error)
+	UpdateUserExport(ctx context.Context, exportID string, data map[string]any) error
+	FindExpiredUserExport(ctx context.Context, now time.Time, limit int64) ([]*chatdb.UserExport, error)
+	DelUserExport(ctx context.Context, exportIDs []string) error
+	LoginLinkHit(ctx context.Context, email string, window time.Duration) (int64, error)
+	SetLoginLink(ctx context.Context, email string, hash string, expire time.Duration) error
+	// TakeLoginLink returns the hash of the latest link of the email, empty when there is none.
+	TakeLoginLink(ctx context.Context, email string) (string, error)
+	// UseLoginLink invalidates the link of the email, false when it was used or replaced meanwhile.
+	UseLoginLink(ctx context.Context, email string, hash string) (bool, error)
+	SetPasskeySession(ctx context.Context, sessionID string, session []byte, expire time.Duration) error
+	// TakePasskeySession returns and deletes the session of a ceremony, nil when it does not exist.
+	TakePasskeySession(ctx context.Context, sessionID string) ([]byte, error)
 }
 
-func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
+func NewChatDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (ChatDatabaseInterface, error) {
 	register, err := chat.NewRegister(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -87,27 +131,67 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 	if err != nil {
 		return nil, err
 	}
+	twoFactor, err := chat.NewTwoFactor(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	passkey, err := chat.NewPasskey(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	userDeletion, err := chat.NewUserDeletion(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	userTombstone, err := chat.NewUserTombstone(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	userExport, err := chat.NewUserExport(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	invitationRegister, err := admindb.NewInvitationRegister(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	return &ChatDatabase{
-		tx:               cli.GetTx(),
-		register:         register,
-		account:          account,
-		attribute:        attribute,
-		credential:       credential,
-		userLoginRecord:  userLoginRecord,
-		verifyCode:       verifyCode,
-		forbiddenAccount: forbiddenAccount,
+		tx:                 cli.GetTx(),
+		register:           register,
+		account:            account,
+		attribute:          attribute,
+		credential:         credential,
+		userLoginRecord:    userLoginRecord,
+		verifyCode:         verifyCode,
+		forbiddenAccount:   forbiddenAccount,
+		twoFactor:          twoFactor,
+		passkey:            passkey,
+		userDeletion:       userDeletion,
+		userTombstone:      userTombstone,
+		userExport:         userExport,
+		invitationRegister: invitationRegister,
+		loginLink:          cache.NewLoginLinkInterface(rdb),
+		passkeySession:     cache.NewPasskeySessionInterface(rdb),
 	}, nil
 }
 
 type ChatDatabase struct {
-	tx               tx.Tx
-	register         chatdb.RegisterInterface
-	account          chatdb.AccountInterface
-	attribute        chatdb.AttributeInterface
-	credential       chatdb.CredentialInterface
-	userLoginRecord  chatdb.UserLoginRecordInterface
-	verifyCode       chatdb.VerifyCodeInterface
-	forbiddenAccount admin.ForbiddenAccountInterface
+	tx                 tx.Tx
+	register           chatdb.RegisterInterface
+	account            chatdb.AccountInterface
+	attribute          chatdb.AttributeInterface
+	credential         chatdb.CredentialInterface
+	userLoginRecord    chatdb.UserLoginRecordInterface
+	verifyCode         chatdb.VerifyCodeInterface
+	forbiddenAccount   admin.ForbiddenAccountInterface
+	twoFactor          chatdb.TwoFactorInterface
+	passkey            chatdb.PasskeyInterface
+	userDeletion       chatdb.UserDeletionInterface
+	userTombstone      chatdb.UserTombstoneInterface
+	userExport         chatdb.UserExportInterface
+	invitationRegister admin.InvitationRegisterInterface
+	loginLink          cache.LoginLinkInterface
+	passkeySession     cache.PasskeySessionInterface
 }
 
 func (o *ChatDatabase) GetUser(ctx context.Context, userID string) (account *chatdb.Account, err error) {
@@ -167,6 +251,22 @@ func (o *ChatDatabase) TakeCredentialByAccount(ctx context.Context, account stri
 	return o.credential.TakeAccount(ctx, account)
 }
 
+func (o *ChatDatabase) FindCredentialsByType(ctx context.Context, userID string, credentialType int) ([]*chatdb.Credential, error) {
+	return o.credential.FindType(ctx, userID, credentialType)
+}
+
+func (o *ChatDatabase) FindAllCredentialsByType(ctx context.Context, credentialType int) ([]*chatdb.Credential, error) {
+	return o.credential.FindAllType(ctx, credentialType)
+}
+
+func (o *ChatDatabase) AddCredential(ctx context.Context, credential *chatdb.Credential) error {
+	return o.credential.Create(ctx, credential)
+}
+
+func (o *ChatDatabase) DelCredentialAccount(ctx context.Context, userID string, account string) error {
+	return o.credential.DeleteAccount(ctx, userID, account)
+}
+
 func (o *ChatDatabase) TakeCredentialsByUserID(ctx context.Context, userID string) ([]*chatdb.Credential, error) {
 	return o.credential.Find(ctx, userID)
 }
@@ -246,13 +346,17 @@ func (o *ChatDatabase) LoginRecord(ctx context.Context, record *chatdb.UserLogin
 	})
 }
 
-func (o *ChatDatabase) UpdatePassword(ctx context.Context, userID string, password string) error {
-	return o.account.UpdatePassword(ctx, userID, password)
+func (o *ChatDatabase) UpdatePassword(ctx context.Context, userID string, password string, history []string) error {
+	return o.account.UpdatePassword(ctx, userID, password, history)
+}
+
+func (o *ChatDatabase) UpdatePasswordHash(ctx context.Context, userID string, password string) error {
+	return o.account.Update(ctx, userID, map[string]any{"password": password})
 }
 
-func (o *ChatDatabase) UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, codeID string) error {
+func (o *ChatDatabase) UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, history []string, codeID string) error {
 	return o.tx.Transaction(ctx, func(ctx context.Context) error {
-		if err := o.account.UpdatePassword(ctx, userID, password); err != nil {
+		if err := o.account.UpdatePassword(ctx, userID, password, history); err != nil {
 			return err
 		}
 		if codeID == "" {
@@ -279,15 +383,192 @@ func (o *ChatDatabase) UserLoginCountRangeEverydayTotal(ctx context.Context, sta
 
 func (o *ChatDatabase) DelUserAccount(ctx context.Context, userIDs []string) error {
 	return o.tx.Transaction(ctx, func(ctx context.Context) error {
-		if err := o.register.Delete(ctx, userIDs); err != nil {
+		return o.delUserAccount(ctx, userIDs)
+	})
+}
+
+func (o *ChatDatabase) delUserAccount(ctx context.Context, userIDs []string) error {
+	if err := o.register.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := o.account.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := o.attribute.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := o.twoFactor.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := o.passkey.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := o.credential.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := o.userLoginRecord.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := o.userExport.DeleteByUserID(ctx, userIDs); err != nil {
+		return err
+	}
+	return o.userDeletion.Delete(ctx, userIDs)
+}
+
+func (o *ChatDatabase) SetTwoFactor(ctx context.Context, twoFactor *chatdb.TwoFactor) error {
+	return o.twoFactor.Set(ctx, twoFactor)
+}
+
+func (o *ChatDatabase) TakeTwoFactor(ctx context.Context, userID string) (*chatdb.TwoFactor, error) {
+	return o.twoFactor.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) UpdateTwoFactor(ctx context.Context, userID string, data map[string]any) error {
+	return o.twoFactor.Update(ctx, userID, data)
+}
+
+func (o *ChatDatabase) UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return o.twoFactor.UseStep(ctx, userID, step)
+}
+
+func (o *ChatDatabase) UseTwoFactorRecoveryCode(ctx context.Context, userID string, hash string) (bool, error) {
+	return o.twoFactor.UseRecoveryCode(ctx, userID, hash)
+}
+
+func (o *ChatDatabase) DelTwoFactor(ctx context.Context, userIDs []string) error {
+	return o.twoFactor.Delete(ctx, userIDs)
+}
+
+func (o *ChatDatabase) AddPasskey(ctx context.Context, passkey *chatdb.Passkey) error {
+	return o.passkey.Create(ctx, passkey)
+}
+
+func (o *ChatDatabase) TakePasskey(ctx context.Context, credentialID string) (*chatdb.Passkey, error) {
+	return o.passkey.Take(ctx, credentialID)
+}
+
+func (o *ChatDatabase) FindPasskeys(ctx context.Context, userID string) ([]*chatdb.Passkey, error) {
+	return o.passkey.Find(ctx, userID)
+}
+
+func (o *ChatDatabase) UsePasskey(ctx context.Context, credentialID string, signCount uint32, backupState bool) (bool, error) {
+	return o.passkey.Use(ctx, credentialID, signCount, backupState)
+}
+
+func (o *ChatDatabase) DelPasskey(ctx context.Context, userID string, credentialID string) error {
+	return o.passkey.DeleteCredential(ctx, userID, credentialID)
+}
+
+func (o *ChatDatabase) SetUserDeletion(ctx context.Context, deletion *chatdb.UserDeletion) error {
+	return o.userDeletion.Set(ctx, deletion)
+}
+
+func (o *ChatDatabase) TakeUserDeletion(ctx context.Context, userID string) (*chatdb.UserDeletion, error) {
+	return o.userDeletion.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) DelUserDeletion(ctx context.Context, userID string) error {
+	return o.userDeletion.Delete(ctx, []string{userID})
+}
+
+func (o *ChatDatabase) FindDueUserDeletion(ctx context.Context, now time.Time, limit int64) ([]*chatdb.UserDeletion, error) {
+	return o.userDeletion.FindDue(ctx, now, limit)
+}
+
+func (o *ChatDatabase) PurgeUser(ctx context.Context, tombstone *chatdb.UserTombstone) (bool, error) {
+	var purged bool
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		// a login cancels the deletion, taking the request inside the transaction keeps a user who logged in meanwhile
+		due, err := o.userDeletion.DeleteDue(ctx, tombstone.UserID, tombstone.DeleteTime)
+		if err != nil || !due {
+			return err
+		}
+		credentials, err := o.credential.Find(ctx, tombstone.UserID)
+		if err != nil {
+			return err
+		}
+		accounts := make([]string, 0, len(credentials))
+		for _, credential := range credentials {
+			accounts = append(accounts, credential.Account)
+		}
+		if err := o.verifyCode.DeleteAccount(ctx, accounts); err != nil {
 			return err
 		}
-		if err := o.account.Delete(ctx, userIDs); err != nil {
+		if err := o.delUserAccount(ctx, []string{tombstone.UserID}); err != nil {
 			return err
 		}
-		if err := o.attribute.Delete(ctx, userIDs); err != nil {
+		if err := o.userTombstone.Create(ctx, tombstone); err != nil {
 			return err
 		}
+		purged = true
 		return nil
 	})
+	if err != nil {
+		return false, err
+	}
+	return purged, nil
+}
+
+func (o *ChatDatabase) FindRegister(ctx context.Context, userID string) ([]*chatdb.Register, error) {
+	return o.register.Find(ctx, userID)
+}
+
+func (o *ChatDatabase) FindUserLoginRecord(ctx context.Context, userID string) ([]*chatdb.UserLoginRecord, error) {
+	return o.userLoginRecord.Find(ctx, userID)
+}
+
+func (o *ChatDatabase) FindUsedInvitationRegister(ctx context.Context, userID string) ([]*admin.InvitationRegister, error) {
+	return o.invitationRegister.FindUsedBy(ctx, userID)
+}
+
+func (o *ChatDatabase) TakeForbiddenAccount(ctx context.Context, userID string) (*admin.ForbiddenAccount, error) {
+	return o.forbiddenAccount.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) CreateUserExport(ctx context.Context, export *chatdb.UserExport) error {
+	return o.userExport.Create(ctx, export)
+}
+
+func (o *ChatDatabase) TakeUserExport(ctx context.Context, exportID string) (*chatdb.UserExport, error) {
+	return o.userExport.Take(ctx, exportID)
+}
+
+func (o *ChatDatabase) TakeLatestUserExport(ctx context.Context, userID string) (*chatdb.UserExport, error) {
+	return o.userExport.TakeLatest(ctx, userID)
+}
+
+func (o *ChatDatabase) UpdateUserExport(ctx context.Context, exportID string, data map[string]any) error {
+	return o.userExport.Update(ctx, exportID, data)
+}
+
+func (o *ChatDatabase) FindExpiredUserExport(ctx context.Context, now time.Time, limit int64) ([]*chatdb.UserExport, error) {
+	return o.userExport.FindExpired(ctx, now, limit)
+}
+
+func (o *ChatDatabase) DelUserExport(ctx context.Context, exportIDs []string) error {
+	return o.userExport.Delete(ctx, exportIDs)
+}
+
+func (o *ChatDatabase) LoginLinkHit(ctx context.Context, email string, window time.Duration) (int64, error) {
+	return o.loginLink.Hit(ctx, email, window)
+}
+
+func (o *ChatDatabase) SetLoginLink(ctx context.Context, email string, hash string, expire time.Duration) error {
+	return o.loginLink.Set(ctx, email, hash, expire)
+}
+
+func (o *ChatDatabase) TakeLoginLink(ctx context.Context, email string) (string, error) {
+	return o.loginLink.Get(ctx, email)
+}
+
+func (o *ChatDatabase) UseLoginLink(ctx context.Context, email string, hash string) (bool, error) {
+	return o.loginLink.Use(ctx, email, hash)
+}
+
+func (o *ChatDatabase) SetPasskeySession(ctx context.Context, sessionID string, session []byte, expire time.Duration) error {
+	return o.passkeySession.Set(ctx, sessionID, session, expire)
+}
+
+func (o *ChatDatabase) TakePasskeySession(ctx context.Context, sessionID string) ([]byte, error) {
+	return o.passkeySession.Take(ctx, sessionID)
 }
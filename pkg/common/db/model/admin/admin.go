@@ -82,3 +82,21 @@ func (o *Admin) Search(ctx context.Context, pagination pagination.Pagination) (i
 	filter := bson.M{"level": constant.NormalAdmin}
 	return mongoutil.FindPage[*admindb.Admin](ctx, o.coll, filter, pagination, opt)
 }
+
+func (o *Admin) PullRole(ctx context.Context, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"role_ids": bson.M{"$in": roleIDs}}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, bson.M{"$pull": bson.M{"role_ids": bson.M{"$in": roleIDs}}})
+	return err
+}
+
+func (o *Admin) SetRoleIfUnset(ctx context.Context, roleIDs []string) (int64, error) {
+	filter := bson.M{"level": constant.NormalAdmin, "role_ids": bson.M{"$exists": false}}
+	res, err := mongoutil.UpdateMany(ctx, o.coll, filter, bson.M{"$set": bson.M{"role_ids": roleIDs}})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
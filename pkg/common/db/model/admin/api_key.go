@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewAPIKey(db *mongo.Database) (admindb.APIKeyInterface, error) {
+	coll := db.Collection("admin_api_key")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "key_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "hash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "create_time", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &APIKey{coll: coll}, nil
+}
+
+type APIKey struct {
+	coll *mongo.Collection
+}
+
+func (o *APIKey) Create(ctx context.Context, keys []*admindb.APIKey) error {
+	return mongoutil.InsertMany(ctx, o.coll, keys)
+}
+
+func (o *APIKey) TakeByHash(ctx context.Context, hash string) (*admindb.APIKey, error) {
+	return mongoutil.FindOne[*admindb.APIKey](ctx, o.coll, bson.M{"hash": hash})
+}
+
+func (o *APIKey) Find(ctx context.Context, keyIDs []string) ([]*admindb.APIKey, error) {
+	if len(keyIDs) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*admindb.APIKey](ctx, o.coll, bson.M{"key_id": bson.M{"$in": keyIDs}})
+}
+
+func (o *APIKey) Search(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*admindb.APIKey, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*admindb.APIKey](ctx, o.coll, filter, pagination, opts)
+}
+
+func (o *APIKey) SetLastUsed(ctx context.Context, keyID string, ip string, lastUsedTime time.Time) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"key_id": keyID}, bson.M{"$set": bson.M{"last_used_time": lastUsedTime, "last_used_ip": ip}}, false)
+}
+
+func (o *APIKey) Delete(ctx context.Context, keyIDs []string) error {
+	if len(keyIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"key_id": bson.M{"$in": keyIDs}})
+}
+
+func (o *APIKey) DeleteByUserID(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
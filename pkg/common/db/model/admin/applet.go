@@ -93,3 +93,7 @@ func (o *Applet) FindOnShelf(ctx context.Context) ([]*admin.Applet, error) {
 func (o *Applet) FindID(ctx context.Context, ids []string) ([]*admin.Applet, error) {
 	return mongoutil.Find[*admin.Applet](ctx, o.coll, bson.M{"id": bson.M{"$in": ids}})
 }
+
+func (o *Applet) FindAppID(ctx context.Context, appID string) ([]*admin.Applet, error) {
+	return mongoutil.Find[*admin.Applet](ctx, o.coll, bson.M{"app_id": appID})
+}
@@ -39,7 +39,7 @@ type ApplicationMgo struct {
 }
 
 func (a *ApplicationMgo) sort() any {
-	return bson.D{{"latest", -1}, {"_id", -1}}
+	return bson.D{{Key: "latest", Value: -1}, {Key: "_id", Value: -1}}
 }
 
 func (a *ApplicationMgo) LatestVersion(ctx context.Context, platform string) (*admin.Application, error) {
@@ -81,3 +81,10 @@ func (a *ApplicationMgo) FindPlatform(ctx context.Context, id []primitive.Object
 	}
 	return mongoutil.Find[string](ctx, a.coll, bson.M{"_id": bson.M{"$in": id}}, options.Find().SetProjection(bson.M{"_id": 0, "platform": 1}))
 }
+
+func (a *ApplicationMgo) FindVersion(ctx context.Context, id []primitive.ObjectID) ([]*admin.Application, error) {
+	if len(id) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*admin.Application](ctx, a.coll, bson.M{"_id": bson.M{"$in": id}})
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewAuditLog(db *mongo.Database) (admindb.AuditLogInterface, error) {
+	coll := db.Collection("audit_log")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "log_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "create_time", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "target", Value: 1}, {Key: "create_time", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &AuditLog{coll: coll}, nil
+}
+
+type AuditLog struct {
+	coll *mongo.Collection
+}
+
+func (o *AuditLog) Create(ctx context.Context, logs []*admindb.AuditLog) error {
+	return mongoutil.InsertMany(ctx, o.coll, logs)
+}
+
+func (o *AuditLog) Search(ctx context.Context, userID string, operation string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.AuditLog, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	if operation != "" {
+		filter["operation"] = operation
+	}
+	if target != "" {
+		filter["target"] = target
+	}
+	if !start.IsZero() || !end.IsZero() {
+		createTime := bson.M{}
+		if !start.IsZero() {
+			createTime["$gte"] = start
+		}
+		if !end.IsZero() {
+			createTime["$lt"] = end
+		}
+		filter["create_time"] = createTime
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*admindb.AuditLog](ctx, o.coll, filter, pagination, opts)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewBanAppeal(db *mongo.Database) (admindb.BanAppealInterface, error) {
+	coll := db.Collection("ban_appeal")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "appeal_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// a user has one pending appeal at most
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"status": constant.BanAppealPending}),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "create_time", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &BanAppeal{coll: coll}, nil
+}
+
+type BanAppeal struct {
+	coll *mongo.Collection
+}
+
+func (o *BanAppeal) Create(ctx context.Context, appeal *admindb.BanAppeal) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*admindb.BanAppeal{appeal})
+}
+
+func (o *BanAppeal) Take(ctx context.Context, appealID string) (*admindb.BanAppeal, error) {
+	return mongoutil.FindOne[*admindb.BanAppeal](ctx, o.coll, bson.M{"appeal_id": appealID})
+}
+
+func (o *BanAppeal) TakeLatest(ctx context.Context, userID string) (*admindb.BanAppeal, error) {
+	return mongoutil.FindOne[*admindb.BanAppeal](ctx, o.coll, bson.M{"user_id": userID}, options.FindOne().SetSort(bson.D{{Key: "create_time", Value: -1}}))
+}
+
+func (o *BanAppeal) Search(ctx context.Context, userID string, status int32, pagination pagination.Pagination) (int64, []*admindb.BanAppeal, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	if status != 0 {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}})
+	return mongoutil.FindPage[*admindb.BanAppeal](ctx, o.coll, filter, pagination, opts)
+}
+
+func (o *BanAppeal) AddComment(ctx context.Context, appealID string, comment *admindb.BanAppealComment) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"appeal_id": appealID}, bson.M{"$push": bson.M{"comments": comment}}, false)
+}
+
+func (o *BanAppeal) Review(ctx context.Context, appealID string, status int32, reviewerUserID string, reviewTime time.Time) (bool, error) {
+	update := bson.M{"$set": bson.M{"status": status, "reviewer_user_id": reviewerUserID, "review_time": reviewTime}}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"appeal_id": appealID, "status": constant.BanAppealPending}, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (o *BanAppeal) DeleteByUserID(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
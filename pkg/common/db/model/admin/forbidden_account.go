@@ -16,6 +16,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
@@ -29,11 +30,18 @@ import (
 
 func NewForbiddenAccount(db *mongo.Database) (admin.ForbiddenAccountInterface, error) {
 	coll := db.Collection("forbidden_account")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "user_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "expire_time", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -47,12 +55,24 @@ type ForbiddenAccount struct {
 	coll *mongo.Collection
 }
 
+// active adds the condition of the blocks that have not expired to filter, blocks from before the
+// expire time have none and a zero one does not expire.
+func (o *ForbiddenAccount) active(filter bson.M) bson.M {
+	filter["expire_time"] = bson.M{"$not": bson.M{"$gt": time.Time{}, "$lte": time.Now()}}
+	return filter
+}
+
+func (o *ForbiddenAccount) expired(filter bson.M, now time.Time) bson.M {
+	filter["expire_time"] = bson.M{"$gt": time.Time{}, "$lte": now}
+	return filter
+}
+
 func (o *ForbiddenAccount) Create(ctx context.Context, ms []*admin.ForbiddenAccount) error {
 	return mongoutil.InsertMany(ctx, o.coll, ms)
 }
 
 func (o *ForbiddenAccount) Take(ctx context.Context, userID string) (*admin.ForbiddenAccount, error) {
-	return mongoutil.FindOne[*admin.ForbiddenAccount](ctx, o.coll, bson.M{"user_id": userID})
+	return mongoutil.FindOne[*admin.ForbiddenAccount](ctx, o.coll, o.active(bson.M{"user_id": userID}))
 }
 
 func (o *ForbiddenAccount) Delete(ctx context.Context, userIDs []string) error {
@@ -63,7 +83,7 @@ func (o *ForbiddenAccount) Delete(ctx context.Context, userIDs []string) error {
 }
 
 func (o *ForbiddenAccount) Find(ctx context.Context, userIDs []string) ([]*admin.ForbiddenAccount, error) {
-	return mongoutil.Find[*admin.ForbiddenAccount](ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+	return mongoutil.Find[*admin.ForbiddenAccount](ctx, o.coll, o.active(bson.M{"user_id": bson.M{"$in": userIDs}}))
 }
 
 func (o *ForbiddenAccount) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admin.ForbiddenAccount, error) {
@@ -78,9 +98,20 @@ func (o *ForbiddenAccount) Search(ctx context.Context, keyword string, paginatio
 			},
 		}
 	}
-	return mongoutil.FindPage[*admin.ForbiddenAccount](ctx, o.coll, filter, pagination)
+	return mongoutil.FindPage[*admin.ForbiddenAccount](ctx, o.coll, o.active(filter), pagination)
 }
 
 func (o *ForbiddenAccount) FindAllIDs(ctx context.Context) ([]string, error) {
-	return mongoutil.Find[string](ctx, o.coll, bson.M{}, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+	return mongoutil.Find[string](ctx, o.coll, o.active(bson.M{}), options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+}
+
+func (o *ForbiddenAccount) FindExpired(ctx context.Context, now time.Time, limit int64) ([]*admin.ForbiddenAccount, error) {
+	return mongoutil.Find[*admin.ForbiddenAccount](ctx, o.coll, o.expired(bson.M{}, now), options.Find().SetLimit(limit))
+}
+
+func (o *ForbiddenAccount) DeleteExpired(ctx context.Context, userIDs []string, now time.Time) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, o.expired(bson.M{"user_id": bson.M{"$in": userIDs}}, now))
 }
@@ -74,6 +74,11 @@ func (o *InvitationRegister) Update(ctx context.Context, code string, data map[s
 	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"invitation_code": code}, bson.M{"$set": data}, false)
 }
 
+func (o *InvitationRegister) FindUsedBy(ctx context.Context, userID string) ([]*admindb.InvitationRegister, error) {
+	// the user of a code is stored as user_id, see ToDBInvitationRegisterUpdate
+	return mongoutil.Find[*admindb.InvitationRegister](ctx, o.coll, bson.M{"user_id": userID})
+}
+
 func (o *InvitationRegister) Search(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error) {
 	filter := bson.M{}
 	switch state {
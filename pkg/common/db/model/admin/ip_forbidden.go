@@ -30,11 +30,19 @@ import (
 
 func NewIPForbidden(db *mongo.Database) (admindb.IPForbiddenInterface, error) {
 	coll := db.Collection("ip_forbidden")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "ip", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "ip", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "range_start", Value: 1},
+				{Key: "range_end", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -56,6 +64,10 @@ func (o *IPForbidden) Find(ctx context.Context, ips []string) ([]*admindb.IPForb
 	return mongoutil.Find[*admindb.IPForbidden](ctx, o.coll, bson.M{"ip": bson.M{"$in": ips}})
 }
 
+func (o *IPForbidden) Match(ctx context.Context, ip string) ([]*admindb.IPForbidden, error) {
+	return mongoutil.Find[*admindb.IPForbidden](ctx, o.coll, ipRangeFilter(ip))
+}
+
 func (o *IPForbidden) Search(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*admindb.IPForbidden, error) {
 	filter := bson.M{}
 
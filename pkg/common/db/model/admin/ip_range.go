@@ -0,0 +1,35 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/openimsdk/chat/pkg/iprange"
+)
+
+// ipRangeFilter matches documents whose range contains ip. Documents written before CIDR support
+// have no range and only match their exact ip string.
+func ipRangeFilter(ip string) bson.M {
+	addr, err := iprange.ParseAddr(ip)
+	if err != nil {
+		return bson.M{"ip": ip}
+	}
+	key := iprange.Key(addr)
+	return bson.M{"$or": []bson.M{
+		{"ip": ip},
+		{"range_start": bson.M{"$lte": key}, "range_end": bson.M{"$gte": key}},
+	}}
+}
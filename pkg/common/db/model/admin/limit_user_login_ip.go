@@ -29,12 +29,21 @@ import (
 
 func NewLimitUserLoginIP(db *mongo.Database) (admin.LimitUserLoginIPInterface, error) {
 	coll := db.Collection("limit_user_login_ip")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "user_id", Value: 1},
-			{Key: "ip", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "ip", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "range_start", Value: 1},
+				{Key: "range_end", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -56,6 +65,13 @@ func (o *LimitUserLoginIP) Delete(ctx context.Context, ms []*admin.LimitUserLogi
 	return mongoutil.DeleteMany(ctx, o.coll, o.limitUserLoginIPFilter(ms))
 }
 
+func (o *LimitUserLoginIP) DeleteByUserID(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
 func (o *LimitUserLoginIP) Count(ctx context.Context, userID string) (uint32, error) {
 	count, err := mongoutil.Count(ctx, o.coll, bson.M{"user_id": userID})
 	if err != nil {
@@ -68,6 +84,12 @@ func (o *LimitUserLoginIP) Take(ctx context.Context, userID string, ip string) (
 	return mongoutil.FindOne[*admin.LimitUserLoginIP](ctx, o.coll, bson.M{"user_id": userID, "ip": ip})
 }
 
+func (o *LimitUserLoginIP) Match(ctx context.Context, userID string, ip string) (*admin.LimitUserLoginIP, error) {
+	filter := ipRangeFilter(ip)
+	filter["user_id"] = userID
+	return mongoutil.FindOne[*admin.LimitUserLoginIP](ctx, o.coll, filter)
+}
+
 func (o *LimitUserLoginIP) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admin.LimitUserLoginIP, error) {
 	filter := bson.M{
 		"$or": []bson.M{
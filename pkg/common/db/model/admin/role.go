@@ -0,0 +1,86 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewRole(db *mongo.Database) (admindb.RoleInterface, error) {
+	coll := db.Collection("admin_role")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "role_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Role{coll: coll}, nil
+}
+
+type Role struct {
+	coll *mongo.Collection
+}
+
+func (o *Role) Create(ctx context.Context, roles []*admindb.Role) error {
+	return mongoutil.InsertMany(ctx, o.coll, roles)
+}
+
+func (o *Role) Take(ctx context.Context, roleID string) (*admindb.Role, error) {
+	return mongoutil.FindOne[*admindb.Role](ctx, o.coll, bson.M{"role_id": roleID})
+}
+
+func (o *Role) Find(ctx context.Context, roleIDs []string) ([]*admindb.Role, error) {
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*admindb.Role](ctx, o.coll, bson.M{"role_id": bson.M{"$in": roleIDs}})
+}
+
+func (o *Role) Update(ctx context.Context, roleID string, update map[string]any) error {
+	if len(update) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"role_id": roleID}, bson.M{"$set": update}, false)
+}
+
+func (o *Role) Delete(ctx context.Context, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"role_id": bson.M{"$in": roleIDs}})
+}
+
+func (o *Role) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.Role, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter["$or"] = []bson.M{
+			{"role_id": bson.M{"$regex": keyword, "$options": "i"}},
+			{"name": bson.M{"$regex": keyword, "$options": "i"}},
+		}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*admindb.Role](ctx, o.coll, filter, pagination, opts)
+}
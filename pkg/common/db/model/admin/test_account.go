@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewTestAccount(db *mongo.Database) (admindb.TestAccountInterface, error) {
+	coll := db.Collection("test_account")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "account", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &TestAccount{coll: coll}, nil
+}
+
+type TestAccount struct {
+	coll *mongo.Collection
+}
+
+func (o *TestAccount) Set(ctx context.Context, ts []*admindb.TestAccount) error {
+	for _, t := range ts {
+		update := bson.M{
+			"$set": bson.M{
+				"code":        t.Code,
+				"remark":      t.Remark,
+				"expire_time": t.ExpireTime,
+			},
+			"$setOnInsert": bson.M{
+				"create_time": t.CreateTime,
+			},
+		}
+		err := mongoutil.UpdateOne(ctx, o.coll, bson.M{"account": t.Account}, update, false, options.Update().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *TestAccount) Take(ctx context.Context, account string) (*admindb.TestAccount, error) {
+	return mongoutil.FindOne[*admindb.TestAccount](ctx, o.coll, bson.M{"account": account})
+}
+
+func (o *TestAccount) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.TestAccount, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter["$or"] = []bson.M{
+			{"account": bson.M{"$regex": keyword, "$options": "i"}},
+			{"remark": bson.M{"$regex": keyword, "$options": "i"}},
+		}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*admindb.TestAccount](ctx, o.coll, filter, pagination, opts)
+}
+
+func (o *TestAccount) Delete(ctx context.Context, accounts []string) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"account": bson.M{"$in": accounts}})
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewTwoFactor(db *mongo.Database) (admin.TwoFactorInterface, error) {
+	coll := db.Collection("admin_two_factor")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &TwoFactor{coll: coll}, nil
+}
+
+type TwoFactor struct {
+	coll *mongo.Collection
+}
+
+func (o *TwoFactor) Set(ctx context.Context, twoFactor *admin.TwoFactor) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": twoFactor.UserID}, bson.M{"$set": twoFactor}, false, options.Update().SetUpsert(true))
+}
+
+func (o *TwoFactor) Take(ctx context.Context, userID string) (*admin.TwoFactor, error) {
+	return mongoutil.FindOne[*admin.TwoFactor](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *TwoFactor) Update(ctx context.Context, userID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userID}, bson.M{"$set": data}, true)
+}
+
+func (o *TwoFactor) UseStep(ctx context.Context, userID string, step int64) (bool, error) {
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"user_id": userID, "last_step": bson.M{"$lt": step}}, bson.M{"$set": bson.M{"last_step": step}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (o *TwoFactor) UseRecoveryCode(ctx context.Context, userID string, hash string) (bool, error) {
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"user_id": userID, "recovery_codes": hash}, bson.M{"$pull": bson.M{"recovery_codes": hash}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (o *TwoFactor) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
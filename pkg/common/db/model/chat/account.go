@@ -60,8 +60,9 @@ func (o *Account) Update(ctx context.Context, userID string, data map[string]any
 	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userID}, bson.M{"$set": data}, false)
 }
 
-func (o *Account) UpdatePassword(ctx context.Context, userId string, password string) error {
-	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userId}, bson.M{"$set": bson.M{"password": password, "change_time": time.Now()}}, false)
+func (o *Account) UpdatePassword(ctx context.Context, userId string, password string, history []string) error {
+	update := bson.M{"password": password, "password_history": history, "change_time": time.Now()}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userId}, bson.M{"$set": update}, false)
 }
 
 func (o *Account) Delete(ctx context.Context, userIDs []string) error {
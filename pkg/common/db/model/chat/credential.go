@@ -2,6 +2,9 @@ package chat
 
 import (
 	"context"
+	"errors"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
@@ -13,13 +16,18 @@ import (
 
 func NewCredential(db *mongo.Database) (chat.CredentialInterface, error) {
 	coll := db.Collection("credential")
+	if err := dropUserTypeIndex(coll); err != nil {
+		return nil, err
+	}
 	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
 		{
+			// one account, phone and email per user, a user may link several oauth identities
 			Keys: bson.D{
 				{Key: "user_id", Value: 1},
 				{Key: "type", Value: 1},
 			},
-			Options: options.Index().SetUnique(true),
+			Options: options.Index().SetName(userTypeIndex).SetUnique(true).
+				SetPartialFilterExpression(bson.M{"type": bson.M{"$lt": constant.CredentialOAuth}}),
 		},
 		{
 			Keys: bson.D{
@@ -34,6 +42,37 @@ func NewCredential(db *mongo.Database) (chat.CredentialInterface, error) {
 	return &Credential{coll: coll}, nil
 }
 
+const userTypeIndex = "user_id_1_type_1"
+
+// dropUserTypeIndex drops the user_id and type index created before oauth credentials, which is
+// unique over every credential type.
+func dropUserTypeIndex(coll *mongo.Collection) error {
+	ctx := context.Background()
+	cur, err := coll.Indexes().List(ctx)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	var indexes []struct {
+		Name                    string `bson:"name"`
+		PartialFilterExpression bson.M `bson:"partialFilterExpression"`
+	}
+	if err := cur.All(ctx, &indexes); err != nil {
+		return errs.Wrap(err)
+	}
+	for _, index := range indexes {
+		if index.Name == userTypeIndex && index.PartialFilterExpression == nil {
+			if _, err := coll.Indexes().DropOne(ctx, userTypeIndex); err != nil {
+				var cmdErr mongo.CommandError
+				if errors.As(err, &cmdErr) && cmdErr.Code == 27 { // IndexNotFound, dropped by another instance
+					return nil
+				}
+				return errs.Wrap(err)
+			}
+		}
+	}
+	return nil
+}
+
 type Credential struct {
 	coll *mongo.Collection
 }
@@ -119,6 +158,18 @@ func (o *Credential) SearchUser(ctx context.Context, keyword string, userIDs []s
 	return mongoutil.FindPage[*chat.Credential](ctx, o.coll, filter, pagination)
 }
 
+func (o *Credential) FindType(ctx context.Context, userID string, credentialType int) ([]*chat.Credential, error) {
+	return mongoutil.Find[*chat.Credential](ctx, o.coll, bson.M{"user_id": userID, "type": credentialType})
+}
+
+func (o *Credential) FindAllType(ctx context.Context, credentialType int) ([]*chat.Credential, error) {
+	return mongoutil.Find[*chat.Credential](ctx, o.coll, bson.M{"type": credentialType})
+}
+
+func (o *Credential) DeleteAccount(ctx context.Context, userID string, account string) error {
+	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"user_id": userID, "account": account})
+}
+
 func (o *Credential) Delete(ctx context.Context, userIDs []string) error {
 	if len(userIDs) == 0 {
 		return nil
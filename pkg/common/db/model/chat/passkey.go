@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewPasskey(db *mongo.Database) (chat.PasskeyInterface, error) {
+	coll := db.Collection("passkey")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "credential_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Passkey{coll: coll}, nil
+}
+
+type Passkey struct {
+	coll *mongo.Collection
+}
+
+func (o *Passkey) Create(ctx context.Context, passkey *chat.Passkey) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.Passkey{passkey})
+}
+
+func (o *Passkey) Take(ctx context.Context, credentialID string) (*chat.Passkey, error) {
+	return mongoutil.FindOne[*chat.Passkey](ctx, o.coll, bson.M{"credential_id": credentialID})
+}
+
+func (o *Passkey) Find(ctx context.Context, userID string) ([]*chat.Passkey, error) {
+	return mongoutil.Find[*chat.Passkey](ctx, o.coll, bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"create_time": 1}))
+}
+
+func (o *Passkey) Use(ctx context.Context, credentialID string, signCount uint32, backupState bool) (bool, error) {
+	filter := bson.M{"credential_id": credentialID}
+	if signCount > 0 {
+		filter["sign_count"] = bson.M{"$lt": signCount}
+	}
+	update := bson.M{"$set": bson.M{"sign_count": signCount, "backup_state": backupState, "last_used_time": time.Now()}}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *Passkey) DeleteCredential(ctx context.Context, userID string, credentialID string) error {
+	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"user_id": userID, "credential_id": credentialID})
+}
+
+func (o *Passkey) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
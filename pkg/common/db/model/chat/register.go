@@ -59,6 +59,10 @@ func (o *Register) CountTotal(ctx context.Context, before *time.Time) (int64, er
 	return mongoutil.Count(ctx, o.coll, filter)
 }
 
+func (o *Register) Find(ctx context.Context, userID string) ([]*chat.Register, error) {
+	return mongoutil.Find[*chat.Register](ctx, o.coll, bson.M{"user_id": userID})
+}
+
 func (o *Register) Delete(ctx context.Context, userIDs []string) error {
 	if len(userIDs) == 0 {
 		return nil
@@ -0,0 +1,106 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserDeletion(db *mongo.Database) (chat.UserDeletionInterface, error) {
+	coll := db.Collection("user_deletion")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "purge_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserDeletion{coll: coll}, nil
+}
+
+type UserDeletion struct {
+	coll *mongo.Collection
+}
+
+func (o *UserDeletion) Set(ctx context.Context, deletion *chat.UserDeletion) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": deletion.UserID}, bson.M{"$set": deletion}, false, options.Update().SetUpsert(true))
+}
+
+func (o *UserDeletion) Take(ctx context.Context, userID string) (*chat.UserDeletion, error) {
+	return mongoutil.FindOne[*chat.UserDeletion](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *UserDeletion) FindDue(ctx context.Context, now time.Time, limit int64) ([]*chat.UserDeletion, error) {
+	opts := options.Find().SetSort(bson.M{"purge_time": 1}).SetLimit(limit)
+	return mongoutil.Find[*chat.UserDeletion](ctx, o.coll, bson.M{"purge_time": bson.M{"$lte": now}}, opts)
+}
+
+func (o *UserDeletion) DeleteDue(ctx context.Context, userID string, now time.Time) (bool, error) {
+	res, err := mongoutil.DeleteOneResult(ctx, o.coll, bson.M{"user_id": userID, "purge_time": bson.M{"$lte": now}})
+	if err != nil {
+		return false, err
+	}
+	return res.DeletedCount == 1, nil
+}
+
+func (o *UserDeletion) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func NewUserTombstone(db *mongo.Database) (chat.UserTombstoneInterface, error) {
+	coll := db.Collection("user_tombstone")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserTombstone{coll: coll}, nil
+}
+
+type UserTombstone struct {
+	coll *mongo.Collection
+}
+
+func (o *UserTombstone) Create(ctx context.Context, tombstone *chat.UserTombstone) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.UserTombstone{tombstone})
+}
+
+func (o *UserTombstone) Take(ctx context.Context, userID string) (*chat.UserTombstone, error) {
+	return mongoutil.FindOne[*chat.UserTombstone](ctx, o.coll, bson.M{"user_id": userID})
+}
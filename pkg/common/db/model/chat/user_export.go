@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserExport(db *mongo.Database) (chat.UserExportInterface, error) {
+	coll := db.Collection("user_export")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "export_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "expire_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserExport{coll: coll}, nil
+}
+
+type UserExport struct {
+	coll *mongo.Collection
+}
+
+func (o *UserExport) Create(ctx context.Context, export *chat.UserExport) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.UserExport{export})
+}
+
+func (o *UserExport) Take(ctx context.Context, exportID string) (*chat.UserExport, error) {
+	return mongoutil.FindOne[*chat.UserExport](ctx, o.coll, bson.M{"export_id": exportID})
+}
+
+func (o *UserExport) TakeLatest(ctx context.Context, userID string) (*chat.UserExport, error) {
+	return mongoutil.FindOne[*chat.UserExport](ctx, o.coll, bson.M{"user_id": userID}, options.FindOne().SetSort(bson.M{"create_time": -1}))
+}
+
+func (o *UserExport) Update(ctx context.Context, exportID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"export_id": exportID}, bson.M{"$set": data}, false)
+}
+
+func (o *UserExport) FindExpired(ctx context.Context, now time.Time, limit int64) ([]*chat.UserExport, error) {
+	return mongoutil.Find[*chat.UserExport](ctx, o.coll, bson.M{"expire_time": bson.M{"$lt": now}}, options.Find().SetLimit(limit))
+}
+
+func (o *UserExport) Delete(ctx context.Context, exportIDs []string) error {
+	if len(exportIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"export_id": bson.M{"$in": exportIDs}})
+}
+
+func (o *UserExport) DeleteByUserID(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"time"
 
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
@@ -118,3 +119,14 @@ func (o *UserLoginRecord) CountRangeEverydayTotal(ctx context.Context, start *ti
 	}
 	return countMap, loginCount, nil
 }
+
+func (o *UserLoginRecord) Find(ctx context.Context, userID string) ([]*chat.UserLoginRecord, error) {
+	return mongoutil.Find[*chat.UserLoginRecord](ctx, o.coll, bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"login_time": -1}))
+}
+
+func (o *UserLoginRecord) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
@@ -143,3 +143,10 @@ func (o *VerifyCode) Delete(ctx context.Context, id string) error {
 	}
 	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"_id": objID})
 }
+
+func (o *VerifyCode) DeleteAccount(ctx context.Context, accounts []string) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"account": bson.M{"$in": accounts}})
+}
@@ -29,6 +29,10 @@ type Admin struct {
 	UserID     string    `bson:"user_id"`
 	Level      int32     `bson:"level"`
 	CreateTime time.Time `bson:"create_time"`
+	// TwoFactorRequired the admin can not log in without TOTP, enrollment is forced on the next login
+	TwoFactorRequired bool `bson:"two_factor_required"`
+	// RoleIDs grant the permissions of a normal admin
+	RoleIDs []string `bson:"role_ids"`
 }
 
 func (Admin) TableName() string {
@@ -43,4 +47,8 @@ type AdminInterface interface {
 	ChangePassword(ctx context.Context, userID string, newPassword string) error
 	Delete(ctx context.Context, userIDs []string) error
 	Search(ctx context.Context, pagination pagination.Pagination) (int64, []*Admin, error)
+	// PullRole removes the roles from the admins that have them.
+	PullRole(ctx context.Context, roleIDs []string) error
+	// SetRoleIfUnset assigns the roles to the normal admins created before roles existed, returning their count.
+	SetRoleIfUnset(ctx context.Context, roleIDs []string) (int64, error)
 }
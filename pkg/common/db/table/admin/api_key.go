@@ -0,0 +1,54 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// APIKey is a long-lived credential of an admin for automation, limited to some routes and
+// optionally to some IPs.
+type APIKey struct {
+	KeyID  string   `bson:"key_id"`
+	UserID string   `bson:"user_id"` // the admin the requests are made as
+	Name   string   `bson:"name"`
+	Hash   string   `bson:"hash"`   // sha256 of the key
+	Prefix string   `bson:"prefix"` // start of the key, to tell the keys apart
+	Routes []string `bson:"routes"` // paths the key is accepted on, a trailing "/*" matches the paths below
+	IPs    []string `bson:"ips"`    // addresses and CIDR blocks the key is accepted from, empty allows all
+	// ExpireTime is zero for keys that do not expire
+	ExpireTime   time.Time `bson:"expire_time"`
+	LastUsedTime time.Time `bson:"last_used_time"`
+	LastUsedIP   string    `bson:"last_used_ip"`
+	CreateTime   time.Time `bson:"create_time"`
+}
+
+func (APIKey) TableName() string {
+	return "admin_api_key"
+}
+
+type APIKeyInterface interface {
+	Create(ctx context.Context, keys []*APIKey) error
+	TakeByHash(ctx context.Context, hash string) (*APIKey, error)
+	Find(ctx context.Context, keyIDs []string) ([]*APIKey, error)
+	// Search finds the keys of the admin, or of all admins when userID is empty, newest first.
+	Search(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*APIKey, error)
+	SetLastUsed(ctx context.Context, keyID string, ip string, lastUsedTime time.Time) error
+	Delete(ctx context.Context, keyIDs []string) error
+	DeleteByUserID(ctx context.Context, userIDs []string) error
+}
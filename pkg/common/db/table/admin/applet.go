@@ -32,6 +32,9 @@ type Applet struct {
 	Priority   uint32    `bson:"priority"`
 	Status     uint8     `bson:"status"`
 	CreateTime time.Time `bson:"create_time"`
+	// AppID is the client id of the applet as an OpenID Connect client
+	ClientSecret string   `bson:"client_secret"` // hashed, empty for public clients
+	RedirectURIs []string `bson:"redirect_uris"`
 }
 
 func (Applet) TableName() string {
@@ -46,4 +49,5 @@ type AppletInterface interface {
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*Applet, error)
 	FindOnShelf(ctx context.Context) ([]*Applet, error)
 	FindID(ctx context.Context, ids []string) ([]*Applet, error)
+	FindAppID(ctx context.Context, appID string) ([]*Applet, error)
 }
@@ -26,4 +26,5 @@ type ApplicationInterface interface {
 	DeleteVersion(ctx context.Context, id []primitive.ObjectID) error
 	PageVersion(ctx context.Context, platforms []string, page pagination.Pagination) (int64, []*Application, error)
 	FindPlatform(ctx context.Context, id []primitive.ObjectID) ([]string, error)
+	FindVersion(ctx context.Context, id []primitive.ObjectID) ([]*Application, error)
 }
@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// AuditLog is an entry of the append-only log of the changes made by admins.
+type AuditLog struct {
+	LogID     string `bson:"log_id"`
+	UserID    string `bson:"user_id"` // the admin, or the chat admin for changes made by the services
+	IP        string `bson:"ip"`
+	Operation string `bson:"operation"`
+	Target    string `bson:"target"`
+	// Before and After are the changed record as JSON, empty when it did not exist
+	Before     string    `bson:"before"`
+	After      string    `bson:"after"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
+
+// AuditJSON encodes a record for Before or After of an AuditLog, empty for nil.
+func AuditJSON(v any) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+type AuditLogInterface interface {
+	Create(ctx context.Context, logs []*AuditLog) error
+	// Search finds the entries newest first, empty arguments and zero times match all.
+	Search(ctx context.Context, userID string, operation string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*AuditLog, error)
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type BanAppealComment struct {
+	UserID     string    `bson:"user_id"` // the admin
+	Content    string    `bson:"content"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// BanAppeal is the request of a blocked user to lift the block, reviewed by the admins.
+type BanAppeal struct {
+	AppealID       string              `bson:"appeal_id"`
+	UserID         string              `bson:"user_id"`
+	BanReason      string              `bson:"ban_reason"` // reason of the block when the appeal was submitted
+	Content        string              `bson:"content"`
+	Status         int32               `bson:"status"` // constant.BanAppealPending, BanAppealApproved or BanAppealRejected
+	Comments       []*BanAppealComment `bson:"comments"`
+	ReviewerUserID string              `bson:"reviewer_user_id"`
+	ReviewTime     time.Time           `bson:"review_time"`
+	CreateTime     time.Time           `bson:"create_time"`
+}
+
+func (BanAppeal) TableName() string {
+	return "ban_appeal"
+}
+
+type BanAppealInterface interface {
+	Create(ctx context.Context, appeal *BanAppeal) error
+	Take(ctx context.Context, appealID string) (*BanAppeal, error)
+	// TakeLatest returns the newest appeal of the user.
+	TakeLatest(ctx context.Context, userID string) (*BanAppeal, error)
+	// Search finds the appeals oldest first, an empty userID and zero status match all.
+	Search(ctx context.Context, userID string, status int32, pagination pagination.Pagination) (int64, []*BanAppeal, error)
+	AddComment(ctx context.Context, appealID string, comment *BanAppealComment) error
+	// Review sets the status of a pending appeal, it returns false when the appeal is not pending.
+	Review(ctx context.Context, appealID string, status int32, reviewerUserID string, reviewTime time.Time) (bool, error)
+	DeleteByUserID(ctx context.Context, userIDs []string) error
+}
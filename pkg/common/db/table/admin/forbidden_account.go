@@ -26,12 +26,16 @@ type ForbiddenAccount struct {
 	Reason         string    `bson:"reason"`
 	OperatorUserID string    `bson:"operator_user_id"`
 	CreateTime     time.Time `bson:"create_time"`
+	// ExpireTime is zero for blocks that do not expire
+	ExpireTime time.Time `bson:"expire_time"`
 }
 
 func (ForbiddenAccount) TableName() string {
 	return "forbidden_accounts"
 }
 
+// ForbiddenAccountInterface only returns the blocks that have not expired, the expired ones are
+// deleted with DeleteExpired.
 type ForbiddenAccountInterface interface {
 	Create(ctx context.Context, ms []*ForbiddenAccount) error
 	Take(ctx context.Context, userID string) (*ForbiddenAccount, error)
@@ -39,4 +43,8 @@ type ForbiddenAccountInterface interface {
 	Find(ctx context.Context, userIDs []string) ([]*ForbiddenAccount, error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*ForbiddenAccount, error)
 	FindAllIDs(ctx context.Context) ([]string, error)
+	// FindExpired returns up to limit blocks that expired before now.
+	FindExpired(ctx context.Context, now time.Time, limit int64) ([]*ForbiddenAccount, error)
+	// DeleteExpired deletes the blocks of the users that expired before now.
+	DeleteExpired(ctx context.Context, userIDs []string, now time.Time) error
 }
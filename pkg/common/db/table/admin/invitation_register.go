@@ -35,6 +35,8 @@ type InvitationRegisterInterface interface {
 	Del(ctx context.Context, codes []string) error
 	Create(ctx context.Context, v []*InvitationRegister) error
 	Take(ctx context.Context, code string) (*InvitationRegister, error)
+	// FindUsedBy returns the codes the user registered with.
+	FindUsedBy(ctx context.Context, userID string) ([]*InvitationRegister, error)
 	Update(ctx context.Context, code string, data map[string]any) error
 	Search(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, pagination pagination.Pagination) (int64, []*InvitationRegister, error)
 }
@@ -21,7 +21,9 @@ import (
 )
 
 type IPForbidden struct {
-	IP            string    `bson:"ip"`
+	IP            string    `bson:"ip"` // address or CIDR block
+	RangeStart    string    `bson:"range_start"`
+	RangeEnd      string    `bson:"range_end"`
 	LimitRegister bool      `bson:"limit_register"`
 	LimitLogin    bool      `bson:"limit_login"`
 	CreateTime    time.Time `bson:"create_time"`
@@ -34,6 +36,8 @@ func (IPForbidden) IPForbidden() string {
 type IPForbiddenInterface interface {
 	Take(ctx context.Context, ip string) (*IPForbidden, error)
 	Find(ctx context.Context, ips []string) ([]*IPForbidden, error)
+	// Match returns the entries whose address or CIDR block contains ip.
+	Match(ctx context.Context, ip string) ([]*IPForbidden, error)
 	Search(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*IPForbidden, error)
 	Create(ctx context.Context, ms []*IPForbidden) error
 	Delete(ctx context.Context, ips []string) error
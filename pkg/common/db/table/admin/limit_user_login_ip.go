@@ -22,7 +22,9 @@ import (
 
 type LimitUserLoginIP struct {
 	UserID     string    `bson:"user_id"`
-	IP         string    `bson:"ip"`
+	IP         string    `bson:"ip"` // address or CIDR block
+	RangeStart string    `bson:"range_start"`
+	RangeEnd   string    `bson:"range_end"`
 	CreateTime time.Time `bson:"create_time"`
 }
 
@@ -33,7 +35,10 @@ func (LimitUserLoginIP) TableName() string {
 type LimitUserLoginIPInterface interface {
 	Create(ctx context.Context, ms []*LimitUserLoginIP) error
 	Delete(ctx context.Context, ms []*LimitUserLoginIP) error
+	DeleteByUserID(ctx context.Context, userIDs []string) error
 	Count(ctx context.Context, userID string) (uint32, error)
 	Take(ctx context.Context, userID string, ip string) (*LimitUserLoginIP, error)
+	// Match returns an entry of the user whose address or CIDR block contains ip.
+	Match(ctx context.Context, userID string, ip string) (*LimitUserLoginIP, error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*LimitUserLoginIP, error)
 }
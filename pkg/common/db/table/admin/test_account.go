@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// TestAccount is a phone number or email that logs in with a fixed verify code,
+// for QA and app store reviewers.
+type TestAccount struct {
+	Account    string    `bson:"account"` // email, or area code and phone number joined by a space
+	Code       string    `bson:"code"`
+	Remark     string    `bson:"remark"`
+	ExpireTime time.Time `bson:"expire_time"` // zero never expires
+	CreateTime time.Time `bson:"create_time"`
+}
+
+func (TestAccount) TableName() string {
+	return "test_account"
+}
+
+// Valid reports whether the account can still be used at now.
+func (t *TestAccount) Valid(now time.Time) bool {
+	return t.ExpireTime.IsZero() || now.Before(t.ExpireTime)
+}
+
+type TestAccountInterface interface {
+	// Set creates the accounts or replaces the code, remark and expiry of existing ones.
+	Set(ctx context.Context, ts []*TestAccount) error
+	Take(ctx context.Context, account string) (*TestAccount, error)
+	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*TestAccount, error)
+	Delete(ctx context.Context, accounts []string) error
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// TwoFactor TOTP enrollment of an account
+type TwoFactor struct {
+	UserID        string    `bson:"user_id"`
+	Secret        string    `bson:"secret"`
+	Enabled       bool      `bson:"enabled"`
+	RecoveryCodes []string  `bson:"recovery_codes"` // sha256 of the unused recovery codes
+	LastStep      int64     `bson:"last_step"`      // last accepted time step, a code is never accepted twice
+	CreateTime    time.Time `bson:"create_time"`
+	EnableTime    time.Time `bson:"enable_time"`
+}
+
+func (TwoFactor) TableName() string {
+	return "admin_two_factor"
+}
+
+type TwoFactorInterface interface {
+	Set(ctx context.Context, twoFactor *TwoFactor) error
+	Take(ctx context.Context, userID string) (*TwoFactor, error)
+	Update(ctx context.Context, userID string, data map[string]any) error
+	UseStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userID string, hash string) (bool, error)
+	Delete(ctx context.Context, userIDs []string) error
+}
@@ -20,11 +20,12 @@ import (
 )
 
 type Account struct {
-	UserID         string    `bson:"user_id"`
-	Password       string    `bson:"password"`
-	CreateTime     time.Time `bson:"create_time"`
-	ChangeTime     time.Time `bson:"change_time"`
-	OperatorUserID string    `bson:"operator_user_id"`
+	UserID          string    `bson:"user_id"`
+	Password        string    `bson:"password"`
+	PasswordHistory []string  `bson:"password_history"` // previous password hashes, latest first
+	CreateTime      time.Time `bson:"create_time"`
+	ChangeTime      time.Time `bson:"change_time"`
+	OperatorUserID  string    `bson:"operator_user_id"`
 }
 
 func (Account) TableName() string {
@@ -35,6 +36,7 @@ type AccountInterface interface {
 	Create(ctx context.Context, accounts ...*Account) error
 	Take(ctx context.Context, userId string) (*Account, error)
 	Update(ctx context.Context, userID string, data map[string]any) error
-	UpdatePassword(ctx context.Context, userId string, password string) error
+	// UpdatePassword replaces the password and the previous passwords, the change time is reset.
+	UpdatePassword(ctx context.Context, userId string, password string, history []string) error
 	Delete(ctx context.Context, userIDs []string) error
 }
@@ -27,6 +27,9 @@ type CredentialInterface interface {
 	Take(ctx context.Context, userID string) (*Credential, error)
 	SearchNormalUser(ctx context.Context, keyword string, forbiddenID []string, pagination pagination.Pagination) (int64, []*Credential, error)
 	SearchUser(ctx context.Context, keyword string, userIDs []string, pagination pagination.Pagination) (int64, []*Credential, error)
+	FindType(ctx context.Context, userID string, credentialType int) ([]*Credential, error)
+	FindAllType(ctx context.Context, credentialType int) ([]*Credential, error)
 	Delete(ctx context.Context, userIDs []string) error
+	DeleteAccount(ctx context.Context, userID string, account string) error
 	DeleteByUserIDType(ctx context.Context, credentials ...*Credential) error
 }
@@ -0,0 +1,51 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// Passkey is a WebAuthn credential of a user.
+type Passkey struct {
+	CredentialID    string    `bson:"credential_id"` // base64url of the raw credential id
+	UserID          string    `bson:"user_id"`
+	Name            string    `bson:"name"`
+	PublicKey       []byte    `bson:"public_key"` // COSE encoded
+	AttestationType string    `bson:"attestation_type"`
+	Transports      []string  `bson:"transports"`
+	AAGUID          []byte    `bson:"aaguid"`
+	SignCount       uint32    `bson:"sign_count"`
+	BackupEligible  bool      `bson:"backup_eligible"`
+	BackupState     bool      `bson:"backup_state"`
+	CreateTime      time.Time `bson:"create_time"`
+	LastUsedTime    time.Time `bson:"last_used_time"`
+}
+
+func (Passkey) TableName() string {
+	return "passkeys"
+}
+
+type PasskeyInterface interface {
+	Create(ctx context.Context, passkey *Passkey) error
+	Take(ctx context.Context, credentialID string) (*Passkey, error)
+	Find(ctx context.Context, userID string) ([]*Passkey, error)
+	// Use records a login, only when signCount is 0 or above the stored count, so a cloned authenticator
+	// replaying an older count is rejected.
+	Use(ctx context.Context, credentialID string, signCount uint32, backupState bool) (bool, error)
+	DeleteCredential(ctx context.Context, userID string, credentialID string) error
+	Delete(ctx context.Context, userIDs []string) error
+}
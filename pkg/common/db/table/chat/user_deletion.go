@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// UserDeletion is a deletion requested by the user, the user is purged at PurgeTime unless they log in before.
+type UserDeletion struct {
+	UserID     string    `bson:"user_id"`
+	Reason     string    `bson:"reason"`
+	CreateTime time.Time `bson:"create_time"`
+	PurgeTime  time.Time `bson:"purge_time"`
+}
+
+func (UserDeletion) TableName() string {
+	return "user_deletions"
+}
+
+// UserTombstone is what is left of a purged user, nothing in it identifies the person.
+type UserTombstone struct {
+	UserID       string    `bson:"user_id"`
+	RegisterTime time.Time `bson:"register_time"`
+	RequestTime  time.Time `bson:"request_time"`
+	DeleteTime   time.Time `bson:"delete_time"`
+}
+
+func (UserTombstone) TableName() string {
+	return "user_tombstones"
+}
+
+type UserDeletionInterface interface {
+	// Set creates the request of the user or replaces it.
+	Set(ctx context.Context, deletion *UserDeletion) error
+	Take(ctx context.Context, userID string) (*UserDeletion, error)
+	// FindDue returns up to limit requests whose purge time is not after now, the oldest first.
+	FindDue(ctx context.Context, now time.Time, limit int64) ([]*UserDeletion, error)
+	// DeleteDue deletes the request of the user if its purge time is not after now, false when there was none.
+	DeleteDue(ctx context.Context, userID string, now time.Time) (bool, error)
+	Delete(ctx context.Context, userIDs []string) error
+}
+
+type UserTombstoneInterface interface {
+	Create(ctx context.Context, tombstone *UserTombstone) error
+	Take(ctx context.Context, userID string) (*UserTombstone, error)
+}
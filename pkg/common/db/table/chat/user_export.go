@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// UserExport is a personal data export of a user, the archive is a file named after ExportID.
+type UserExport struct {
+	ExportID   string    `bson:"export_id"`
+	UserID     string    `bson:"user_id"`
+	Status     int32     `bson:"status"` // constant.UserExportPending, UserExportReady or UserExportFailed
+	Size       int64     `bson:"size"`
+	CreateTime time.Time `bson:"create_time"`
+	FinishTime time.Time `bson:"finish_time"`
+	ExpireTime time.Time `bson:"expire_time"`
+}
+
+func (UserExport) TableName() string {
+	return "user_exports"
+}
+
+type UserExportInterface interface {
+	Create(ctx context.Context, export *UserExport) error
+	Take(ctx context.Context, exportID string) (*UserExport, error)
+	// TakeLatest returns the newest export of the user.
+	TakeLatest(ctx context.Context, userID string) (*UserExport, error)
+	Update(ctx context.Context, exportID string, data map[string]any) error
+	// FindExpired returns up to limit exports that expired before now.
+	FindExpired(ctx context.Context, now time.Time, limit int64) ([]*UserExport, error)
+	Delete(ctx context.Context, exportIDs []string) error
+	DeleteByUserID(ctx context.Context, userIDs []string) error
+}
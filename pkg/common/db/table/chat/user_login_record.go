@@ -35,4 +35,7 @@ type UserLoginRecordInterface interface {
 	Create(ctx context.Context, records ...*UserLoginRecord) error
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
 	CountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
+	// Find returns the login records of the user, the latest first.
+	Find(ctx context.Context, userID string) ([]*UserLoginRecord, error)
+	Delete(ctx context.Context, userIDs []string) error
 }
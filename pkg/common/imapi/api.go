@@ -15,6 +15,7 @@
 package imapi
 
 import (
+	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/protocol/relation"
@@ -43,4 +44,5 @@ var (
 	importFriend  = NewApiCaller[relation.ImportFriendReq, relation.ImportFriendResp]("/friend/import_friend")
 
 	sendSimpleMsg = NewApiCaller[SendSingleMsgReq, SendSingleMsgResp]("/msg/send_simple_msg")
+	sendMsg       = NewApiCaller[apistruct.SendMsgReq, SendMsgResp]("/msg/send_msg")
 )
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/chat/pkg/botstruct"
+	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/constant"
@@ -32,12 +33,14 @@ type CallerInterface interface {
 	UpdateNotificationAccount(ctx context.Context, req *user.UpdateNotificationAccountInfoReq) error
 
 	ForceOffLine(ctx context.Context, userID string) error
+	ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error
 	RegisterUser(ctx context.Context, users []*sdkws.UserInfo) error
 	FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error)
 	UserRegisterCount(ctx context.Context, start int64, end int64) (map[string]int64, int64, error)
 	FriendUserIDs(ctx context.Context, userID string) ([]string, error)
 	AccountCheckSingle(ctx context.Context, userID string) (bool, error)
 	SendSimpleMsg(ctx context.Context, req *SendSingleMsgReq, key string) error
+	SendMsg(ctx context.Context, req *apistruct.SendMsgReq) error
 }
 
 type authToken struct {
@@ -180,6 +183,14 @@ func (c *Caller) ForceOffLine(ctx context.Context, userID string) error {
 	return nil
 }
 
+func (c *Caller) ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error {
+	_, err := forceOffLine.Call(ctx, c.imApi, &auth.ForceLogoutReq{
+		PlatformID: platformID,
+		UserID:     userID,
+	})
+	return err
+}
+
 func (c *Caller) FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error) {
 	resp, err := getGroupsInfo.Call(ctx, c.imApi, &group.GetGroupsInfoReq{
 		GroupIDs: groupIDs,
@@ -226,6 +237,11 @@ func (c *Caller) SendSimpleMsg(ctx context.Context, req *SendSingleMsgReq, key s
 	return err
 }
 
+func (c *Caller) SendMsg(ctx context.Context, req *apistruct.SendMsgReq) error {
+	_, err := sendMsg.Call(ctx, c.imApi, req)
+	return err
+}
+
 func (c *Caller) AddNotificationAccount(ctx context.Context, req *user.AddNotificationAccountReq) error {
 	_, err := addNotificationAccount.Call(ctx, c.imApi, req)
 	return err
@@ -11,3 +11,5 @@ type SendSingleMsgReq struct {
 	Ex              string                 `json:"ex"`
 }
 type SendSingleMsgResp struct{}
+
+type SendMsgResp struct{}
@@ -67,6 +67,18 @@ func CheckAdmin(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
+// CheckAdminToken is CheckAdmin, but refuses an admin authenticated by an API key.
+func CheckAdminToken(ctx context.Context) (string, error) {
+	userID, err := CheckAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+	if IsAPIKey(ctx) {
+		return "", errs.ErrNoPermission.WrapMsg("not allowed with an api key")
+	}
+	return userID, nil
+}
+
 func CheckUser(ctx context.Context) (string, error) {
 	userID, userType, err := Check(ctx)
 	if err != nil {
@@ -107,6 +119,21 @@ func GetOpUserID(ctx context.Context) string {
 	return userID
 }
 
+// GetOpIP returns the ip of the admin that made the request, empty if unknown.
+func GetOpIP(ctx context.Context) string {
+	ip, _ := ctx.Value(constant.RpcOpIP).([]string)
+	if len(ip) == 0 {
+		return ""
+	}
+	return ip[0]
+}
+
+// IsAPIKey reports whether the admin making the request is authenticated by an API key.
+func IsAPIKey(ctx context.Context) bool {
+	v, _ := ctx.Value(constant.RpcOpAPIKey).([]string)
+	return len(v) > 0
+}
+
 func GetUserType(ctx context.Context) (int, error) {
 	userTypeArr, _ := ctx.Value(constant.RpcOpUserType).([]string)
 	userType, err := strconv.Atoi(userTypeArr[0])
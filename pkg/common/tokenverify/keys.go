@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+)
+
+// SigningKey is an asymmetric key identified by the kid header of the tokens it signs.
+type SigningKey struct {
+	ID     string
+	Method jwt.SigningMethod
+	// private is nil for keys that only verify tokens
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// NewSigningKey parses a PEM encoded RSA (RS256) or Ed25519 (EdDSA) private key.
+func NewSigningKey(id string, pemData []byte) (*SigningKey, error) {
+	if id == "" {
+		return nil, errs.ErrArgs.WrapMsg("signing key id is empty")
+	}
+	if rsaKey, err := jwt.ParseRSAPrivateKeyFromPEM(pemData); err == nil {
+		if rsaKey.N.BitLen() < 2048 {
+			return nil, errs.ErrArgs.WrapMsg("rsa signing key must be at least 2048 bits", "kid", id)
+		}
+		return &SigningKey{ID: id, Method: jwt.SigningMethodRS256, private: rsaKey, public: &rsaKey.PublicKey}, nil
+	}
+	edKey, err := jwt.ParseEdPrivateKeyFromPEM(pemData)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("signing key must be a PEM encoded RSA or Ed25519 private key", "kid", id)
+	}
+	signer, ok := edKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("unsupported signing key type", "kid", id)
+	}
+	return &SigningKey{ID: id, Method: jwt.SigningMethodEdDSA, private: signer, public: signer.Public()}, nil
+}
+
+// JWK is a public key in the JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func (k *SigningKey) JWK() JWK {
+	jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
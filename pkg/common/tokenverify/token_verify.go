@@ -15,6 +15,11 @@
 package tokenverify
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -35,16 +40,53 @@ type claims struct {
 }
 
 type Token struct {
-	Expires time.Duration
-	Secret  string
+	Expires        time.Duration // access token
+	RefreshExpires time.Duration // sliding, extended on every refresh
+	Secret         string        // HS256, signs new tokens when there is no active signing key
+	// SigningKeys verify tokens by their kid header, so keys rotated out of ActiveKeyID keep
+	// verifying outstanding tokens until they are removed.
+	SigningKeys []*SigningKey
+	ActiveKeyID string
+}
+
+func (t *Token) signingKey(kid string) *SigningKey {
+	for _, key := range t.SigningKeys {
+		if key.ID == kid {
+			return key
+		}
+	}
+	return nil
 }
 
 func (t *Token) secret() jwt.Keyfunc {
 	return func(token *jwt.Token) (any, error) {
-		return []byte(t.Secret), nil
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			if t.Secret == "" || token.Method != jwt.SigningMethodHS256 {
+				return nil, errs.ErrTokenUnknown.WrapMsg("unexpected signing method", "alg", token.Method.Alg())
+			}
+			return []byte(t.Secret), nil
+		}
+		key := t.signingKey(kid)
+		if key == nil {
+			return nil, errs.ErrTokenUnknown.WrapMsg("unknown signing key", "kid", kid)
+		}
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, errs.ErrTokenUnknown.WrapMsg("unexpected signing method", "alg", token.Method.Alg(), "kid", kid)
+		}
+		return key.public, nil
 	}
 }
 
+// JWKS returns the public keys that verify tokens, HS256 tokens can only be verified with the secret.
+func (t *Token) JWKS() JWKS {
+	keys := make([]JWK, 0, len(t.SigningKeys))
+	for _, key := range t.SigningKeys {
+		keys = append(keys, key.JWK())
+	}
+	return JWKS{Keys: keys}
+}
+
 func (t *Token) buildClaims(userID string, userType int32) claims {
 	now := time.Now()
 	return claims{
@@ -90,14 +132,45 @@ func (t *Token) CreateToken(UserID string, userType int32) (string, time.Duratio
 	if !(userType == TokenUser || userType == TokenAdmin) {
 		return "", 0, errs.ErrTokenUnknown.WrapMsg("token type unknown")
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, t.buildClaims(UserID, userType))
-	str, err := token.SignedString([]byte(t.Secret))
+	var (
+		token *jwt.Token
+		key   any
+	)
+	if t.ActiveKeyID == "" {
+		token = jwt.NewWithClaims(jwt.SigningMethodHS256, t.buildClaims(UserID, userType))
+		key = []byte(t.Secret)
+	} else {
+		signingKey := t.signingKey(t.ActiveKeyID)
+		if signingKey == nil || signingKey.private == nil {
+			return "", 0, errs.ErrInternalServer.WrapMsg("active signing key not found", "kid", t.ActiveKeyID)
+		}
+		token = jwt.NewWithClaims(signingKey.Method, t.buildClaims(UserID, userType))
+		token.Header["kid"] = signingKey.ID
+		key = signingKey.private
+	}
+	str, err := token.SignedString(key)
 	if err != nil {
 		return "", 0, errs.Wrap(err)
 	}
 	return str, t.Expires, nil
 }
 
+// SignClaims signs claims for other parties, such as OpenID Connect id tokens, with the active signing key.
+// They verify it with the JWKS, so there must be an active asymmetric key.
+func (t *Token) SignClaims(claims jwt.Claims) (string, error) {
+	signingKey := t.signingKey(t.ActiveKeyID)
+	if t.ActiveKeyID == "" || signingKey == nil || signingKey.private == nil {
+		return "", errs.ErrInternalServer.WrapMsg("no active signing key")
+	}
+	token := jwt.NewWithClaims(signingKey.Method, claims)
+	token.Header["kid"] = signingKey.ID
+	str, err := token.SignedString(signingKey.private)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return str, nil
+}
+
 func (t *Token) GetToken(token string) (string, int32, error) {
 	userID, userType, err := t.getToken(token)
 	if err != nil {
@@ -109,6 +182,37 @@ func (t *Token) GetToken(token string) (string, int32, error) {
 	return userID, userType, nil
 }
 
+// NewRefreshTokenFamily returns the ID shared by the chain of refresh tokens rotated from one login.
+func NewRefreshTokenFamily() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// NewRefreshToken returns an opaque refresh token of the family, only its hash is stored.
+func NewRefreshToken(familyID string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return familyID + "." + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func ParseRefreshToken(refreshToken string) (string, error) {
+	familyID, _, ok := strings.Cut(refreshToken, ".")
+	if !ok || familyID == "" {
+		return "", errs.ErrTokenMalformed.WrapMsg("invalid refresh token")
+	}
+	return familyID, nil
+}
+
+func HashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
 //func (t *Token) GetAdminTokenCache(token string) (string, error) {
 //	userID, userType, err := getToken(token)
 //	if err != nil {
@@ -0,0 +1,214 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func rsaPEM(t *testing.T, bits int) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func ed25519PEM(t *testing.T) []byte {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func newTestKey(t *testing.T, id string, pemData []byte) *SigningKey {
+	t.Helper()
+	key, err := NewSigningKey(id, pemData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// signTestToken signs valid claims of a user with method and key, kid is left out when empty.
+func signTestToken(t *testing.T, method jwt.SigningMethod, kid string, key any) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, (&Token{Expires: time.Hour}).buildClaims("user1", TokenUser))
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	str, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return str
+}
+
+func TestSigningKeyRoundTrip(t *testing.T) {
+	keys := []*SigningKey{
+		newTestKey(t, "rsa", rsaPEM(t, 2048)),
+		newTestKey(t, "ed", ed25519PEM(t)),
+	}
+	algs := map[string]string{"rsa": "RS256", "ed": "EdDSA"}
+	for _, key := range keys {
+		if key.Method.Alg() != algs[key.ID] {
+			t.Errorf("%s: alg = %s, want %s", key.ID, key.Method.Alg(), algs[key.ID])
+		}
+		tk := &Token{Expires: time.Hour, SigningKeys: keys, ActiveKeyID: key.ID}
+		str, _, err := tk.CreateToken("user1", TokenAdmin)
+		if err != nil {
+			t.Fatalf("%s: %v", key.ID, err)
+		}
+		parsed, _, err := new(jwt.Parser).ParseUnverified(str, &claims{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed.Header["kid"] != key.ID || parsed.Method.Alg() != algs[key.ID] {
+			t.Errorf("%s: header = %v", key.ID, parsed.Header)
+		}
+		userID, userType, err := tk.GetToken(str)
+		if err != nil || userID != "user1" || userType != TokenAdmin {
+			t.Errorf("%s: GetToken = %q, %d, %v", key.ID, userID, userType, err)
+		}
+	}
+}
+
+func TestRotatedKeyStillVerifies(t *testing.T) {
+	old := newTestKey(t, "old", ed25519PEM(t))
+	str, _, err := (&Token{Expires: time.Hour, SigningKeys: []*SigningKey{old}, ActiveKeyID: "old"}).CreateToken("user1", TokenUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated := &Token{Expires: time.Hour, SigningKeys: []*SigningKey{newTestKey(t, "new", ed25519PEM(t)), old}, ActiveKeyID: "new"}
+	if userID, _, err := rotated.GetToken(str); err != nil || userID != "user1" {
+		t.Errorf("token of the rotated out key = %q, %v", userID, err)
+	}
+	removed := &Token{Expires: time.Hour, SigningKeys: rotated.SigningKeys[:1], ActiveKeyID: "new"}
+	if _, _, err := removed.GetToken(str); err == nil {
+		t.Error("token of a removed key is accepted")
+	}
+}
+
+func TestRejectUnknownKid(t *testing.T) {
+	signer, err := jwt.ParseEdPrivateKeyFromPEM(ed25519PEM(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk := &Token{Expires: time.Hour, Secret: "secret", SigningKeys: []*SigningKey{newTestKey(t, "ed", ed25519PEM(t))}}
+	if _, _, err := tk.GetToken(signTestToken(t, jwt.SigningMethodEdDSA, "other", signer)); err == nil {
+		t.Error("token with an unknown kid is accepted")
+	}
+	// a kid never falls back to the secret
+	if _, _, err := tk.GetToken(signTestToken(t, jwt.SigningMethodHS256, "other", []byte("secret"))); err == nil {
+		t.Error("HS256 token with an unknown kid is accepted")
+	}
+}
+
+func TestRejectAlgOfOtherKey(t *testing.T) {
+	rsaKey := newTestKey(t, "rsa", rsaPEM(t, 2048))
+	edKey := newTestKey(t, "ed", ed25519PEM(t))
+	tk := &Token{Expires: time.Hour, Secret: "secret", SigningKeys: []*SigningKey{rsaKey, edKey}}
+
+	// HS256 keyed with the public key, which is published in the JWKS
+	der, err := x509.MarshalPKIXPublicKey(rsaKey.public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	tokens := map[string]string{
+		"HS256 with the rsa public key": signTestToken(t, jwt.SigningMethodHS256, "rsa", publicPEM),
+		"HS256 with the secret":         signTestToken(t, jwt.SigningMethodHS256, "rsa", []byte("secret")),
+		"EdDSA under the rsa kid":       signTestToken(t, jwt.SigningMethodEdDSA, "rsa", edKey.private),
+		"RS256 under the ed kid":        signTestToken(t, jwt.SigningMethodRS256, "ed", rsaKey.private),
+	}
+	for name, str := range tokens {
+		if _, _, err := tk.GetToken(str); err == nil {
+			t.Errorf("%s is accepted", name)
+		}
+	}
+}
+
+func TestHS256WithoutKid(t *testing.T) {
+	str := signTestToken(t, jwt.SigningMethodHS256, "", []byte("secret"))
+	if userID, _, err := (&Token{Expires: time.Hour, Secret: "secret"}).GetToken(str); err != nil || userID != "user1" {
+		t.Errorf("HS256 token with the secret = %q, %v", userID, err)
+	}
+	// keys without a secret only accept tokens of the keys
+	noSecret := &Token{Expires: time.Hour, SigningKeys: []*SigningKey{newTestKey(t, "ed", ed25519PEM(t))}, ActiveKeyID: "ed"}
+	for _, key := range [][]byte{[]byte("secret"), []byte("")} {
+		if _, _, err := noSecret.GetToken(signTestToken(t, jwt.SigningMethodHS256, "", key)); err == nil {
+			t.Errorf("HS256 token signed with %q is accepted without a secret", key)
+		}
+	}
+	// only HS256 is accepted without a kid
+	if _, _, err := (&Token{Expires: time.Hour, Secret: "secret"}).GetToken(signTestToken(t, jwt.SigningMethodHS512, "", []byte("secret"))); err == nil {
+		t.Error("HS512 token is accepted")
+	}
+}
+
+func TestNewSigningKeyRejects(t *testing.T) {
+	if _, err := NewSigningKey("small", rsaPEM(t, 1024)); err == nil {
+		t.Error("1024 bit rsa key is accepted")
+	}
+	if _, err := NewSigningKey("", ed25519PEM(t)); err == nil {
+		t.Error("key without id is accepted")
+	}
+	if _, err := NewSigningKey("bad", []byte("not a key")); err == nil {
+		t.Error("invalid pem is accepted")
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	rsaKey := newTestKey(t, "rsa", rsaPEM(t, 2048))
+	edKey := newTestKey(t, "ed", ed25519PEM(t))
+	jwks := (&Token{Secret: "secret", SigningKeys: []*SigningKey{rsaKey, edKey}, ActiveKeyID: "ed"}).JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("JWKS has %d keys, want 2", len(jwks.Keys))
+	}
+	rsaJWK, edJWK := jwks.Keys[0], jwks.Keys[1]
+	if rsaJWK.Kty != "RSA" || rsaJWK.Kid != "rsa" || rsaJWK.Alg != "RS256" || rsaJWK.Use != "sig" {
+		t.Errorf("rsa jwk = %+v", rsaJWK)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(rsaJWK.N)
+	if err != nil || string(n) != string(rsaKey.public.(*rsa.PublicKey).N.Bytes()) {
+		t.Errorf("rsa jwk modulus does not match the key")
+	}
+	if rsaJWK.E != "AQAB" {
+		t.Errorf("rsa jwk exponent = %q, want AQAB", rsaJWK.E)
+	}
+	if edJWK.Kty != "OKP" || edJWK.Crv != "Ed25519" || edJWK.Kid != "ed" || edJWK.Alg != "EdDSA" {
+		t.Errorf("ed25519 jwk = %+v", edJWK)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(edJWK.X)
+	if err != nil || string(x) != string(edKey.public.(ed25519.PublicKey)) {
+		t.Errorf("ed25519 jwk x does not match the key")
+	}
+}
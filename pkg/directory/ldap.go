@@ -0,0 +1,252 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directory
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/openimsdk/tools/errs"
+)
+
+// ErrInvalidCredentials is returned when the directory rejects the username or password of a user.
+var ErrInvalidCredentials = errors.New("invalid directory credentials")
+
+// uacAccountDisable is the ACCOUNTDISABLE flag of the Active Directory userAccountControl attribute.
+const uacAccountDisable = 0x2
+
+const pagingSize = 500
+
+type Config struct {
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	BindDN             string
+	BindPassword       string
+	BaseDN             string
+	// UserFilter finds a user by name, {username} is replaced by the escaped name.
+	UserFilter string
+	// MemberFilter finds the members of a group, {group} is replaced by the escaped group DN.
+	MemberFilter string
+	Attributes   Attributes
+	Timeout      time.Duration
+}
+
+// Attributes names the directory attributes of the user fields.
+type Attributes struct {
+	Username string
+	Nickname string
+	Email    string
+}
+
+// Entry is a user of the directory.
+type Entry struct {
+	DN       string
+	Username string
+	Nickname string
+	Email    string
+	Disabled bool
+}
+
+type Directory struct {
+	conf Config
+}
+
+func New(conf Config) (*Directory, error) {
+	if conf.URL == "" || conf.BaseDN == "" {
+		return nil, errs.New("ldap url and baseDN must be set")
+	}
+	if !strings.Contains(conf.UserFilter, "{username}") {
+		return nil, errs.New("ldap userFilter must contain {username}", "userFilter", conf.UserFilter)
+	}
+	if conf.MemberFilter != "" && !strings.Contains(conf.MemberFilter, "{group}") {
+		return nil, errs.New("ldap memberFilter must contain {group}", "memberFilter", conf.MemberFilter)
+	}
+	if conf.Attributes.Username == "" {
+		return nil, errs.New("ldap username attribute must be set")
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	return &Directory{conf: conf}, nil
+}
+
+func (d *Directory) dial(ctx context.Context) (*ldap.Conn, error) {
+	u, err := url.Parse(d.conf.URL)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid ldap url", "url", d.conf.URL)
+	}
+	tlsConfig := &tls.Config{ServerName: u.Hostname(), InsecureSkipVerify: d.conf.InsecureSkipVerify}
+	timeout := d.conf.Timeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+	conn, err := ldap.DialURL(d.conf.URL, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "dial ldap failed", "url", d.conf.URL)
+	}
+	conn.SetTimeout(timeout)
+	if d.conf.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, errs.WrapMsg(err, "ldap start tls failed")
+		}
+	}
+	if err := conn.Bind(d.conf.BindDN, d.conf.BindPassword); err != nil {
+		_ = conn.Close()
+		return nil, errs.WrapMsg(err, "ldap service bind failed", "bindDN", d.conf.BindDN)
+	}
+	return conn, nil
+}
+
+func (d *Directory) attributes() []string {
+	attrs := []string{"dn", d.conf.Attributes.Username, "userAccountControl"}
+	if d.conf.Attributes.Nickname != "" {
+		attrs = append(attrs, d.conf.Attributes.Nickname)
+	}
+	if d.conf.Attributes.Email != "" {
+		attrs = append(attrs, d.conf.Attributes.Email)
+	}
+	return attrs
+}
+
+func (d *Directory) entry(e *ldap.Entry) *Entry {
+	entry := &Entry{
+		DN:       e.DN,
+		Username: strings.ToLower(e.GetAttributeValue(d.conf.Attributes.Username)),
+	}
+	if d.conf.Attributes.Nickname != "" {
+		entry.Nickname = e.GetAttributeValue(d.conf.Attributes.Nickname)
+	}
+	if d.conf.Attributes.Email != "" {
+		entry.Email = e.GetAttributeValue(d.conf.Attributes.Email)
+	}
+	if uac, err := strconv.ParseInt(e.GetAttributeValue("userAccountControl"), 10, 64); err == nil {
+		entry.Disabled = uac&uacAccountDisable != 0
+	}
+	return entry
+}
+
+func (d *Directory) search(conn *ldap.Conn, filter string, sizeLimit int) ([]*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(d.conf.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, sizeLimit, int(d.conf.Timeout/time.Second), false, filter, d.attributes(), nil)
+	var (
+		res *ldap.SearchResult
+		err error
+	)
+	if sizeLimit > 0 {
+		res, err = conn.Search(req)
+	} else {
+		res, err = conn.SearchWithPaging(req, pagingSize)
+	}
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, errs.WrapMsg(err, "ldap search failed", "filter", filter)
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.Entries, nil
+}
+
+// Authenticate binds as the user found by the username, so the password is checked by the directory.
+func (d *Directory) Authenticate(ctx context.Context, username string, password string) (*Entry, error) {
+	if username == "" || password == "" {
+		// an empty password would be an unauthenticated bind, which most servers accept
+		return nil, errs.Wrap(ErrInvalidCredentials)
+	}
+	conn, err := d.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	filter := strings.ReplaceAll(d.conf.UserFilter, "{username}", ldap.EscapeFilter(username))
+	entries, err := d.search(conn, filter, 2)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, errs.Wrap(ErrInvalidCredentials)
+	}
+	entry := d.entry(entries[0])
+	if entry.Disabled {
+		return nil, errs.Wrap(ErrInvalidCredentials)
+	}
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, errs.Wrap(ErrInvalidCredentials)
+		}
+		return nil, errs.WrapMsg(err, "ldap user bind failed", "dn", entry.DN)
+	}
+	return entry, nil
+}
+
+// GroupMembers returns the users of a group, disabled users included.
+func (d *Directory) GroupMembers(ctx context.Context, groupDN string) ([]*Entry, error) {
+	if d.conf.MemberFilter == "" {
+		return nil, errs.New("ldap memberFilter is not configured")
+	}
+	conn, err := d.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	filter := strings.ReplaceAll(d.conf.MemberFilter, "{group}", ldap.EscapeFilter(groupDN))
+	entries, err := d.search(conn, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+	return d.members(entries), nil
+}
+
+// members maps the search results, entries without a username cannot be matched to a user and are skipped.
+func (d *Directory) members(entries []*ldap.Entry) []*Entry {
+	members := make([]*Entry, 0, len(entries))
+	for _, e := range entries {
+		if entry := d.entry(e); entry.Username != "" {
+			members = append(members, entry)
+		}
+	}
+	return members
+}
+
+// Classify compares the users created from the directory, username to user ID, with the current group members.
+// Active are the users of enabled members, inactive the users who are disabled or no longer members, and
+// added the enabled members without a user. Each result is sorted.
+func Classify(users map[string]string, members map[string]*Entry) (active []string, inactive []string, added []*Entry) {
+	for username, userID := range users {
+		if entry, ok := members[username]; ok && !entry.Disabled {
+			active = append(active, userID)
+		} else {
+			inactive = append(inactive, userID)
+		}
+	}
+	for username, entry := range members {
+		if _, ok := users[username]; ok || entry.Disabled {
+			continue
+		}
+		added = append(added, entry)
+	}
+	sort.Strings(active)
+	sort.Strings(inactive)
+	sort.Slice(added, func(i, j int) bool { return added[i].Username < added[j].Username })
+	return active, inactive, added
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directory
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+func newTestDirectory(t *testing.T) *Directory {
+	t.Helper()
+	d, err := New(Config{
+		URL:          "ldap://localhost",
+		BaseDN:       "dc=example,dc=com",
+		UserFilter:   "(sAMAccountName={username})",
+		MemberFilter: "(memberOf={group})",
+		Attributes:   Attributes{Username: "sAMAccountName", Nickname: "displayName", Email: "mail"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestEntryMapping(t *testing.T) {
+	d := newTestDirectory(t)
+	entries := []*ldap.Entry{
+		ldap.NewEntry("cn=Alice,dc=example,dc=com", map[string][]string{
+			"sAMAccountName":     {"Alice"},
+			"displayName":        {"Alice Liddell"},
+			"mail":               {"alice@example.com"},
+			"userAccountControl": {"512"},
+		}),
+		ldap.NewEntry("cn=Bob,dc=example,dc=com", map[string][]string{
+			"sAMAccountName":     {"bob"},
+			"userAccountControl": {"514"}, // NORMAL_ACCOUNT | ACCOUNTDISABLE
+		}),
+		ldap.NewEntry("cn=Carol,dc=example,dc=com", map[string][]string{
+			"sAMAccountName": {"carol"},
+			"mail":           {"carol@example.com"},
+		}),
+		ldap.NewEntry("cn=Printers,dc=example,dc=com", map[string][]string{
+			"displayName": {"Printers"},
+		}),
+	}
+	want := []*Entry{
+		{DN: "cn=Alice,dc=example,dc=com", Username: "alice", Nickname: "Alice Liddell", Email: "alice@example.com"},
+		{DN: "cn=Bob,dc=example,dc=com", Username: "bob", Disabled: true},
+		{DN: "cn=Carol,dc=example,dc=com", Username: "carol", Email: "carol@example.com"},
+	}
+	got := d.members(entries)
+	if !reflect.DeepEqual(got, want) {
+		for i := range got {
+			t.Logf("got %+v", *got[i])
+		}
+		t.Errorf("members mismatch, want %d entries without the one lacking a username", len(want))
+	}
+}
+
+func TestEntryMappingOptionalAttributes(t *testing.T) {
+	d, err := New(Config{
+		URL:        "ldap://localhost",
+		BaseDN:     "dc=example,dc=com",
+		UserFilter: "(uid={username})",
+		Attributes: Attributes{Username: "uid"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := d.entry(ldap.NewEntry("uid=dave,dc=example,dc=com", map[string][]string{
+		"uid":         {"dave"},
+		"displayName": {"Dave"},
+		"mail":        {"dave@example.com"},
+	}))
+	if entry.Nickname != "" || entry.Email != "" || entry.Disabled {
+		t.Errorf("unmapped attributes were read: %+v", *entry)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	users := map[string]string{
+		"alice": "u1", // still an enabled member
+		"bob":   "u2", // disabled in the directory
+		"carol": "u3", // removed from all groups
+	}
+	members := map[string]*Entry{
+		"alice": {Username: "alice"},
+		"bob":   {Username: "bob", Disabled: true},
+		"dave":  {Username: "dave"},
+		"erin":  {Username: "erin", Disabled: true}, // disabled and never created
+		"frank": {Username: "frank"},
+	}
+	active, inactive, added := Classify(users, members)
+	if !reflect.DeepEqual(active, []string{"u1"}) {
+		t.Errorf("active = %v, want [u1]", active)
+	}
+	if !reflect.DeepEqual(inactive, []string{"u2", "u3"}) {
+		t.Errorf("inactive = %v, want [u2 u3]", inactive)
+	}
+	if len(added) != 2 || added[0].Username != "dave" || added[1].Username != "frank" {
+		t.Errorf("added = %v, want dave and frank", added)
+	}
+
+	active, inactive, added = Classify(users, nil)
+	if len(active) != 0 || len(added) != 0 || !reflect.DeepEqual(inactive, []string{"u1", "u2", "u3"}) {
+		t.Errorf("without members got %v, %v, %v, want all users inactive", active, inactive, added)
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	valid := Config{URL: "ldap://localhost", BaseDN: "dc=example,dc=com", UserFilter: "(uid={username})", Attributes: Attributes{Username: "uid"}}
+	cases := []func(*Config){
+		func(c *Config) { c.URL = "" },
+		func(c *Config) { c.UserFilter = "(uid=alice)" },
+		func(c *Config) { c.MemberFilter = "(memberOf=cn=staff)" },
+		func(c *Config) { c.Attributes.Username = "" },
+	}
+	for i, change := range cases {
+		conf := valid
+		change(&conf)
+		if _, err := New(conf); err == nil {
+			t.Errorf("case %d: invalid config accepted", i)
+		}
+	}
+}
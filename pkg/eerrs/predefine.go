@@ -31,6 +31,18 @@ var (
 	ErrForbidden                = errs.NewCodeError(20012, "Forbidden")
 	ErrRefuseFriend             = errs.NewCodeError(20013, "RefuseFriend")
 	ErrEmailAlreadyRegister     = errs.NewCodeError(20014, "EmailAlreadyRegister")
+	ErrTwoFactorRequired        = errs.NewCodeError(20015, "TwoFactorRequired")
+	ErrTwoFactorCodeInvalid     = errs.NewCodeError(20016, "TwoFactorCodeInvalid")
+	ErrLoginLocked              = errs.NewCodeError(20017, "LoginLocked")
+	ErrPasskeyInvalid           = errs.NewCodeError(20018, "PasskeyInvalid")
+	ErrPasswordPolicy           = errs.NewCodeError(20019, "PasswordPolicyViolated")
+	ErrPasswordReused           = errs.NewCodeError(20020, "PasswordReused")
+	ErrCaptchaRequired          = errs.NewCodeError(20021, "CaptchaRequired")
+	ErrCaptchaInvalid           = errs.NewCodeError(20022, "CaptchaInvalid")
+	ErrPasswordExpired          = errs.NewCodeError(20023, "PasswordExpired")
 
-	ErrTokenNotExist = errs.NewCodeError(20101, "ErrTokenNotExist")
+	ErrTokenNotExist       = errs.NewCodeError(20101, "ErrTokenNotExist")
+	ErrRefreshTokenInvalid = errs.NewCodeError(20102, "RefreshTokenInvalid")
+	ErrRefreshTokenReused  = errs.NewCodeError(20103, "RefreshTokenReused")
+	ErrAPIKeyInvalid       = errs.NewCodeError(20104, "APIKeyInvalid")
 )
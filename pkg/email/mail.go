@@ -4,33 +4,53 @@
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
-//     http://www.apache.org/licenses/LICENSE-2.0
+//	http://www.apache.org/licenses/LICENSE-2.0
 //
 // Unless required by applicable law or agreed to in writing, software
 // distributed under the License is distributed on an "AS IS" BASIS,
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 // See the License for the specific language governing permissions and
 // limitations under the License.
-
 package email
 
 import (
 	"context"
-	"fmt"
+	"time"
+
 	"github.com/openimsdk/tools/errs"
 	"gopkg.in/gomail.v2"
 )
 
 type Mail interface {
 	Name() string
-	SendMail(ctx context.Context, mail string, verifyCode string) error
+	// SendMail sends a verification code rendered from the template of purpose in one of language,
+	// which may be an Accept-Language value.
+	SendMail(ctx context.Context, mail string, verifyCode string, purpose string, language string) error
+	// SendLoginLink sends a one-time login link valid for validTime.
+	SendLoginLink(ctx context.Context, mail string, link string, validTime time.Duration, language string) error
+	// SendContactChanged tells the old email of a user that the email or phone number changed to contact.
+	SendContactChanged(ctx context.Context, mail string, contact string, language string) error
+}
+
+// CodeData is the data of verification code templates, Link is only set for login links
+// and Contact only for contact change notices.
+type CodeData struct {
+	Title        string
+	Email        string
+	Code         string
+	Link         string
+	Contact      string
+	Purpose      string
+	ValidMinutes int
 }
 
-func NewMail(smtpAddr string, smtpPort int, senderMail, senderAuthorizationCode, title string) Mail {
+func NewMail(smtpAddr string, smtpPort int, senderMail, senderAuthorizationCode, title string, validTime time.Duration, templates *Templates) Mail {
 	dail := gomail.NewDialer(smtpAddr, smtpPort, senderMail, senderAuthorizationCode)
 	return &mail{
 		title:      title,
 		senderMail: senderMail,
+		validTime:  validTime,
+		templates:  templates,
 		dail:       dail,
 	}
 }
@@ -38,6 +58,8 @@ func NewMail(smtpAddr string, smtpPort int, senderMail, senderAuthorizationCode,
 type mail struct {
 	senderMail string
 	title      string
+	validTime  time.Duration
+	templates  *Templates
 	dail       *gomail.Dialer
 }
 
@@ -45,11 +67,49 @@ func (m *mail) Name() string {
 	return "mail"
 }
 
-func (m *mail) SendMail(ctx context.Context, mail string, verifyCode string) error {
+func (m *mail) SendMail(ctx context.Context, mail string, verifyCode string, purpose string, language string) error {
+	return m.send(ctx, mail, language, &CodeData{
+		Title:        m.title,
+		Email:        mail,
+		Code:         verifyCode,
+		Purpose:      purpose,
+		ValidMinutes: minutes(m.validTime),
+	})
+}
+
+func (m *mail) SendLoginLink(ctx context.Context, mail string, link string, validTime time.Duration, language string) error {
+	return m.send(ctx, mail, language, &CodeData{
+		Title:        m.title,
+		Email:        mail,
+		Link:         link,
+		Purpose:      PurposeLoginLink,
+		ValidMinutes: minutes(validTime),
+	})
+}
+
+func (m *mail) SendContactChanged(ctx context.Context, mail string, contact string, language string) error {
+	return m.send(ctx, mail, language, &CodeData{
+		Title:   m.title,
+		Email:   mail,
+		Contact: contact,
+		Purpose: PurposeContactChanged,
+	})
+}
+
+func (m *mail) send(ctx context.Context, mail string, language string, data *CodeData) error {
+	content, err := m.templates.Render(data.Purpose, language, data)
+	if err != nil {
+		return err
+	}
 	msg := gomail.NewMessage()
 	msg.SetHeader(`From`, m.senderMail)
 	msg.SetHeader(`To`, []string{mail}...)
-	msg.SetHeader(`Subject`, m.title)
-	msg.SetBody(`text/html`, fmt.Sprintf("Your verification code is: %s. This code is valid for 5 minutes and should not be shared with others", verifyCode))
+	msg.SetHeader(`Subject`, content.Subject)
+	msg.SetBody(`text/plain`, content.Text)
+	msg.AddAlternative(`text/html`, content.HTML)
 	return errs.Wrap(m.dail.DialAndSend(msg))
 }
+
+func minutes(d time.Duration) int {
+	return int((d + time.Minute - 1) / time.Minute)
+}
@@ -0,0 +1,202 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// Purposes of verification emails, each has a template per language named <language>/<purpose>.tmpl.
+const (
+	PurposeRegister      = "register"
+	PurposeLogin         = "login"
+	PurposeResetPassword = "reset_password"
+	PurposeLoginLink     = "login_link"
+	PurposeChangeContact = "change_contact"
+	PurposeBanAppeal     = "ban_appeal"
+	// PurposeContactChanged tells the old email of a user about a change, it has no code.
+	PurposeContactChanged = "contact_changed"
+)
+
+const defaultLanguage = "en"
+
+//go:embed templates
+var embedded embed.FS
+
+// Content is a rendered email.
+type Content struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Templates renders emails from template files defining the "subject", "text" and "html" templates,
+// named <language>/<purpose>.tmpl. Files in dir override the embedded ones of the same name.
+type Templates struct {
+	defaultLanguage string
+	templates       map[string]*template
+}
+
+type template struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// NewTemplates parses the embedded templates and those in dir, dir may be empty or not exist.
+func NewTemplates(dir string, defaultLang string) (*Templates, error) {
+	if defaultLang == "" {
+		defaultLang = defaultLanguage
+	}
+	t := &Templates{
+		defaultLanguage: normalizeLanguage(defaultLang),
+		templates:       make(map[string]*template),
+	}
+	sub, err := fs.Sub(embedded, "templates")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := t.load(sub); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if _, err := os.Stat(dir); err == nil {
+			if err := t.load(os.DirFS(dir)); err != nil {
+				return nil, err
+			}
+		} else if !os.IsNotExist(err) {
+			return nil, errs.WrapMsg(err, "read email template dir failed", "dir", dir)
+		}
+	}
+	return t, nil
+}
+
+func (t *Templates) load(fsys fs.FS) error {
+	names, err := fs.Glob(fsys, "*/*.tmpl")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return errs.WrapMsg(err, "read email template failed", "name", name)
+		}
+		tmpl, err := parseTemplate(name, string(data))
+		if err != nil {
+			return err
+		}
+		lang, file := path.Split(name)
+		t.templates[normalizeLanguage(strings.TrimSuffix(lang, "/"))+"/"+file] = tmpl
+	}
+	return nil
+}
+
+// Render executes the template of purpose in the first of the requested languages that has one, language
+// may be an Accept-Language value. It falls back to the default language and then to English.
+func (t *Templates) Render(purpose string, language string, data any) (*Content, error) {
+	tmpl := t.lookup(purpose, language)
+	if tmpl == nil {
+		return nil, errs.ErrInternalServer.WrapMsg("email template not found", "purpose", purpose, "language", language)
+	}
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, errs.WrapMsg(err, "render email subject failed", "purpose", purpose)
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, errs.WrapMsg(err, "render email text failed", "purpose", purpose)
+	}
+	if err := tmpl.html.ExecuteTemplate(&html, "html", data); err != nil {
+		return nil, errs.WrapMsg(err, "render email html failed", "purpose", purpose)
+	}
+	return &Content{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    strings.TrimSpace(html.String()),
+	}, nil
+}
+
+func (t *Templates) lookup(purpose string, language string) *template {
+	for _, lang := range t.candidates(language) {
+		if tmpl, ok := t.templates[lang+"/"+purpose+".tmpl"]; ok {
+			return tmpl
+		}
+	}
+	return nil
+}
+
+func (t *Templates) candidates(language string) []string {
+	var langs []string
+	add := func(lang string) {
+		for _, l := range langs {
+			if l == lang {
+				return
+			}
+		}
+		langs = append(langs, lang)
+	}
+	for _, part := range strings.Split(language, ",") {
+		lang, _, _ := strings.Cut(part, ";") // drop the Accept-Language quality
+		lang = normalizeLanguage(lang)
+		if lang == "" || lang == "*" {
+			continue
+		}
+		add(lang)
+		if base, _, ok := strings.Cut(lang, "-"); ok {
+			add(base)
+		}
+	}
+	add(t.defaultLanguage)
+	add(defaultLanguage)
+	return langs
+}
+
+func parseTemplate(name string, content string) (*template, error) {
+	text, err := texttemplate.New(name).Parse(content)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "parse email template failed", "name", name)
+	}
+	html, err := htmltemplate.New(name).Parse(content)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "parse email template failed", "name", name)
+	}
+	for _, def := range []string{"subject", "text", "html"} {
+		if text.Lookup(def) == nil {
+			return nil, errs.ErrInternalServer.WrapMsg("email template must define "+def, "name", name)
+		}
+	}
+	return &template{text: text, html: html}, nil
+}
+
+// normalizeLanguage turns zh_cn, ZH-cn etc. into zh-CN.
+func normalizeLanguage(lang string) string {
+	lang = strings.ReplaceAll(strings.TrimSpace(lang), "_", "-")
+	base, region, ok := strings.Cut(lang, "-")
+	base = strings.ToLower(base)
+	if !ok {
+		return base
+	}
+	if len(region) == 2 {
+		region = strings.ToUpper(region)
+	}
+	return base + "-" + region
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var testData = &CodeData{
+	Title:        "OpenIM",
+	Email:        "alice@example.com",
+	Code:         "246810",
+	Link:         "https://example.com/login?token=abc",
+	Contact:      "bob@example.com",
+	ValidMinutes: 5,
+}
+
+func TestRenderPurposes(t *testing.T) {
+	templates, err := NewTemplates("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	purposes := map[string]string{
+		PurposeRegister:       testData.Code,
+		PurposeLogin:          testData.Code,
+		PurposeResetPassword:  testData.Code,
+		PurposeLoginLink:      testData.Link,
+		PurposeChangeContact:  testData.Code,
+		PurposeBanAppeal:      testData.Code,
+		PurposeContactChanged: testData.Contact,
+	}
+	for purpose, want := range purposes {
+		for _, lang := range []string{"en", "zh-CN"} {
+			content, err := templates.Render(purpose, lang, testData)
+			if err != nil {
+				t.Errorf("Render(%s, %s): %v", purpose, lang, err)
+				continue
+			}
+			if !strings.HasPrefix(content.Subject, testData.Title+" - ") {
+				t.Errorf("Render(%s, %s) subject %q lacks the title", purpose, lang, content.Subject)
+			}
+			if !strings.Contains(content.Text, want) {
+				t.Errorf("Render(%s, %s) text lacks %q", purpose, lang, want)
+			}
+			if !strings.Contains(content.HTML, want) || !strings.Contains(content.HTML, "<html>") {
+				t.Errorf("Render(%s, %s) html lacks %q", purpose, lang, want)
+			}
+		}
+	}
+	if _, err := templates.Render("unknown", "en", testData); err == nil {
+		t.Error("unknown purpose rendered")
+	}
+}
+
+func TestRenderLanguage(t *testing.T) {
+	templates, err := NewTemplates("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	render := func(language string) string {
+		t.Helper()
+		content, err := templates.Render(PurposeLogin, language, testData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return content.Subject
+	}
+	en, zh := render("en"), render("zh-CN")
+	if en == zh {
+		t.Fatal("en and zh-CN subjects are equal")
+	}
+	for _, language := range []string{"", "fr", "de-DE,fr;q=0.8", "*"} {
+		if got := render(language); got != en {
+			t.Errorf("language %q rendered %q, want the en fallback %q", language, got, en)
+		}
+	}
+	for _, language := range []string{"zh_cn", "ZH-cn", "fr;q=0.9,zh-CN;q=0.8"} {
+		if got := render(language); got != zh {
+			t.Errorf("language %q rendered %q, want %q", language, got, zh)
+		}
+	}
+
+	zhDefault, err := NewTemplates("", "zh_CN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := zhDefault.Render(PurposeLogin, "fr", testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Subject != zh {
+		t.Errorf("unknown language with zh-CN default rendered %q, want %q", content.Subject, zh)
+	}
+}
+
+func TestRenderOverride(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	override := `{{define "subject"}}Custom {{.Code}}{{end}}{{define "text"}}custom text {{.Code}}{{end}}{{define "html"}}<p>{{.Code}}</p>{{end}}`
+	if err := os.WriteFile(filepath.Join(dir, "en", PurposeLogin+".tmpl"), []byte(override), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	templates, err := NewTemplates(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := templates.Render(PurposeLogin, "en", testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Subject != "Custom "+testData.Code || content.HTML != "<p>"+testData.Code+"</p>" {
+		t.Errorf("override not used, got %+v", *content)
+	}
+	// Purposes without an override keep the embedded template.
+	content, err = templates.Render(PurposeRegister, "en", testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.HasPrefix(content.Subject, "Custom") {
+		t.Errorf("register rendered the login override %q", content.Subject)
+	}
+
+	if _, err := NewTemplates(filepath.Join(dir, "missing"), ""); err != nil {
+		t.Errorf("missing override dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "en", PurposeRegister+".tmpl"), []byte(`{{define "subject"}}x{{end}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewTemplates(dir, ""); err == nil {
+		t.Error("override without text and html templates accepted")
+	}
+}
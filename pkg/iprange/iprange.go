@@ -0,0 +1,89 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package iprange parses IP addresses and CIDR blocks of both families into ranges of 16 byte addresses,
+// IPv4 is mapped into ::ffff:0:0/96. The bounds are fixed length hex strings, so they compare like the
+// addresses do and a range lookup is a plain indexed string comparison in the database.
+package iprange
+
+import (
+	"encoding/hex"
+	"net/netip"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+type Range struct {
+	// Value is the canonical form, an address without prefix length or the masked CIDR block.
+	Value string
+	Start string
+	End   string
+}
+
+// Parse accepts an IPv4 or IPv6 address or CIDR block.
+func Parse(s string) (*Range, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		addr, err := ParseAddr(s)
+		if err != nil {
+			return nil, err
+		}
+		key := Key(addr)
+		return &Range{Value: addr.String(), Start: key, End: key}, nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid cidr", "cidr", s)
+	}
+	if prefix.Addr().Is4In6() {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		if !prefix.IsValid() {
+			return nil, errs.ErrArgs.WrapMsg("invalid cidr", "cidr", s)
+		}
+	}
+	prefix = prefix.Masked()
+	if prefix.IsSingleIP() {
+		key := Key(prefix.Addr())
+		return &Range{Value: prefix.Addr().String(), Start: key, End: key}, nil
+	}
+	start := prefix.Addr().As16()
+	end := start
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	for i := len(end) - 1; hostBits > 0; i-- {
+		if hostBits >= 8 {
+			end[i] = 0xff
+			hostBits -= 8
+		} else {
+			end[i] |= byte(1)<<hostBits - 1
+			hostBits = 0
+		}
+	}
+	return &Range{Value: prefix.String(), Start: hex.EncodeToString(start[:]), End: hex.EncodeToString(end[:])}, nil
+}
+
+// ParseAddr parses a single address, IPv4-mapped IPv6 addresses are treated as IPv4.
+func ParseAddr(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil {
+		return netip.Addr{}, errs.ErrArgs.WrapMsg("invalid ip", "ip", s)
+	}
+	return addr.Unmap().WithZone(""), nil
+}
+
+// Key returns the value compared against the range bounds.
+func Key(addr netip.Addr) string {
+	b := addr.Unmap().As16()
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iprange
+
+import (
+	"strings"
+	"testing"
+)
+
+func contains(t *testing.T, r *Range, ip string) bool {
+	t.Helper()
+	addr, err := ParseAddr(ip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := Key(addr)
+	return r.Start <= key && key <= r.End
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		in, value, start, end string
+	}{
+		{"192.168.1.7", "192.168.1.7", "00000000000000000000ffffc0a80107", "00000000000000000000ffffc0a80107"},
+		{" 10.1.2.3/8 ", "10.0.0.0/8", "00000000000000000000ffff0a000000", "00000000000000000000ffff0affffff"},
+		{"192.168.1.0/23", "192.168.0.0/23", "00000000000000000000ffffc0a80000", "00000000000000000000ffffc0a801ff"},
+		{"0.0.0.0/0", "0.0.0.0/0", "00000000000000000000ffff00000000", "00000000000000000000ffffffffffff"},
+		{"1.2.3.4/32", "1.2.3.4", "00000000000000000000ffff01020304", "00000000000000000000ffff01020304"},
+		{"::ffff:1.2.3.4", "1.2.3.4", "00000000000000000000ffff01020304", "00000000000000000000ffff01020304"},
+		{"::ffff:10.0.0.0/104", "10.0.0.0/8", "00000000000000000000ffff0a000000", "00000000000000000000ffff0affffff"},
+		{"2001:db8::1", "2001:db8::1", "20010db8000000000000000000000001", "20010db8000000000000000000000001"},
+		{"2001:db8::/32", "2001:db8::/32", "20010db8000000000000000000000000", "20010db8ffffffffffffffffffffffff"},
+		{"2001:db8::1/127", "2001:db8::/127", "20010db8000000000000000000000000", "20010db8000000000000000000000001"},
+		{"2001:db8::1/128", "2001:db8::1", "20010db8000000000000000000000001", "20010db8000000000000000000000001"},
+		{"::/0", "::/0", strings.Repeat("0", 32), strings.Repeat("f", 32)},
+	}
+	for _, c := range cases {
+		r, err := Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.in, err)
+			continue
+		}
+		if r.Value != c.value || r.Start != c.start || r.End != c.end {
+			t.Errorf("Parse(%q) = %+v, want {%s %s %s}", c.in, *r, c.value, c.start, c.end)
+		}
+		if len(r.Start) != 32 || len(r.End) != 32 {
+			t.Errorf("Parse(%q) bounds are not 16 bytes", c.in)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, in := range []string{"", "1.2.3", "1.2.3.4/33", "2001:db8::/129", "::ffff:1.2.3.4/95", "example.com", "10.0.0.0/-1", "1.2.3.4/"} {
+		if r, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) = %+v, want an error", in, *r)
+		}
+	}
+	if _, err := ParseAddr("10.0.0.0/8"); err == nil {
+		t.Error("ParseAddr accepted a cidr block")
+	}
+}
+
+func TestContains(t *testing.T) {
+	cases := []struct {
+		cidr string
+		ip   string
+		want bool
+	}{
+		{"10.0.0.0/8", "10.255.255.255", true},
+		{"10.0.0.0/8", "11.0.0.0", false},
+		{"10.0.0.0/8", "::ffff:10.1.1.1", true},
+		{"0.0.0.0/0", "255.255.255.255", true},
+		{"0.0.0.0/0", "2001:db8::1", false},
+		{"1.2.3.4/32", "1.2.3.5", false},
+		{"2001:db8::/32", "2001:db8:ffff::1", true},
+		{"2001:db8::/32", "2001:db9::", false},
+		{"2001:db8::/32", "32.1.13.184", false},
+		{"::/96", "1.2.3.4", false},
+		{"fe80::/10", "fe80::1%eth0", true},
+		{"fe80::/10", "192.168.0.1", false},
+	}
+	for _, c := range cases {
+		r, err := Parse(c.cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := contains(t, r, c.ip); got != c.want {
+			t.Errorf("%s contains %s = %v, want %v", c.cidr, c.ip, got, c.want)
+		}
+	}
+}
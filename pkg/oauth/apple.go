@@ -0,0 +1,69 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+)
+
+// appleClientSecretTTL is the validity of a generated client secret, Apple accepts up to six months.
+const appleClientSecretTTL = time.Minute * 5
+
+// NewApple creates a Sign in with Apple provider. The client secret is a JWT signed with the
+// ES256 private key of keyID, privateKey is PEM encoded PKCS#8 as downloaded from Apple.
+func NewApple(name string, clientID string, teamID string, keyID string, privateKey []byte, redirectURL string, scopes []string) (Provider, error) {
+	if teamID == "" || keyID == "" {
+		return nil, errs.New("apple team id and key id must be set", "provider", name)
+	}
+	key, err := jwt.ParseECPrivateKeyFromPEM(privateKey)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid apple private key", "provider", name)
+	}
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "name", "email"}
+	}
+	return NewOIDC(OIDCConfig{
+		Name:        name,
+		Issuer:      appleIssuer,
+		ClientID:    clientID,
+		RedirectURL: redirectURL,
+		Scopes:      scopes,
+		ClientSecretFunc: func() (string, error) {
+			return appleClientSecret(key, teamID, keyID, clientID, time.Now())
+		},
+		// Apple requires form_post when name or email is requested
+		AuthParams: map[string]string{"response_mode": "form_post"},
+	})
+}
+
+func appleClientSecret(key *ecdsa.PrivateKey, teamID string, keyID string, clientID string, now time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    teamID,
+		Subject:   clientID,
+		Audience:  jwt.ClaimStrings{appleIssuer},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appleClientSecretTTL)),
+	})
+	token.Header["kid"] = keyID
+	secret, err := token.SignedString(key)
+	if err != nil {
+		return "", errs.WrapMsg(err, "sign apple client secret failed")
+	}
+	return secret, nil
+}
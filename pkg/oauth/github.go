@@ -0,0 +1,139 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/oauth2"
+)
+
+const (
+	githubAuthURL  = "https://github.com/login/oauth/authorize"
+	githubTokenURL = "https://github.com/login/oauth/access_token"
+	githubAPIURL   = "https://api.github.com"
+)
+
+// GitHubConfig configures a GitHub OAuth app, the URLs default to github.com and may point to
+// GitHub Enterprise Server.
+type GitHubConfig struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	AuthURL      string
+	TokenURL     string
+	APIURL       string
+}
+
+type githubUser struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+func NewGitHub(conf GitHubConfig) (Provider, error) {
+	if conf.Name == "" || conf.ClientID == "" {
+		return nil, errs.New("github provider name and client id must be set")
+	}
+	if conf.AuthURL == "" {
+		conf.AuthURL = githubAuthURL
+	}
+	if conf.TokenURL == "" {
+		conf.TokenURL = githubTokenURL
+	}
+	if conf.APIURL == "" {
+		conf.APIURL = githubAPIURL
+	}
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = []string{"read:user", "user:email"}
+	}
+	return &github{
+		name:   conf.Name,
+		apiURL: strings.TrimSuffix(conf.APIURL, "/"),
+		conf: &oauth2.Config{
+			ClientID:     conf.ClientID,
+			ClientSecret: conf.ClientSecret,
+			RedirectURL:  conf.RedirectURL,
+			Scopes:       conf.Scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   conf.AuthURL,
+				TokenURL:  conf.TokenURL,
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		},
+	}, nil
+}
+
+type github struct {
+	name   string
+	apiURL string
+	conf   *oauth2.Config
+}
+
+func (g *github) Name() string {
+	return g.name
+}
+
+func (g *github) AuthCodeURL(ctx context.Context, req *AuthRequest) (string, error) {
+	return authCodeURL(g.conf, req)
+}
+
+// Exchange ignores nonce, GitHub does not issue ID tokens.
+func (g *github) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*Identity, error) {
+	token, err := exchange(ctx, g.conf, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	var user githubUser
+	if err := getJSON(ctx, g.apiURL+"/user", token.AccessToken, &user); err != nil {
+		return nil, err
+	}
+	if user.ID == 0 {
+		return nil, errs.ErrArgs.WrapMsg("github user has no id", "provider", g.name)
+	}
+	identity := &Identity{
+		Provider: g.name,
+		Subject:  strconv.FormatInt(user.ID, 10),
+		Nickname: user.Name,
+		FaceURL:  user.AvatarURL,
+	}
+	if identity.Nickname == "" {
+		identity.Nickname = user.Login
+	}
+	var emails []githubEmail
+	if err := getJSON(ctx, g.apiURL+"/user/emails", token.AccessToken, &emails); err != nil {
+		return nil, err
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+			break
+		}
+	}
+	return identity, nil
+}
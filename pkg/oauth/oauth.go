@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package oauth implements the authorization code flow with PKCE against OAuth2 and OpenID Connect
+// identity providers. Clients keep state, nonce and the PKCE verifier, so the server side is stateless.
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/oauth2"
+)
+
+// Provider types accepted in configuration.
+const (
+	TypeOIDC   = "oidc"
+	TypeGoogle = "google"
+	TypeApple  = "apple"
+	TypeGitHub = "github"
+)
+
+// maxResponseSize bounds how much of a provider response is read.
+const maxResponseSize = 1 << 20
+
+var client = &http.Client{
+	Timeout: time.Second * 10,
+}
+
+// Identity is the user authenticated by a provider.
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Nickname      string
+	FaceURL       string
+}
+
+// AuthRequest is chosen by the client before redirecting the user to the provider.
+type AuthRequest struct {
+	State         string
+	CodeChallenge string // base64url sha256 of the code verifier
+	Nonce         string // bound into the ID token of OpenID Connect providers
+}
+
+type Provider interface {
+	Name() string
+	// AuthCodeURL returns the URL the user authorizes the login at.
+	AuthCodeURL(ctx context.Context, req *AuthRequest) (string, error)
+	// Exchange redeems the authorization code and returns the authenticated user,
+	// nonce must be the one of the AuthRequest.
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*Identity, error)
+}
+
+func authCodeURL(conf *oauth2.Config, req *AuthRequest, opts ...oauth2.AuthCodeOption) (string, error) {
+	if req.State == "" {
+		return "", errs.ErrArgs.WrapMsg("state is empty")
+	}
+	if req.CodeChallenge == "" {
+		return "", errs.ErrArgs.WrapMsg("code challenge is empty")
+	}
+	opts = append(opts,
+		oauth2.SetAuthURLParam("code_challenge", req.CodeChallenge),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
+	return conf.AuthCodeURL(req.State, opts...), nil
+}
+
+func exchange(ctx context.Context, conf *oauth2.Config, code string, codeVerifier string) (*oauth2.Token, error) {
+	if code == "" {
+		return nil, errs.ErrArgs.WrapMsg("authorization code is empty")
+	}
+	if codeVerifier == "" {
+		return nil, errs.ErrArgs.WrapMsg("code verifier is empty")
+	}
+	token, err := conf.Exchange(context.WithValue(ctx, oauth2.HTTPClient, client), code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		if _, ok := err.(*oauth2.RetrieveError); ok {
+			return nil, errs.ErrArgs.WrapMsg("exchange authorization code failed", "error", err.Error())
+		}
+		return nil, errs.WrapMsg(err, "exchange authorization code failed", "tokenURL", conf.Endpoint.TokenURL)
+	}
+	return token, nil
+}
+
+// getJSON decodes the JSON response of a GET request, accessToken may be empty.
+func getJSON(ctx context.Context, url string, accessToken string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errs.WrapMsg(err, "invalid url", "url", url)
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "request failed", "url", url)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errs.WrapMsg(err, "read response failed", "url", url)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errs.New("unexpected response status", "url", url, "status", resp.StatusCode, "body", string(data)).Wrap()
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errs.WrapMsg(err, "invalid response", "url", url)
+	}
+	return nil
+}
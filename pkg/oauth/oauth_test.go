@@ -0,0 +1,259 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	testClientID     = "client"
+	testClientSecret = "secret"
+	testVerifier     = "dBjftJeZ4CVP-mJ92K27uhbUJU1p1r_wW1gFWFOEjXk"
+)
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// mockIssuer is an OpenID Connect provider issuing ID tokens for the code "code" redeemed with testVerifier.
+type mockIssuer struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims jwt.MapClaims
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.URL,
+			"authorization_endpoint": m.URL + "/authorize",
+			"token_endpoint":         m.URL + "/token",
+			"jwks_uri":               m.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "k1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.Form.Get("client_id"), r.Form.Get("client_secret")
+		}
+		if r.Form.Get("code") != "code" || r.Form.Get("code_verifier") != testVerifier ||
+			clientID != testClientID || clientSecret != testClientSecret {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, m.claims)
+		token.Header["kid"] = "k1"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	m.claims = jwt.MapClaims{
+		"iss":            m.URL,
+		"aud":            testClientID,
+		"sub":            "subject",
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          "nonce",
+		"email":          "user@example.com",
+		"email_verified": true,
+		"name":           "User",
+	}
+	return m
+}
+
+func newTestOIDC(t *testing.T, issuer string) Provider {
+	t.Helper()
+	p, err := NewOIDC(OIDCConfig{
+		Name:         "mock",
+		Issuer:       issuer,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  "https://chat.example.com/oauth/callback",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestOIDCAuthCodeURL(t *testing.T) {
+	issuer := newMockIssuer(t)
+	p := newTestOIDC(t, issuer.URL)
+	link, err := p.AuthCodeURL(context.Background(), &AuthRequest{State: "state", CodeChallenge: codeChallenge(testVerifier), Nonce: "nonce"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if !strings.HasPrefix(link, issuer.URL+"/authorize?") || q.Get("state") != "state" || q.Get("nonce") != "nonce" ||
+		q.Get("code_challenge") != codeChallenge(testVerifier) || q.Get("code_challenge_method") != "S256" ||
+		q.Get("client_id") != testClientID || q.Get("scope") != "openid email profile" {
+		t.Fatalf("unexpected authorization url %s", link)
+	}
+	if _, err := p.AuthCodeURL(context.Background(), &AuthRequest{State: "state", CodeChallenge: "c"}); err == nil {
+		t.Fatal("expected an error without nonce")
+	}
+}
+
+func TestOIDCExchange(t *testing.T) {
+	issuer := newMockIssuer(t)
+	p := newTestOIDC(t, issuer.URL)
+	identity, err := p.Exchange(context.Background(), "code", testVerifier, "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Identity{Provider: "mock", Subject: "subject", Email: "user@example.com", EmailVerified: true, Nickname: "User"}
+	if *identity != want {
+		t.Fatalf("got %+v, want %+v", identity, want)
+	}
+}
+
+func TestOIDCExchangeRejects(t *testing.T) {
+	issuer := newMockIssuer(t)
+	p := newTestOIDC(t, issuer.URL)
+	if _, err := p.Exchange(context.Background(), "code", "wrong", "nonce"); err == nil {
+		t.Error("expected an error for a wrong code verifier")
+	}
+	if _, err := p.Exchange(context.Background(), "code", testVerifier, "other"); err == nil {
+		t.Error("expected an error for a wrong nonce")
+	}
+	for name, claims := range map[string]jwt.MapClaims{
+		"audience": {"aud": "other"},
+		"issuer":   {"iss": "https://other.example.com"},
+		"expired":  {"exp": time.Now().Add(-time.Minute).Unix()},
+	} {
+		for k, v := range claims {
+			old := issuer.claims[k]
+			issuer.claims[k] = v
+			if _, err := p.Exchange(context.Background(), "code", testVerifier, "nonce"); err == nil {
+				t.Errorf("expected an error for a wrong %s", name)
+			}
+			issuer.claims[k] = old
+		}
+	}
+}
+
+func TestGitHubExchange(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.Form.Get("code_verifier") != testVerifier || r.Form.Get("client_secret") != testClientSecret {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access","token_type":"bearer"}`))
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":42,"login":"octocat","avatar_url":"https://example.com/a.png"}`))
+	})
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"email":"other@example.com","verified":true},{"email":"octocat@example.com","primary":true,"verified":true}]`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	p, err := NewGitHub(GitHubConfig{
+		Name:         "github",
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		AuthURL:      srv.URL + "/login/oauth/authorize",
+		TokenURL:     srv.URL + "/login/oauth/access_token",
+		APIURL:       srv.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := p.Exchange(context.Background(), "code", testVerifier, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Identity{Provider: "github", Subject: "42", Email: "octocat@example.com", EmailVerified: true, Nickname: "octocat", FaceURL: "https://example.com/a.png"}
+	if *identity != want {
+		t.Fatalf("got %+v, want %+v", identity, want)
+	}
+}
+
+func TestAppleClientSecret(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	secret, err := appleClientSecret(key, "team", "key", "com.example.chat", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims jwt.RegisteredClaims
+	token, err := jwt.ParseWithClaims(secret, &claims, func(*jwt.Token) (any, error) { return &key.PublicKey, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Header["kid"] != "key" || claims.Issuer != "team" || claims.Subject != "com.example.chat" || !claims.VerifyAudience(appleIssuer, true) {
+		t.Fatalf("unexpected client secret %v %+v", token.Header, claims)
+	}
+}
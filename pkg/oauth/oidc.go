@@ -0,0 +1,208 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/oauth2"
+)
+
+const (
+	googleIssuer = "https://accounts.google.com"
+	appleIssuer  = "https://appleid.apple.com"
+)
+
+var defaultOIDCScopes = []string{"openid", "email", "profile"}
+
+// OIDCConfig configures an OpenID Connect provider, the endpoints are discovered from the issuer.
+type OIDCConfig struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// ClientSecretFunc, when set, generates the client secret of each exchange instead of ClientSecret.
+	ClientSecretFunc func() (string, error)
+	// AuthParams are added to the authorization URL.
+	AuthParams map[string]string
+}
+
+type oidcMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"` // a bool, Apple sends a string
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+}
+
+func NewOIDC(conf OIDCConfig) (Provider, error) {
+	if conf.Name == "" {
+		return nil, errs.New("oidc provider name must be set")
+	}
+	if conf.Issuer == "" || conf.ClientID == "" {
+		return nil, errs.New("oidc issuer and client id must be set", "provider", conf.Name)
+	}
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = defaultOIDCScopes
+	}
+	return &oidc{conf: conf}, nil
+}
+
+// NewGoogle creates a Google provider.
+func NewGoogle(name string, clientID string, clientSecret string, redirectURL string, scopes []string) (Provider, error) {
+	return NewOIDC(OIDCConfig{
+		Name:         name,
+		Issuer:       googleIssuer,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+	})
+}
+
+type oidc struct {
+	conf     OIDCConfig
+	lock     sync.Mutex
+	metadata *oidcMetadata
+	keys     *keySet
+}
+
+func (o *oidc) Name() string {
+	return o.conf.Name
+}
+
+// discover fetches the provider metadata once it succeeds.
+func (o *oidc) discover(ctx context.Context) (*oidcMetadata, *keySet, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.metadata != nil {
+		return o.metadata, o.keys, nil
+	}
+	var metadata oidcMetadata
+	url := strings.TrimSuffix(o.conf.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, url, "", &metadata); err != nil {
+		return nil, nil, err
+	}
+	if metadata.Issuer != o.conf.Issuer {
+		return nil, nil, errs.New("oidc issuer mismatch", "issuer", o.conf.Issuer, "discovered", metadata.Issuer).Wrap()
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, nil, errs.New("incomplete oidc metadata", "issuer", o.conf.Issuer).Wrap()
+	}
+	o.metadata = &metadata
+	o.keys = newKeySet(metadata.JWKSURI)
+	return o.metadata, o.keys, nil
+}
+
+func (o *oidc) config(metadata *oidcMetadata, clientSecret string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     o.conf.ClientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  o.conf.RedirectURL,
+		Scopes:       o.conf.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  metadata.AuthorizationEndpoint,
+			TokenURL: metadata.TokenEndpoint,
+		},
+	}
+}
+
+func (o *oidc) AuthCodeURL(ctx context.Context, req *AuthRequest) (string, error) {
+	if req.Nonce == "" {
+		return "", errs.ErrArgs.WrapMsg("nonce is empty")
+	}
+	metadata, _, err := o.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	opts := []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("nonce", req.Nonce)}
+	for k, v := range o.conf.AuthParams {
+		opts = append(opts, oauth2.SetAuthURLParam(k, v))
+	}
+	return authCodeURL(o.config(metadata, ""), req, opts...)
+}
+
+func (o *oidc) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*Identity, error) {
+	if nonce == "" {
+		return nil, errs.ErrArgs.WrapMsg("nonce is empty")
+	}
+	metadata, keys, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clientSecret := o.conf.ClientSecret
+	if o.conf.ClientSecretFunc != nil {
+		if clientSecret, err = o.conf.ClientSecretFunc(); err != nil {
+			return nil, err
+		}
+	}
+	token, err := exchange(ctx, o.config(metadata, clientSecret), code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, errs.ErrArgs.WrapMsg("no id token in token response", "provider", o.conf.Name)
+	}
+	return o.verify(ctx, keys, rawIDToken, nonce)
+}
+
+func (o *oidc) verify(ctx context.Context, keys *keySet, rawIDToken string, nonce string) (*Identity, error) {
+	var claims idTokenClaims
+	if _, err := jwt.ParseWithClaims(rawIDToken, &claims, keys.keyfunc(ctx)); err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid id token", "provider", o.conf.Name, "error", err.Error())
+	}
+	if !claims.VerifyIssuer(o.conf.Issuer, true) {
+		return nil, errs.ErrArgs.WrapMsg("id token issuer mismatch", "provider", o.conf.Name)
+	}
+	if !claims.VerifyAudience(o.conf.ClientID, true) {
+		return nil, errs.ErrArgs.WrapMsg("id token audience mismatch", "provider", o.conf.Name)
+	}
+	if claims.Nonce != nonce {
+		return nil, errs.ErrArgs.WrapMsg("id token nonce mismatch", "provider", o.conf.Name)
+	}
+	if claims.Subject == "" {
+		return nil, errs.ErrArgs.WrapMsg("id token has no subject", "provider", o.conf.Name)
+	}
+	var verified bool
+	switch v := claims.EmailVerified.(type) {
+	case bool:
+		verified = v
+	case string:
+		verified = v == "true"
+	}
+	return &Identity{
+		Provider:      o.conf.Name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: verified,
+		Nickname:      claims.Name,
+		FaceURL:       claims.Picture,
+	}, nil
+}
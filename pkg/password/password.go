@@ -0,0 +1,201 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	Bcrypt   = "bcrypt"
+	Argon2id = "argon2id"
+)
+
+const (
+	defaultBcryptCost    = bcrypt.DefaultCost
+	defaultArgon2Time    = 3
+	defaultArgon2Memory  = 64 * 1024 // KiB
+	defaultArgon2Threads = 2
+	argon2KeyLen         = 32
+	argon2SaltLen        = 16
+)
+
+const (
+	hashedPrefix   = "$"
+	argon2idPrefix = "$argon2id$"
+)
+
+// Hasher turns client supplied passwords into salted, self-describing hashes.
+// Values that do not start with "$" are treated as legacy plaintext (client MD5) passwords,
+// they still verify but always report NeedsRehash so they are upgraded on the next login.
+type Hasher interface {
+	Hash(password string) (string, error)
+	Verify(hashed string, password string) (bool, error)
+	NeedsRehash(hashed string) bool
+}
+
+type Argon2 struct {
+	Time    uint32
+	Memory  uint32 // KiB
+	Threads uint8
+}
+
+func New(algorithm string, bcryptCost int, argon Argon2) (Hasher, error) {
+	algorithm = strings.ToLower(algorithm)
+	switch algorithm {
+	case "":
+		algorithm = Bcrypt
+	case Bcrypt, Argon2id:
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unknown password hash algorithm", "algorithm", algorithm)
+	}
+	if bcryptCost == 0 {
+		bcryptCost = defaultBcryptCost
+	}
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		return nil, errs.ErrArgs.WrapMsg("invalid bcrypt cost", "cost", bcryptCost)
+	}
+	if argon.Time == 0 {
+		argon.Time = defaultArgon2Time
+	}
+	if argon.Memory == 0 {
+		argon.Memory = defaultArgon2Memory
+	}
+	if argon.Threads == 0 {
+		argon.Threads = defaultArgon2Threads
+	}
+	return &hasher{
+		algorithm:  algorithm,
+		bcryptCost: bcryptCost,
+		argon2:     argon,
+	}, nil
+}
+
+type hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     Argon2
+}
+
+func (h *hasher) Hash(password string) (string, error) {
+	if password == "" {
+		return "", errs.ErrArgs.WrapMsg("password is empty")
+	}
+	switch h.algorithm {
+	case Argon2id:
+		salt := make([]byte, argon2SaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", errs.Wrap(err)
+		}
+		key := argon2.IDKey([]byte(password), salt, h.argon2.Time, h.argon2.Memory, h.argon2.Threads, argon2KeyLen)
+		return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version, h.argon2.Memory, h.argon2.Time, h.argon2.Threads,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	default:
+		hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		if err != nil {
+			return "", errs.Wrap(err)
+		}
+		return string(hashed), nil
+	}
+}
+
+func (h *hasher) Verify(hashed string, password string) (bool, error) {
+	if hashed == "" || password == "" {
+		return false, nil
+	}
+	switch {
+	case !IsHashed(hashed):
+		return subtle.ConstantTimeCompare([]byte(hashed), []byte(password)) == 1, nil
+	case strings.HasPrefix(hashed, argon2idPrefix):
+		p, salt, key, err := parseArgon2id(hashed)
+		if err != nil {
+			return false, err
+		}
+		other := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, uint32(len(key)))
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+	default:
+		err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(password))
+		switch err {
+		case nil:
+			return true, nil
+		case bcrypt.ErrMismatchedHashAndPassword:
+			return false, nil
+		default:
+			return false, errs.WrapMsg(err, "invalid bcrypt password hash")
+		}
+	}
+}
+
+func (h *hasher) NeedsRehash(hashed string) bool {
+	if !IsHashed(hashed) {
+		return true
+	}
+	if strings.HasPrefix(hashed, argon2idPrefix) {
+		if h.algorithm != Argon2id {
+			return true
+		}
+		p, _, _, err := parseArgon2id(hashed)
+		if err != nil {
+			return true
+		}
+		return p != h.argon2
+	}
+	if h.algorithm != Bcrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hashed))
+	if err != nil {
+		return true
+	}
+	return cost != h.bcryptCost
+}
+
+// IsHashed reports whether the stored value was produced by a Hasher rather than saved as sent by the client.
+func IsHashed(stored string) bool {
+	return strings.HasPrefix(stored, hashedPrefix)
+}
+
+func parseArgon2id(hashed string) (Argon2, []byte, []byte, error) {
+	// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 6 {
+		return Argon2{}, nil, nil, errs.ErrArgs.WrapMsg("invalid argon2id password hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Argon2{}, nil, nil, errs.ErrArgs.WrapMsg("unsupported argon2id version", "version", parts[2])
+	}
+	var p Argon2
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return Argon2{}, nil, nil, errs.WrapMsg(err, "invalid argon2id params")
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Argon2{}, nil, nil, errs.WrapMsg(err, "invalid argon2id salt")
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return Argon2{}, nil, nil, errs.WrapMsg(err, "invalid argon2id key")
+	}
+	return p, salt, key, nil
+}
@@ -0,0 +1,153 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testArgon2 = Argon2{Time: 1, Memory: 1024, Threads: 1}
+
+func newTestHasher(t *testing.T, algorithm string) Hasher {
+	t.Helper()
+	h, err := New(algorithm, bcrypt.MinCost, testArgon2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, algorithm := range []string{Bcrypt, Argon2id} {
+		h := newTestHasher(t, algorithm)
+		hashed, err := h.Hash("e10adc3949ba59abbe56e057f20f883e")
+		if err != nil {
+			t.Fatalf("%s: %v", algorithm, err)
+		}
+		if !IsHashed(hashed) {
+			t.Errorf("%s: hash %q is not recognized as hashed", algorithm, hashed)
+		}
+		if algorithm == Argon2id && !strings.HasPrefix(hashed, argon2idPrefix) {
+			t.Errorf("argon2id: unexpected hash %q", hashed)
+		}
+		if ok, err := h.Verify(hashed, "e10adc3949ba59abbe56e057f20f883e"); err != nil || !ok {
+			t.Errorf("%s: Verify of the right password = %v, %v", algorithm, ok, err)
+		}
+		if ok, err := h.Verify(hashed, "e10adc3949ba59abbe56e057f20f883f"); err != nil || ok {
+			t.Errorf("%s: Verify of a wrong password = %v, %v", algorithm, ok, err)
+		}
+		if h.NeedsRehash(hashed) {
+			t.Errorf("%s: a fresh hash needs rehash", algorithm)
+		}
+		other, err := h.Hash("e10adc3949ba59abbe56e057f20f883e")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if other == hashed {
+			t.Errorf("%s: two hashes of the same password are equal, the salt is missing", algorithm)
+		}
+	}
+}
+
+func TestVerifyAcrossAlgorithms(t *testing.T) {
+	bcryptHasher := newTestHasher(t, Bcrypt)
+	argonHasher := newTestHasher(t, Argon2id)
+	hashed, err := argonHasher.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := bcryptHasher.Verify(hashed, "secret"); err != nil || !ok {
+		t.Errorf("bcrypt hasher cannot verify an argon2id hash: %v, %v", ok, err)
+	}
+}
+
+func TestLegacyPlaintext(t *testing.T) {
+	h := newTestHasher(t, Bcrypt)
+	legacy := "e10adc3949ba59abbe56e057f20f883e"
+	if IsHashed(legacy) {
+		t.Fatal("legacy md5 password is recognized as hashed")
+	}
+	if ok, err := h.Verify(legacy, legacy); err != nil || !ok {
+		t.Errorf("Verify of the legacy password = %v, %v", ok, err)
+	}
+	if ok, err := h.Verify(legacy, "96e79218965eb72c92a549dd5a330112"); err != nil || ok {
+		t.Errorf("Verify of a wrong legacy password = %v, %v", ok, err)
+	}
+	if !h.NeedsRehash(legacy) {
+		t.Error("legacy password does not need rehash")
+	}
+}
+
+func TestVerifyEmpty(t *testing.T) {
+	h := newTestHasher(t, Bcrypt)
+	hashed, err := h.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := h.Verify(hashed, ""); ok {
+		t.Error("empty password verified")
+	}
+	if ok, _ := h.Verify("", ""); ok {
+		t.Error("empty stored password verified")
+	}
+	if _, err := h.Hash(""); err == nil {
+		t.Error("empty password hashed")
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	bcryptHasher := newTestHasher(t, Bcrypt)
+	argonHasher := newTestHasher(t, Argon2id)
+	bcryptHash, err := bcryptHasher.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	argonHash, err := argonHasher.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !argonHasher.NeedsRehash(bcryptHash) {
+		t.Error("bcrypt hash does not need rehash when argon2id is configured")
+	}
+	if !bcryptHasher.NeedsRehash(argonHash) {
+		t.Error("argon2id hash does not need rehash when bcrypt is configured")
+	}
+	costlier, err := New(Bcrypt, bcrypt.MinCost+1, testArgon2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !costlier.NeedsRehash(bcryptHash) {
+		t.Error("bcrypt hash does not need rehash after the cost changed")
+	}
+	stronger, err := New(Argon2id, 0, Argon2{Time: 2, Memory: 1024, Threads: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stronger.NeedsRehash(argonHash) {
+		t.Error("argon2id hash does not need rehash after the params changed")
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	if _, err := New("md5", 0, Argon2{}); err == nil {
+		t.Error("unknown algorithm accepted")
+	}
+	if _, err := New(Bcrypt, bcrypt.MaxCost+1, Argon2{}); err == nil {
+		t.Error("invalid bcrypt cost accepted")
+	}
+}
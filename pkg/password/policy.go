@@ -0,0 +1,133 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package password
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+)
+
+// Rules a password can violate, returned to clients.
+const (
+	RuleMinLength = "minLength"
+	RuleLower     = "lower"
+	RuleUpper     = "upper"
+	RuleDigit     = "digit"
+	RuleSymbol    = "symbol"
+)
+
+// Policy is the password policy set in the client config.
+type Policy struct {
+	MinLength     int
+	RequireLower  bool
+	RequireUpper  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// History is the number of latest passwords, the current one included, a new password must differ from.
+	History int
+	// MaxAge after which a password must be changed, 0 never expires.
+	MaxAge time.Duration
+	// Plaintext is set when clients send plaintext passwords, otherwise the length and character rules
+	// can only be checked before the client hashes the password.
+	Plaintext bool
+}
+
+// ParsePolicy reads the policy from the client config, missing keys disable their rule.
+func ParsePolicy(conf map[string]string) (*Policy, error) {
+	var (
+		policy Policy
+		err    error
+	)
+	if policy.MinLength, err = parseCount(conf, constant.PasswordMinLengthConfigKey); err != nil {
+		return nil, err
+	}
+	if policy.History, err = parseCount(conf, constant.PasswordHistoryConfigKey); err != nil {
+		return nil, err
+	}
+	days, err := parseCount(conf, constant.PasswordMaxAgeConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	policy.MaxAge = time.Duration(days) * 24 * time.Hour
+	policy.RequireLower = parseBool(conf[constant.PasswordRequireLowerConfigKey])
+	policy.RequireUpper = parseBool(conf[constant.PasswordRequireUpperConfigKey])
+	policy.RequireDigit = parseBool(conf[constant.PasswordRequireDigitConfigKey])
+	policy.RequireSymbol = parseBool(conf[constant.PasswordRequireSymbolConfigKey])
+	policy.Plaintext = parseBool(conf[constant.PasswordPlaintextConfigKey])
+	return &policy, nil
+}
+
+func parseCount(conf map[string]string, key string) (int, error) {
+	val := strings.TrimSpace(conf[key])
+	if val == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil || n < 0 {
+		return 0, errs.ErrArgs.WrapMsg("invalid password policy", "key", key, "value", val)
+	}
+	return n, nil
+}
+
+func parseBool(val string) bool {
+	return datautil.Contain(strings.ToLower(strings.TrimSpace(val)), "1", "true", "yes")
+}
+
+// Violations returns the rules a plaintext password breaks.
+func (p *Policy) Violations(password string) []string {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	var rules []string
+	if utf8.RuneCountInString(password) < p.MinLength {
+		rules = append(rules, RuleMinLength)
+	}
+	if p.RequireLower && !lower {
+		rules = append(rules, RuleLower)
+	}
+	if p.RequireUpper && !upper {
+		rules = append(rules, RuleUpper)
+	}
+	if p.RequireDigit && !digit {
+		rules = append(rules, RuleDigit)
+	}
+	if p.RequireSymbol && !symbol {
+		rules = append(rules, RuleSymbol)
+	}
+	return rules
+}
+
+// Expired reports whether a password changed at changeTime must be changed.
+func (p *Policy) Expired(changeTime time.Time, now time.Time) bool {
+	return p.MaxAge > 0 && !changeTime.IsZero() && now.Sub(changeTime) > p.MaxAge
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package phonenumber normalizes the area code and phone number of an account to E.164, so every way of
+// writing a number (spaces, a trunk prefix, the country code repeated) maps to the same credential.
+package phonenumber
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/ttacon/libphonenumber"
+)
+
+// Normalize validates phoneNumber for the region of areaCode and returns the area code as "+<country code>"
+// and the national significant number, which concatenated are the E.164 form of the number.
+func Normalize(areaCode string, phoneNumber string) (string, string, error) {
+	areaCode = strings.TrimPrefix(strings.TrimSpace(areaCode), "+")
+	countryCode, err := strconv.Atoi(areaCode)
+	if err != nil || countryCode <= 0 {
+		return "", "", errs.ErrArgs.WrapMsg("area code is invalid", "areaCode", areaCode)
+	}
+	phoneNumber = strings.TrimSpace(phoneNumber)
+	if phoneNumber == "" {
+		return "", "", errs.ErrArgs.WrapMsg("phone number is empty")
+	}
+	region := libphonenumber.GetRegionCodeForCountryCode(countryCode)
+	if (region == libphonenumber.UNKNOWN_REGION || region == libphonenumber.REGION_CODE_FOR_NON_GEO_ENTITY) && !strings.HasPrefix(phoneNumber, "+") {
+		// non-geographic country codes have no region to parse a national number in
+		phoneNumber = "+" + strconv.Itoa(countryCode) + phoneNumber
+	}
+	number, err := libphonenumber.Parse(phoneNumber, region)
+	if err != nil {
+		return "", "", errs.ErrArgs.WrapMsg("phone number is invalid", "areaCode", areaCode, "phoneNumber", phoneNumber)
+	}
+	if int(number.GetCountryCode()) != countryCode {
+		return "", "", errs.ErrArgs.WrapMsg("phone number does not belong to the area code", "areaCode", areaCode, "phoneNumber", phoneNumber)
+	}
+	if !libphonenumber.IsValidNumber(number) {
+		return "", "", errs.ErrArgs.WrapMsg("phone number is invalid", "areaCode", areaCode, "phoneNumber", phoneNumber)
+	}
+	// the parsed country code drops the zeros of "0086", "086" etc., which would be separate credentials
+	return "+" + strconv.Itoa(int(number.GetCountryCode())), libphonenumber.GetNationalSignificantNumber(number), nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phonenumber
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		areaCode, phoneNumber string
+		wantAreaCode, want    string
+	}{
+		{"+86", "13800138000", "+86", "13800138000"},
+		{"0086", "13800138000", "+86", "13800138000"},
+		{"086", "13800138000", "+86", "13800138000"},
+		{" +086 ", "13800138000", "+86", "13800138000"},
+		{"86", "138 0013 8000", "+86", "13800138000"},
+		{"+86", "+86 138-0013-8000", "+86", "13800138000"},
+		{"+44", "07911 123456", "+44", "7911123456"},
+		{"+1", "(650) 253-0000", "+1", "6502530000"},
+		{"+39", "06 1234 5678", "+39", "0612345678"},
+		{"+800", "1234 5678", "+800", "12345678"},
+		{"00800", "1234 5678", "+800", "12345678"},
+	}
+	for _, c := range cases {
+		areaCode, phoneNumber, err := Normalize(c.areaCode, c.phoneNumber)
+		if err != nil {
+			t.Errorf("Normalize(%q, %q): %v", c.areaCode, c.phoneNumber, err)
+			continue
+		}
+		if areaCode != c.wantAreaCode || phoneNumber != c.want {
+			t.Errorf("Normalize(%q, %q) = %q, %q, want %q, %q", c.areaCode, c.phoneNumber, areaCode, phoneNumber, c.wantAreaCode, c.want)
+		}
+	}
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	cases := [][2]string{
+		{"", "13800138000"},
+		{"+abc", "13800138000"},
+		{"+86", ""},
+		{"+86", "12345"},
+		{"+86", "+44 7911 123456"},
+		{"+44", "13800138000"},
+	}
+	for _, c := range cases {
+		if _, _, err := Normalize(c[0], c[1]); err == nil {
+			t.Errorf("Normalize(%q, %q) accepted an invalid number", c[0], c[1])
+		}
+	}
+}
@@ -15,6 +15,8 @@
 package admin
 
 import (
+	"strings"
+
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -236,6 +238,9 @@ func (x *BlockUserReq) Check() error {
 	if x.UserID == "" {
 		return errs.ErrArgs.WrapMsg("userID is empty")
 	}
+	if x.ExpireTime < 0 {
+		return errs.ErrArgs.WrapMsg("expireTime is invalid")
+	}
 	return nil
 }
 
@@ -387,3 +392,261 @@ func (x *SearchAdminAccountReq) Check() error {
 	}
 	return nil
 }
+
+func (x *ConfirmTwoFactorReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *RegenerateRecoveryCodesReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *SetTwoFactorRequiredReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeSessionReq) Check() error {
+	if x.SessionID == "" {
+		return errs.ErrArgs.WrapMsg("sessionID is empty")
+	}
+	return nil
+}
+
+func (x *SearchLoginLockReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetLoginLockReq) Check() error {
+	if x.Kind == "" || x.Target == "" {
+		return errs.ErrArgs.WrapMsg("kind or target is empty")
+	}
+	return nil
+}
+
+func (x *ClearLoginLockReq) Check() error {
+	if x.Kind == "" || x.Target == "" {
+		return errs.ErrArgs.WrapMsg("kind or target is empty")
+	}
+	return nil
+}
+
+func (x *AddTestAccountReq) Check() error {
+	if len(x.TestAccounts) == 0 {
+		return errs.ErrArgs.WrapMsg("testAccounts is empty")
+	}
+	for _, t := range x.TestAccounts {
+		if strings.TrimSpace(t.Account) == "" || strings.TrimSpace(t.Code) == "" {
+			return errs.ErrArgs.WrapMsg("account or code is empty")
+		}
+	}
+	return nil
+}
+
+func (x *DelTestAccountReq) Check() error {
+	if len(x.Accounts) == 0 {
+		return errs.ErrArgs.WrapMsg("accounts is empty")
+	}
+	return nil
+}
+
+func (x *SearchTestAccountReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetTestAccountReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.WrapMsg("account is empty")
+	}
+	return nil
+}
+
+func (x *CheckTestAccountCodeReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.WrapMsg("account is empty")
+	}
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func checkPermissions(permissions []string) error {
+	if datautil.Duplicate(permissions) {
+		return errs.ErrArgs.WrapMsg("permissions is duplicate")
+	}
+	for _, permission := range permissions {
+		if !datautil.Contain(permission, constant.Permissions...) {
+			return errs.ErrArgs.WrapMsg("unknown permission " + permission)
+		}
+	}
+	return nil
+}
+
+func (x *AddRoleReq) Check() error {
+	if strings.TrimSpace(x.Name) == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	return checkPermissions(x.Permissions)
+}
+
+func (x *UpdateRoleReq) Check() error {
+	if x.RoleID == "" {
+		return errs.ErrArgs.WrapMsg("roleID is empty")
+	}
+	if strings.TrimSpace(x.Name) == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	return checkPermissions(x.Permissions)
+}
+
+func (x *DelRoleReq) Check() error {
+	if len(x.RoleIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("roleIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchRoleReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	return nil
+}
+
+func (x *SetAdminRoleReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if datautil.Duplicate(x.RoleIDs) {
+		return errs.ErrArgs.WrapMsg("roleIDs is duplicate")
+	}
+	return nil
+}
+
+func (x *CheckPermissionReq) Check() error {
+	if !datautil.Contain(x.Permission, constant.Permissions...) {
+		return errs.ErrArgs.WrapMsg("unknown permission " + x.Permission)
+	}
+	return nil
+}
+
+func (x *AddAuditLogReq) Check() error {
+	if x.Operation == "" {
+		return errs.ErrArgs.WrapMsg("operation is empty")
+	}
+	return nil
+}
+
+func (x *SearchAuditLogReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.StartTime > 0 && x.EndTime > 0 && x.StartTime >= x.EndTime {
+		return errs.ErrArgs.WrapMsg("startTime is not before endTime")
+	}
+	return nil
+}
+
+func (x *AddAPIKeyReq) Check() error {
+	if x.Name == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	if len(x.Routes) == 0 {
+		return errs.ErrArgs.WrapMsg("routes is empty")
+	}
+	for _, route := range x.Routes {
+		if !strings.HasPrefix(route, "/") {
+			return errs.ErrArgs.WrapMsg("route must start with /", "route", route)
+		}
+		// "*" is only allowed as the last segment, "/user/*" matches every route below /user/
+		if path := strings.TrimSuffix(route, "/*"); strings.ContainsAny(path, "* \t\r\n") || strings.Contains(path, "//") {
+			return errs.ErrArgs.WrapMsg("route is invalid", "route", route)
+		}
+	}
+	if x.ExpireTime < 0 {
+		return errs.ErrArgs.WrapMsg("expireTime is invalid")
+	}
+	return nil
+}
+
+func (x *SearchAPIKeyReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	return nil
+}
+
+func (x *DelAPIKeyReq) Check() error {
+	if len(x.KeyIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("keyIDs is empty")
+	}
+	return nil
+}
+
+func (x *CheckAPIKeyReq) Check() error {
+	if x.Key == "" {
+		return errs.ErrArgs.WrapMsg("key is empty")
+	}
+	return nil
+}
+
+func (x *SubmitBanAppealReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if strings.TrimSpace(x.Content) == "" {
+		return errs.ErrArgs.WrapMsg("content is empty")
+	}
+	return nil
+}
+
+func (x *GetUserBanAppealReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	return nil
+}
+
+func (x *SearchBanAppealReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	switch x.Status {
+	case 0, constant.BanAppealPending, constant.BanAppealApproved, constant.BanAppealRejected:
+	default:
+		return errs.ErrArgs.WrapMsg("status is invalid")
+	}
+	return nil
+}
+
+func (x *CommentBanAppealReq) Check() error {
+	if x.AppealID == "" {
+		return errs.ErrArgs.WrapMsg("appealID is empty")
+	}
+	if strings.TrimSpace(x.Content) == "" {
+		return errs.ErrArgs.WrapMsg("content is empty")
+	}
+	return nil
+}
+
+func (x *ReviewBanAppealReq) Check() error {
+	if x.AppealID == "" {
+		return errs.ErrArgs.WrapMsg("appealID is empty")
+	}
+	return nil
+}
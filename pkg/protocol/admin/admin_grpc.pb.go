@@ -27,6 +27,19 @@ const (
 	Admin_ChangeAdminPassword_FullMethodName      = "/openim.admin.admin/ChangeAdminPassword"
 	Admin_DelAdminAccount_FullMethodName          = "/openim.admin.admin/DelAdminAccount"
 	Admin_SearchAdminAccount_FullMethodName       = "/openim.admin.admin/SearchAdminAccount"
+	Admin_AddRole_FullMethodName                  = "/openim.admin.admin/AddRole"
+	Admin_UpdateRole_FullMethodName               = "/openim.admin.admin/UpdateRole"
+	Admin_DelRole_FullMethodName                  = "/openim.admin.admin/DelRole"
+	Admin_SearchRole_FullMethodName               = "/openim.admin.admin/SearchRole"
+	Admin_SetAdminRole_FullMethodName             = "/openim.admin.admin/SetAdminRole"
+	Admin_GetPermissions_FullMethodName           = "/openim.admin.admin/GetPermissions"
+	Admin_CheckPermission_FullMethodName          = "/openim.admin.admin/CheckPermission"
+	Admin_AddAuditLog_FullMethodName              = "/openim.admin.admin/AddAuditLog"
+	Admin_SearchAuditLog_FullMethodName           = "/openim.admin.admin/SearchAuditLog"
+	Admin_AddAPIKey_FullMethodName                = "/openim.admin.admin/AddAPIKey"
+	Admin_SearchAPIKey_FullMethodName             = "/openim.admin.admin/SearchAPIKey"
+	Admin_DelAPIKey_FullMethodName                = "/openim.admin.admin/DelAPIKey"
+	Admin_CheckAPIKey_FullMethodName              = "/openim.admin.admin/CheckAPIKey"
 	Admin_AddDefaultFriend_FullMethodName         = "/openim.admin.admin/AddDefaultFriend"
 	Admin_DelDefaultFriend_FullMethodName         = "/openim.admin.admin/DelDefaultFriend"
 	Admin_FindDefaultFriend_FullMethodName        = "/openim.admin.admin/FindDefaultFriend"
@@ -52,10 +65,24 @@ const (
 	Admin_UnblockUser_FullMethodName              = "/openim.admin.admin/UnblockUser"
 	Admin_SearchBlockUser_FullMethodName          = "/openim.admin.admin/SearchBlockUser"
 	Admin_FindUserBlockInfo_FullMethodName        = "/openim.admin.admin/FindUserBlockInfo"
+	Admin_SubmitBanAppeal_FullMethodName          = "/openim.admin.admin/SubmitBanAppeal"
+	Admin_GetUserBanAppeal_FullMethodName         = "/openim.admin.admin/GetUserBanAppeal"
+	Admin_SearchBanAppeal_FullMethodName          = "/openim.admin.admin/SearchBanAppeal"
+	Admin_CommentBanAppeal_FullMethodName         = "/openim.admin.admin/CommentBanAppeal"
+	Admin_ReviewBanAppeal_FullMethodName          = "/openim.admin.admin/ReviewBanAppeal"
 	Admin_CheckRegisterForbidden_FullMethodName   = "/openim.admin.admin/CheckRegisterForbidden"
 	Admin_CheckLoginForbidden_FullMethodName      = "/openim.admin.admin/CheckLoginForbidden"
+	Admin_GetCaptcha_FullMethodName               = "/openim.admin.admin/GetCaptcha"
+	Admin_CheckCaptcha_FullMethodName             = "/openim.admin.admin/CheckCaptcha"
+	Admin_AddTestAccount_FullMethodName           = "/openim.admin.admin/AddTestAccount"
+	Admin_DelTestAccount_FullMethodName           = "/openim.admin.admin/DelTestAccount"
+	Admin_SearchTestAccount_FullMethodName        = "/openim.admin.admin/SearchTestAccount"
+	Admin_GetTestAccount_FullMethodName           = "/openim.admin.admin/GetTestAccount"
+	Admin_CheckTestAccountCode_FullMethodName     = "/openim.admin.admin/CheckTestAccountCode"
 	Admin_CreateToken_FullMethodName              = "/openim.admin.admin/CreateToken"
 	Admin_ParseToken_FullMethodName               = "/openim.admin.admin/ParseToken"
+	Admin_RefreshToken_FullMethodName             = "/openim.admin.admin/RefreshToken"
+	Admin_GetJWKS_FullMethodName                  = "/openim.admin.admin/GetJWKS"
 	Admin_AddApplet_FullMethodName                = "/openim.admin.admin/AddApplet"
 	Admin_DelApplet_FullMethodName                = "/openim.admin.admin/DelApplet"
 	Admin_UpdateApplet_FullMethodName             = "/openim.admin.admin/UpdateApplet"
@@ -66,11 +93,29 @@ const (
 	Admin_DelClientConfig_FullMethodName          = "/openim.admin.admin/DelClientConfig"
 	Admin_GetUserToken_FullMethodName             = "/openim.admin.admin/GetUserToken"
 	Admin_InvalidateToken_FullMethodName          = "/openim.admin.admin/InvalidateToken"
+	Admin_GetSessions_FullMethodName              = "/openim.admin.admin/GetSessions"
+	Admin_RevokeSession_FullMethodName            = "/openim.admin.admin/RevokeSession"
+	Admin_LoginFailed_FullMethodName              = "/openim.admin.admin/LoginFailed"
+	Admin_LoginSucceeded_FullMethodName           = "/openim.admin.admin/LoginSucceeded"
+	Admin_SearchLoginLock_FullMethodName          = "/openim.admin.admin/SearchLoginLock"
+	Admin_GetLoginLock_FullMethodName             = "/openim.admin.admin/GetLoginLock"
+	Admin_ClearLoginLock_FullMethodName           = "/openim.admin.admin/ClearLoginLock"
 	Admin_LatestApplicationVersion_FullMethodName = "/openim.admin.admin/LatestApplicationVersion"
 	Admin_AddApplicationVersion_FullMethodName    = "/openim.admin.admin/AddApplicationVersion"
 	Admin_UpdateApplicationVersion_FullMethodName = "/openim.admin.admin/UpdateApplicationVersion"
 	Admin_DeleteApplicationVersion_FullMethodName = "/openim.admin.admin/DeleteApplicationVersion"
 	Admin_PageApplicationVersion_FullMethodName   = "/openim.admin.admin/PageApplicationVersion"
+	Admin_SetupTwoFactor_FullMethodName           = "/openim.admin.admin/SetupTwoFactor"
+	Admin_ConfirmTwoFactor_FullMethodName         = "/openim.admin.admin/ConfirmTwoFactor"
+	Admin_DisableTwoFactor_FullMethodName         = "/openim.admin.admin/DisableTwoFactor"
+	Admin_RegenerateRecoveryCodes_FullMethodName  = "/openim.admin.admin/RegenerateRecoveryCodes"
+	Admin_GetTwoFactor_FullMethodName             = "/openim.admin.admin/GetTwoFactor"
+	Admin_SetTwoFactorRequired_FullMethodName     = "/openim.admin.admin/SetTwoFactorRequired"
+	Admin_SyncLDAP_FullMethodName                 = "/openim.admin.admin/SyncLDAP"
+	Admin_SetAppletClient_FullMethodName          = "/openim.admin.admin/SetAppletClient"
+	Admin_OIDCAuthorize_FullMethodName            = "/openim.admin.admin/OIDCAuthorize"
+	Admin_OIDCToken_FullMethodName                = "/openim.admin.admin/OIDCToken"
+	Admin_OIDCUserInfo_FullMethodName             = "/openim.admin.admin/OIDCUserInfo"
 )
 
 // AdminClient is the client API for Admin service.
@@ -87,6 +132,23 @@ type AdminClient interface {
 	ChangeAdminPassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error)
 	DelAdminAccount(ctx context.Context, in *DelAdminAccountReq, opts ...grpc.CallOption) (*DelAdminAccountResp, error)
 	SearchAdminAccount(ctx context.Context, in *SearchAdminAccountReq, opts ...grpc.CallOption) (*SearchAdminAccountResp, error)
+	// Roles grant permissions to normal admins, managed by the super admin
+	AddRole(ctx context.Context, in *AddRoleReq, opts ...grpc.CallOption) (*AddRoleResp, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*UpdateRoleResp, error)
+	DelRole(ctx context.Context, in *DelRoleReq, opts ...grpc.CallOption) (*DelRoleResp, error)
+	SearchRole(ctx context.Context, in *SearchRoleReq, opts ...grpc.CallOption) (*SearchRoleResp, error)
+	SetAdminRole(ctx context.Context, in *SetAdminRoleReq, opts ...grpc.CallOption) (*SetAdminRoleResp, error)
+	GetPermissions(ctx context.Context, in *GetPermissionsReq, opts ...grpc.CallOption) (*GetPermissionsResp, error)
+	// checks that the admin of the token has the permission
+	CheckPermission(ctx context.Context, in *CheckPermissionReq, opts ...grpc.CallOption) (*CheckPermissionResp, error)
+	// append-only log of the changes made by admins, the apis add the changes made outside the admin service
+	AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error)
+	SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error)
+	// API keys of admins for automation, CheckAPIKey returns the admin a request with the key is made as
+	AddAPIKey(ctx context.Context, in *AddAPIKeyReq, opts ...grpc.CallOption) (*AddAPIKeyResp, error)
+	SearchAPIKey(ctx context.Context, in *SearchAPIKeyReq, opts ...grpc.CallOption) (*SearchAPIKeyResp, error)
+	DelAPIKey(ctx context.Context, in *DelAPIKeyReq, opts ...grpc.CallOption) (*DelAPIKeyResp, error)
+	CheckAPIKey(ctx context.Context, in *CheckAPIKeyReq, opts ...grpc.CallOption) (*CheckAPIKeyResp, error)
 	// Add Remove Get default friend list on registration
 	AddDefaultFriend(ctx context.Context, in *AddDefaultFriendReq, opts ...grpc.CallOption) (*AddDefaultFriendResp, error)
 	DelDefaultFriend(ctx context.Context, in *DelDefaultFriendReq, opts ...grpc.CallOption) (*DelDefaultFriendResp, error)
@@ -117,12 +179,31 @@ type AdminClient interface {
 	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserResp, error)
 	SearchBlockUser(ctx context.Context, in *SearchBlockUserReq, opts ...grpc.CallOption) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(ctx context.Context, in *FindUserBlockInfoReq, opts ...grpc.CallOption) (*FindUserBlockInfoResp, error)
+	// Appeals of blocked users, submitted through chat rpc which verifies the user
+	SubmitBanAppeal(ctx context.Context, in *SubmitBanAppealReq, opts ...grpc.CallOption) (*SubmitBanAppealResp, error)
+	GetUserBanAppeal(ctx context.Context, in *GetUserBanAppealReq, opts ...grpc.CallOption) (*GetUserBanAppealResp, error)
+	SearchBanAppeal(ctx context.Context, in *SearchBanAppealReq, opts ...grpc.CallOption) (*SearchBanAppealResp, error)
+	CommentBanAppeal(ctx context.Context, in *CommentBanAppealReq, opts ...grpc.CallOption) (*CommentBanAppealResp, error)
+	ReviewBanAppeal(ctx context.Context, in *ReviewBanAppealReq, opts ...grpc.CallOption) (*ReviewBanAppealResp, error)
 	CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(ctx context.Context, in *CheckLoginForbiddenReq, opts ...grpc.CallOption) (*CheckLoginForbiddenResp, error)
+	GetCaptcha(ctx context.Context, in *GetCaptchaReq, opts ...grpc.CallOption) (*GetCaptchaResp, error)
+	// counts a gated request of the ip and checks the answer once the ip is over the threshold
+	CheckCaptcha(ctx context.Context, in *CheckCaptchaReq, opts ...grpc.CallOption) (*CheckCaptchaResp, error)
+	// test accounts log in with a fixed verify code
+	AddTestAccount(ctx context.Context, in *AddTestAccountReq, opts ...grpc.CallOption) (*AddTestAccountResp, error)
+	DelTestAccount(ctx context.Context, in *DelTestAccountReq, opts ...grpc.CallOption) (*DelTestAccountResp, error)
+	SearchTestAccount(ctx context.Context, in *SearchTestAccountReq, opts ...grpc.CallOption) (*SearchTestAccountResp, error)
+	GetTestAccount(ctx context.Context, in *GetTestAccountReq, opts ...grpc.CallOption) (*GetTestAccountResp, error)
+	CheckTestAccountCode(ctx context.Context, in *CheckTestAccountCodeReq, opts ...grpc.CallOption) (*CheckTestAccountCodeResp, error)
 	// create token
 	CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenResp, error)
 	// parse token
 	ParseToken(ctx context.Context, in *ParseTokenReq, opts ...grpc.CallOption) (*ParseTokenResp, error)
+	// exchange a refresh token for a new access token, the refresh token is rotated
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	// public keys that verify tokens
+	GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error)
 	// app
 	AddApplet(ctx context.Context, in *AddAppletReq, opts ...grpc.CallOption) (*AddAppletResp, error)
 	DelApplet(ctx context.Context, in *DelAppletReq, opts ...grpc.CallOption) (*DelAppletResp, error)
@@ -136,11 +217,34 @@ type AdminClient interface {
 	GetUserToken(ctx context.Context, in *GetUserTokenReq, opts ...grpc.CallOption) (*GetUserTokenResp, error)
 	// invalidate token
 	InvalidateToken(ctx context.Context, in *InvalidateTokenReq, opts ...grpc.CallOption) (*InvalidateTokenResp, error)
+	// login sessions of a user, one per login device
+	GetSessions(ctx context.Context, in *GetSessionsReq, opts ...grpc.CallOption) (*GetSessionsResp, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error)
+	// failed login accounting, accounts and ips are locked after too many failures
+	LoginFailed(ctx context.Context, in *LoginFailedReq, opts ...grpc.CallOption) (*LoginFailedResp, error)
+	LoginSucceeded(ctx context.Context, in *LoginSucceededReq, opts ...grpc.CallOption) (*LoginSucceededResp, error)
+	SearchLoginLock(ctx context.Context, in *SearchLoginLockReq, opts ...grpc.CallOption) (*SearchLoginLockResp, error)
+	GetLoginLock(ctx context.Context, in *GetLoginLockReq, opts ...grpc.CallOption) (*GetLoginLockResp, error)
+	ClearLoginLock(ctx context.Context, in *ClearLoginLockReq, opts ...grpc.CallOption) (*ClearLoginLockResp, error)
 	LatestApplicationVersion(ctx context.Context, in *LatestApplicationVersionReq, opts ...grpc.CallOption) (*LatestApplicationVersionResp, error)
 	AddApplicationVersion(ctx context.Context, in *AddApplicationVersionReq, opts ...grpc.CallOption) (*AddApplicationVersionResp, error)
 	UpdateApplicationVersion(ctx context.Context, in *UpdateApplicationVersionReq, opts ...grpc.CallOption) (*UpdateApplicationVersionResp, error)
 	DeleteApplicationVersion(ctx context.Context, in *DeleteApplicationVersionReq, opts ...grpc.CallOption) (*DeleteApplicationVersionResp, error)
 	PageApplicationVersion(ctx context.Context, in *PageApplicationVersionReq, opts ...grpc.CallOption) (*PageApplicationVersionResp, error)
+	// Two-factor authentication
+	SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error)
+	ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq, opts ...grpc.CallOption) (*ConfirmTwoFactorResp, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResp, error)
+	GetTwoFactor(ctx context.Context, in *GetTwoFactorReq, opts ...grpc.CallOption) (*GetTwoFactorResp, error)
+	SetTwoFactorRequired(ctx context.Context, in *SetTwoFactorRequiredReq, opts ...grpc.CallOption) (*SetTwoFactorRequiredResp, error)
+	// Directory sync
+	SyncLDAP(ctx context.Context, in *SyncLDAPReq, opts ...grpc.CallOption) (*SyncLDAPResp, error)
+	// OpenID Connect provider for applets
+	SetAppletClient(ctx context.Context, in *SetAppletClientReq, opts ...grpc.CallOption) (*SetAppletClientResp, error)
+	OIDCAuthorize(ctx context.Context, in *OIDCAuthorizeReq, opts ...grpc.CallOption) (*OIDCAuthorizeResp, error)
+	OIDCToken(ctx context.Context, in *OIDCTokenReq, opts ...grpc.CallOption) (*OIDCTokenResp, error)
+	OIDCUserInfo(ctx context.Context, in *OIDCUserInfoReq, opts ...grpc.CallOption) (*OIDCUserInfoResp, error)
 }
 
 type adminClient struct {
@@ -231,6 +335,136 @@ func (c *adminClient) SearchAdminAccount(ctx context.Context, in *SearchAdminAcc
 	return out, nil
 }
 
+func (c *adminClient) AddRole(ctx context.Context, in *AddRoleReq, opts ...grpc.CallOption) (*AddRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRoleResp)
+	err := c.cc.Invoke(ctx, Admin_AddRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*UpdateRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoleResp)
+	err := c.cc.Invoke(ctx, Admin_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DelRole(ctx context.Context, in *DelRoleReq, opts ...grpc.CallOption) (*DelRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelRoleResp)
+	err := c.cc.Invoke(ctx, Admin_DelRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchRole(ctx context.Context, in *SearchRoleReq, opts ...grpc.CallOption) (*SearchRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRoleResp)
+	err := c.cc.Invoke(ctx, Admin_SearchRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetAdminRole(ctx context.Context, in *SetAdminRoleReq, opts ...grpc.CallOption) (*SetAdminRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAdminRoleResp)
+	err := c.cc.Invoke(ctx, Admin_SetAdminRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetPermissions(ctx context.Context, in *GetPermissionsReq, opts ...grpc.CallOption) (*GetPermissionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPermissionsResp)
+	err := c.cc.Invoke(ctx, Admin_GetPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckPermission(ctx context.Context, in *CheckPermissionReq, opts ...grpc.CallOption) (*CheckPermissionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionResp)
+	err := c.cc.Invoke(ctx, Admin_CheckPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAuditLogResp)
+	err := c.cc.Invoke(ctx, Admin_AddAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAuditLogResp)
+	err := c.cc.Invoke(ctx, Admin_SearchAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddAPIKey(ctx context.Context, in *AddAPIKeyReq, opts ...grpc.CallOption) (*AddAPIKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAPIKeyResp)
+	err := c.cc.Invoke(ctx, Admin_AddAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchAPIKey(ctx context.Context, in *SearchAPIKeyReq, opts ...grpc.CallOption) (*SearchAPIKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAPIKeyResp)
+	err := c.cc.Invoke(ctx, Admin_SearchAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DelAPIKey(ctx context.Context, in *DelAPIKeyReq, opts ...grpc.CallOption) (*DelAPIKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelAPIKeyResp)
+	err := c.cc.Invoke(ctx, Admin_DelAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckAPIKey(ctx context.Context, in *CheckAPIKeyReq, opts ...grpc.CallOption) (*CheckAPIKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAPIKeyResp)
+	err := c.cc.Invoke(ctx, Admin_CheckAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) AddDefaultFriend(ctx context.Context, in *AddDefaultFriendReq, opts ...grpc.CallOption) (*AddDefaultFriendResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddDefaultFriendResp)
@@ -481,6 +715,56 @@ func (c *adminClient) FindUserBlockInfo(ctx context.Context, in *FindUserBlockIn
 	return out, nil
 }
 
+func (c *adminClient) SubmitBanAppeal(ctx context.Context, in *SubmitBanAppealReq, opts ...grpc.CallOption) (*SubmitBanAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitBanAppealResp)
+	err := c.cc.Invoke(ctx, Admin_SubmitBanAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetUserBanAppeal(ctx context.Context, in *GetUserBanAppealReq, opts ...grpc.CallOption) (*GetUserBanAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserBanAppealResp)
+	err := c.cc.Invoke(ctx, Admin_GetUserBanAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchBanAppeal(ctx context.Context, in *SearchBanAppealReq, opts ...grpc.CallOption) (*SearchBanAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBanAppealResp)
+	err := c.cc.Invoke(ctx, Admin_SearchBanAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CommentBanAppeal(ctx context.Context, in *CommentBanAppealReq, opts ...grpc.CallOption) (*CommentBanAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentBanAppealResp)
+	err := c.cc.Invoke(ctx, Admin_CommentBanAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReviewBanAppeal(ctx context.Context, in *ReviewBanAppealReq, opts ...grpc.CallOption) (*ReviewBanAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewBanAppealResp)
+	err := c.cc.Invoke(ctx, Admin_ReviewBanAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckRegisterForbiddenResp)
@@ -501,6 +785,76 @@ func (c *adminClient) CheckLoginForbidden(ctx context.Context, in *CheckLoginFor
 	return out, nil
 }
 
+func (c *adminClient) GetCaptcha(ctx context.Context, in *GetCaptchaReq, opts ...grpc.CallOption) (*GetCaptchaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCaptchaResp)
+	err := c.cc.Invoke(ctx, Admin_GetCaptcha_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckCaptcha(ctx context.Context, in *CheckCaptchaReq, opts ...grpc.CallOption) (*CheckCaptchaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckCaptchaResp)
+	err := c.cc.Invoke(ctx, Admin_CheckCaptcha_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddTestAccount(ctx context.Context, in *AddTestAccountReq, opts ...grpc.CallOption) (*AddTestAccountResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTestAccountResp)
+	err := c.cc.Invoke(ctx, Admin_AddTestAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DelTestAccount(ctx context.Context, in *DelTestAccountReq, opts ...grpc.CallOption) (*DelTestAccountResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelTestAccountResp)
+	err := c.cc.Invoke(ctx, Admin_DelTestAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchTestAccount(ctx context.Context, in *SearchTestAccountReq, opts ...grpc.CallOption) (*SearchTestAccountResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTestAccountResp)
+	err := c.cc.Invoke(ctx, Admin_SearchTestAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetTestAccount(ctx context.Context, in *GetTestAccountReq, opts ...grpc.CallOption) (*GetTestAccountResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTestAccountResp)
+	err := c.cc.Invoke(ctx, Admin_GetTestAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckTestAccountCode(ctx context.Context, in *CheckTestAccountCodeReq, opts ...grpc.CallOption) (*CheckTestAccountCodeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckTestAccountCodeResp)
+	err := c.cc.Invoke(ctx, Admin_CheckTestAccountCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTokenResp)
@@ -521,6 +875,26 @@ func (c *adminClient) ParseToken(ctx context.Context, in *ParseTokenReq, opts ..
 	return out, nil
 }
 
+func (c *adminClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, Admin_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJWKSResp)
+	err := c.cc.Invoke(ctx, Admin_GetJWKS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) AddApplet(ctx context.Context, in *AddAppletReq, opts ...grpc.CallOption) (*AddAppletResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddAppletResp)
@@ -621,6 +995,76 @@ func (c *adminClient) InvalidateToken(ctx context.Context, in *InvalidateTokenRe
 	return out, nil
 }
 
+func (c *adminClient) GetSessions(ctx context.Context, in *GetSessionsReq, opts ...grpc.CallOption) (*GetSessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionsResp)
+	err := c.cc.Invoke(ctx, Admin_GetSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResp)
+	err := c.cc.Invoke(ctx, Admin_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) LoginFailed(ctx context.Context, in *LoginFailedReq, opts ...grpc.CallOption) (*LoginFailedResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginFailedResp)
+	err := c.cc.Invoke(ctx, Admin_LoginFailed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) LoginSucceeded(ctx context.Context, in *LoginSucceededReq, opts ...grpc.CallOption) (*LoginSucceededResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginSucceededResp)
+	err := c.cc.Invoke(ctx, Admin_LoginSucceeded_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchLoginLock(ctx context.Context, in *SearchLoginLockReq, opts ...grpc.CallOption) (*SearchLoginLockResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLoginLockResp)
+	err := c.cc.Invoke(ctx, Admin_SearchLoginLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetLoginLock(ctx context.Context, in *GetLoginLockReq, opts ...grpc.CallOption) (*GetLoginLockResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoginLockResp)
+	err := c.cc.Invoke(ctx, Admin_GetLoginLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ClearLoginLock(ctx context.Context, in *ClearLoginLockReq, opts ...grpc.CallOption) (*ClearLoginLockResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearLoginLockResp)
+	err := c.cc.Invoke(ctx, Admin_ClearLoginLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) LatestApplicationVersion(ctx context.Context, in *LatestApplicationVersionReq, opts ...grpc.CallOption) (*LatestApplicationVersionResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LatestApplicationVersionResp)
@@ -671,24 +1115,151 @@ func (c *adminClient) PageApplicationVersion(ctx context.Context, in *PageApplic
 	return out, nil
 }
 
-// AdminServer is the server API for Admin service.
-// All implementations must embed UnimplementedAdminServer
-// for forward compatibility.
-type AdminServer interface {
-	// Login
-	Login(context.Context, *LoginReq) (*LoginResp, error)
-	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
-	AdminUpdateInfo(context.Context, *AdminUpdateInfoReq) (*AdminUpdateInfoResp, error)
-	// Get administrator information
-	GetAdminInfo(context.Context, *GetAdminInfoReq) (*GetAdminInfoResp, error)
-	AddAdminAccount(context.Context, *AddAdminAccountReq) (*AddAdminAccountResp, error)
-	ChangeAdminPassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
-	DelAdminAccount(context.Context, *DelAdminAccountReq) (*DelAdminAccountResp, error)
-	SearchAdminAccount(context.Context, *SearchAdminAccountReq) (*SearchAdminAccountResp, error)
-	// Add Remove Get default friend list on registration
-	AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error)
-	DelDefaultFriend(context.Context, *DelDefaultFriendReq) (*DelDefaultFriendResp, error)
-	FindDefaultFriend(context.Context, *FindDefaultFriendReq) (*FindDefaultFriendResp, error)
+func (c *adminClient) SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupTwoFactorResp)
+	err := c.cc.Invoke(ctx, Admin_SetupTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq, opts ...grpc.CallOption) (*ConfirmTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTwoFactorResp)
+	err := c.cc.Invoke(ctx, Admin_ConfirmTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResp)
+	err := c.cc.Invoke(ctx, Admin_DisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Admin_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetTwoFactor(ctx context.Context, in *GetTwoFactorReq, opts ...grpc.CallOption) (*GetTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTwoFactorResp)
+	err := c.cc.Invoke(ctx, Admin_GetTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetTwoFactorRequired(ctx context.Context, in *SetTwoFactorRequiredReq, opts ...grpc.CallOption) (*SetTwoFactorRequiredResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTwoFactorRequiredResp)
+	err := c.cc.Invoke(ctx, Admin_SetTwoFactorRequired_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SyncLDAP(ctx context.Context, in *SyncLDAPReq, opts ...grpc.CallOption) (*SyncLDAPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncLDAPResp)
+	err := c.cc.Invoke(ctx, Admin_SyncLDAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetAppletClient(ctx context.Context, in *SetAppletClientReq, opts ...grpc.CallOption) (*SetAppletClientResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAppletClientResp)
+	err := c.cc.Invoke(ctx, Admin_SetAppletClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) OIDCAuthorize(ctx context.Context, in *OIDCAuthorizeReq, opts ...grpc.CallOption) (*OIDCAuthorizeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OIDCAuthorizeResp)
+	err := c.cc.Invoke(ctx, Admin_OIDCAuthorize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) OIDCToken(ctx context.Context, in *OIDCTokenReq, opts ...grpc.CallOption) (*OIDCTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OIDCTokenResp)
+	err := c.cc.Invoke(ctx, Admin_OIDCToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) OIDCUserInfo(ctx context.Context, in *OIDCUserInfoReq, opts ...grpc.CallOption) (*OIDCUserInfoResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OIDCUserInfoResp)
+	err := c.cc.Invoke(ctx, Admin_OIDCUserInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility.
+type AdminServer interface {
+	// Login
+	Login(context.Context, *LoginReq) (*LoginResp, error)
+	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
+	AdminUpdateInfo(context.Context, *AdminUpdateInfoReq) (*AdminUpdateInfoResp, error)
+	// Get administrator information
+	GetAdminInfo(context.Context, *GetAdminInfoReq) (*GetAdminInfoResp, error)
+	AddAdminAccount(context.Context, *AddAdminAccountReq) (*AddAdminAccountResp, error)
+	ChangeAdminPassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
+	DelAdminAccount(context.Context, *DelAdminAccountReq) (*DelAdminAccountResp, error)
+	SearchAdminAccount(context.Context, *SearchAdminAccountReq) (*SearchAdminAccountResp, error)
+	// Roles grant permissions to normal admins, managed by the super admin
+	AddRole(context.Context, *AddRoleReq) (*AddRoleResp, error)
+	UpdateRole(context.Context, *UpdateRoleReq) (*UpdateRoleResp, error)
+	DelRole(context.Context, *DelRoleReq) (*DelRoleResp, error)
+	SearchRole(context.Context, *SearchRoleReq) (*SearchRoleResp, error)
+	SetAdminRole(context.Context, *SetAdminRoleReq) (*SetAdminRoleResp, error)
+	GetPermissions(context.Context, *GetPermissionsReq) (*GetPermissionsResp, error)
+	// checks that the admin of the token has the permission
+	CheckPermission(context.Context, *CheckPermissionReq) (*CheckPermissionResp, error)
+	// append-only log of the changes made by admins, the apis add the changes made outside the admin service
+	AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error)
+	SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error)
+	// API keys of admins for automation, CheckAPIKey returns the admin a request with the key is made as
+	AddAPIKey(context.Context, *AddAPIKeyReq) (*AddAPIKeyResp, error)
+	SearchAPIKey(context.Context, *SearchAPIKeyReq) (*SearchAPIKeyResp, error)
+	DelAPIKey(context.Context, *DelAPIKeyReq) (*DelAPIKeyResp, error)
+	CheckAPIKey(context.Context, *CheckAPIKeyReq) (*CheckAPIKeyResp, error)
+	// Add Remove Get default friend list on registration
+	AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error)
+	DelDefaultFriend(context.Context, *DelDefaultFriendReq) (*DelDefaultFriendResp, error)
+	FindDefaultFriend(context.Context, *FindDefaultFriendReq) (*FindDefaultFriendResp, error)
 	SearchDefaultFriend(context.Context, *SearchDefaultFriendReq) (*SearchDefaultFriendResp, error)
 	AddDefaultGroup(context.Context, *AddDefaultGroupReq) (*AddDefaultGroupResp, error)
 	DelDefaultGroup(context.Context, *DelDefaultGroupReq) (*DelDefaultGroupResp, error)
@@ -715,12 +1286,31 @@ type AdminServer interface {
 	UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserResp, error)
 	SearchBlockUser(context.Context, *SearchBlockUserReq) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error)
+	// Appeals of blocked users, submitted through chat rpc which verifies the user
+	SubmitBanAppeal(context.Context, *SubmitBanAppealReq) (*SubmitBanAppealResp, error)
+	GetUserBanAppeal(context.Context, *GetUserBanAppealReq) (*GetUserBanAppealResp, error)
+	SearchBanAppeal(context.Context, *SearchBanAppealReq) (*SearchBanAppealResp, error)
+	CommentBanAppeal(context.Context, *CommentBanAppealReq) (*CommentBanAppealResp, error)
+	ReviewBanAppeal(context.Context, *ReviewBanAppealReq) (*ReviewBanAppealResp, error)
 	CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(context.Context, *CheckLoginForbiddenReq) (*CheckLoginForbiddenResp, error)
+	GetCaptcha(context.Context, *GetCaptchaReq) (*GetCaptchaResp, error)
+	// counts a gated request of the ip and checks the answer once the ip is over the threshold
+	CheckCaptcha(context.Context, *CheckCaptchaReq) (*CheckCaptchaResp, error)
+	// test accounts log in with a fixed verify code
+	AddTestAccount(context.Context, *AddTestAccountReq) (*AddTestAccountResp, error)
+	DelTestAccount(context.Context, *DelTestAccountReq) (*DelTestAccountResp, error)
+	SearchTestAccount(context.Context, *SearchTestAccountReq) (*SearchTestAccountResp, error)
+	GetTestAccount(context.Context, *GetTestAccountReq) (*GetTestAccountResp, error)
+	CheckTestAccountCode(context.Context, *CheckTestAccountCodeReq) (*CheckTestAccountCodeResp, error)
 	// create token
 	CreateToken(context.Context, *CreateTokenReq) (*CreateTokenResp, error)
 	// parse token
 	ParseToken(context.Context, *ParseTokenReq) (*ParseTokenResp, error)
+	// exchange a refresh token for a new access token, the refresh token is rotated
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	// public keys that verify tokens
+	GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error)
 	// app
 	AddApplet(context.Context, *AddAppletReq) (*AddAppletResp, error)
 	DelApplet(context.Context, *DelAppletReq) (*DelAppletResp, error)
@@ -734,11 +1324,34 @@ type AdminServer interface {
 	GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error)
 	// invalidate token
 	InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error)
+	// login sessions of a user, one per login device
+	GetSessions(context.Context, *GetSessionsReq) (*GetSessionsResp, error)
+	RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error)
+	// failed login accounting, accounts and ips are locked after too many failures
+	LoginFailed(context.Context, *LoginFailedReq) (*LoginFailedResp, error)
+	LoginSucceeded(context.Context, *LoginSucceededReq) (*LoginSucceededResp, error)
+	SearchLoginLock(context.Context, *SearchLoginLockReq) (*SearchLoginLockResp, error)
+	GetLoginLock(context.Context, *GetLoginLockReq) (*GetLoginLockResp, error)
+	ClearLoginLock(context.Context, *ClearLoginLockReq) (*ClearLoginLockResp, error)
 	LatestApplicationVersion(context.Context, *LatestApplicationVersionReq) (*LatestApplicationVersionResp, error)
 	AddApplicationVersion(context.Context, *AddApplicationVersionReq) (*AddApplicationVersionResp, error)
 	UpdateApplicationVersion(context.Context, *UpdateApplicationVersionReq) (*UpdateApplicationVersionResp, error)
 	DeleteApplicationVersion(context.Context, *DeleteApplicationVersionReq) (*DeleteApplicationVersionResp, error)
 	PageApplicationVersion(context.Context, *PageApplicationVersionReq) (*PageApplicationVersionResp, error)
+	// Two-factor authentication
+	SetupTwoFactor(context.Context, *SetupTwoFactorReq) (*SetupTwoFactorResp, error)
+	ConfirmTwoFactor(context.Context, *ConfirmTwoFactorReq) (*ConfirmTwoFactorResp, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorReq) (*DisableTwoFactorResp, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RegenerateRecoveryCodesResp, error)
+	GetTwoFactor(context.Context, *GetTwoFactorReq) (*GetTwoFactorResp, error)
+	SetTwoFactorRequired(context.Context, *SetTwoFactorRequiredReq) (*SetTwoFactorRequiredResp, error)
+	// Directory sync
+	SyncLDAP(context.Context, *SyncLDAPReq) (*SyncLDAPResp, error)
+	// OpenID Connect provider for applets
+	SetAppletClient(context.Context, *SetAppletClientReq) (*SetAppletClientResp, error)
+	OIDCAuthorize(context.Context, *OIDCAuthorizeReq) (*OIDCAuthorizeResp, error)
+	OIDCToken(context.Context, *OIDCTokenReq) (*OIDCTokenResp, error)
+	OIDCUserInfo(context.Context, *OIDCUserInfoReq) (*OIDCUserInfoResp, error)
 	mustEmbedUnimplementedAdminServer()
 }
 
@@ -773,6 +1386,45 @@ func (UnimplementedAdminServer) DelAdminAccount(context.Context, *DelAdminAccoun
 func (UnimplementedAdminServer) SearchAdminAccount(context.Context, *SearchAdminAccountReq) (*SearchAdminAccountResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchAdminAccount not implemented")
 }
+func (UnimplementedAdminServer) AddRole(context.Context, *AddRoleReq) (*AddRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRole not implemented")
+}
+func (UnimplementedAdminServer) UpdateRole(context.Context, *UpdateRoleReq) (*UpdateRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedAdminServer) DelRole(context.Context, *DelRoleReq) (*DelRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelRole not implemented")
+}
+func (UnimplementedAdminServer) SearchRole(context.Context, *SearchRoleReq) (*SearchRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRole not implemented")
+}
+func (UnimplementedAdminServer) SetAdminRole(context.Context, *SetAdminRoleReq) (*SetAdminRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdminRole not implemented")
+}
+func (UnimplementedAdminServer) GetPermissions(context.Context, *GetPermissionsReq) (*GetPermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
+}
+func (UnimplementedAdminServer) CheckPermission(context.Context, *CheckPermissionReq) (*CheckPermissionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedAdminServer) AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditLog not implemented")
+}
+func (UnimplementedAdminServer) SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLog not implemented")
+}
+func (UnimplementedAdminServer) AddAPIKey(context.Context, *AddAPIKeyReq) (*AddAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAPIKey not implemented")
+}
+func (UnimplementedAdminServer) SearchAPIKey(context.Context, *SearchAPIKeyReq) (*SearchAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAPIKey not implemented")
+}
+func (UnimplementedAdminServer) DelAPIKey(context.Context, *DelAPIKeyReq) (*DelAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelAPIKey not implemented")
+}
+func (UnimplementedAdminServer) CheckAPIKey(context.Context, *CheckAPIKeyReq) (*CheckAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAPIKey not implemented")
+}
 func (UnimplementedAdminServer) AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDefaultFriend not implemented")
 }
@@ -848,18 +1500,60 @@ func (UnimplementedAdminServer) SearchBlockUser(context.Context, *SearchBlockUse
 func (UnimplementedAdminServer) FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserBlockInfo not implemented")
 }
+func (UnimplementedAdminServer) SubmitBanAppeal(context.Context, *SubmitBanAppealReq) (*SubmitBanAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBanAppeal not implemented")
+}
+func (UnimplementedAdminServer) GetUserBanAppeal(context.Context, *GetUserBanAppealReq) (*GetUserBanAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBanAppeal not implemented")
+}
+func (UnimplementedAdminServer) SearchBanAppeal(context.Context, *SearchBanAppealReq) (*SearchBanAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBanAppeal not implemented")
+}
+func (UnimplementedAdminServer) CommentBanAppeal(context.Context, *CommentBanAppealReq) (*CommentBanAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentBanAppeal not implemented")
+}
+func (UnimplementedAdminServer) ReviewBanAppeal(context.Context, *ReviewBanAppealReq) (*ReviewBanAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewBanAppeal not implemented")
+}
 func (UnimplementedAdminServer) CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRegisterForbidden not implemented")
 }
 func (UnimplementedAdminServer) CheckLoginForbidden(context.Context, *CheckLoginForbiddenReq) (*CheckLoginForbiddenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckLoginForbidden not implemented")
 }
+func (UnimplementedAdminServer) GetCaptcha(context.Context, *GetCaptchaReq) (*GetCaptchaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCaptcha not implemented")
+}
+func (UnimplementedAdminServer) CheckCaptcha(context.Context, *CheckCaptchaReq) (*CheckCaptchaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCaptcha not implemented")
+}
+func (UnimplementedAdminServer) AddTestAccount(context.Context, *AddTestAccountReq) (*AddTestAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTestAccount not implemented")
+}
+func (UnimplementedAdminServer) DelTestAccount(context.Context, *DelTestAccountReq) (*DelTestAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelTestAccount not implemented")
+}
+func (UnimplementedAdminServer) SearchTestAccount(context.Context, *SearchTestAccountReq) (*SearchTestAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTestAccount not implemented")
+}
+func (UnimplementedAdminServer) GetTestAccount(context.Context, *GetTestAccountReq) (*GetTestAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestAccount not implemented")
+}
+func (UnimplementedAdminServer) CheckTestAccountCode(context.Context, *CheckTestAccountCodeReq) (*CheckTestAccountCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTestAccountCode not implemented")
+}
 func (UnimplementedAdminServer) CreateToken(context.Context, *CreateTokenReq) (*CreateTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
 }
 func (UnimplementedAdminServer) ParseToken(context.Context, *ParseTokenReq) (*ParseTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParseToken not implemented")
 }
+func (UnimplementedAdminServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAdminServer) GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
 func (UnimplementedAdminServer) AddApplet(context.Context, *AddAppletReq) (*AddAppletResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddApplet not implemented")
 }
@@ -890,6 +1584,27 @@ func (UnimplementedAdminServer) GetUserToken(context.Context, *GetUserTokenReq)
 func (UnimplementedAdminServer) InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateToken not implemented")
 }
+func (UnimplementedAdminServer) GetSessions(context.Context, *GetSessionsReq) (*GetSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedAdminServer) RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAdminServer) LoginFailed(context.Context, *LoginFailedReq) (*LoginFailedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginFailed not implemented")
+}
+func (UnimplementedAdminServer) LoginSucceeded(context.Context, *LoginSucceededReq) (*LoginSucceededResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginSucceeded not implemented")
+}
+func (UnimplementedAdminServer) SearchLoginLock(context.Context, *SearchLoginLockReq) (*SearchLoginLockResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLoginLock not implemented")
+}
+func (UnimplementedAdminServer) GetLoginLock(context.Context, *GetLoginLockReq) (*GetLoginLockResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginLock not implemented")
+}
+func (UnimplementedAdminServer) ClearLoginLock(context.Context, *ClearLoginLockReq) (*ClearLoginLockResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLoginLock not implemented")
+}
 func (UnimplementedAdminServer) LatestApplicationVersion(context.Context, *LatestApplicationVersionReq) (*LatestApplicationVersionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestApplicationVersion not implemented")
 }
@@ -905,6 +1620,39 @@ func (UnimplementedAdminServer) DeleteApplicationVersion(context.Context, *Delet
 func (UnimplementedAdminServer) PageApplicationVersion(context.Context, *PageApplicationVersionReq) (*PageApplicationVersionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PageApplicationVersion not implemented")
 }
+func (UnimplementedAdminServer) SetupTwoFactor(context.Context, *SetupTwoFactorReq) (*SetupTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupTwoFactor not implemented")
+}
+func (UnimplementedAdminServer) ConfirmTwoFactor(context.Context, *ConfirmTwoFactorReq) (*ConfirmTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactor not implemented")
+}
+func (UnimplementedAdminServer) DisableTwoFactor(context.Context, *DisableTwoFactorReq) (*DisableTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedAdminServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RegenerateRecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAdminServer) GetTwoFactor(context.Context, *GetTwoFactorReq) (*GetTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactor not implemented")
+}
+func (UnimplementedAdminServer) SetTwoFactorRequired(context.Context, *SetTwoFactorRequiredReq) (*SetTwoFactorRequiredResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTwoFactorRequired not implemented")
+}
+func (UnimplementedAdminServer) SyncLDAP(context.Context, *SyncLDAPReq) (*SyncLDAPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncLDAP not implemented")
+}
+func (UnimplementedAdminServer) SetAppletClient(context.Context, *SetAppletClientReq) (*SetAppletClientResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppletClient not implemented")
+}
+func (UnimplementedAdminServer) OIDCAuthorize(context.Context, *OIDCAuthorizeReq) (*OIDCAuthorizeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCAuthorize not implemented")
+}
+func (UnimplementedAdminServer) OIDCToken(context.Context, *OIDCTokenReq) (*OIDCTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCToken not implemented")
+}
+func (UnimplementedAdminServer) OIDCUserInfo(context.Context, *OIDCUserInfoReq) (*OIDCUserInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCUserInfo not implemented")
+}
 func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
 func (UnimplementedAdminServer) testEmbeddedByValue()               {}
 
@@ -1070,794 +1818,1604 @@ func _Admin_SearchAdminAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDefaultFriendReq)
+func _Admin_AddRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddDefaultFriend(ctx, in)
+		return srv.(AdminServer).AddRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddDefaultFriend_FullMethodName,
+		FullMethod: Admin_AddRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddDefaultFriend(ctx, req.(*AddDefaultFriendReq))
+		return srv.(AdminServer).AddRole(ctx, req.(*AddRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelDefaultFriendReq)
+func _Admin_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelDefaultFriend(ctx, in)
+		return srv.(AdminServer).UpdateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelDefaultFriend_FullMethodName,
+		FullMethod: Admin_UpdateRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelDefaultFriend(ctx, req.(*DelDefaultFriendReq))
+		return srv.(AdminServer).UpdateRole(ctx, req.(*UpdateRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_FindDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindDefaultFriendReq)
+func _Admin_DelRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).FindDefaultFriend(ctx, in)
+		return srv.(AdminServer).DelRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_FindDefaultFriend_FullMethodName,
+		FullMethod: Admin_DelRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).FindDefaultFriend(ctx, req.(*FindDefaultFriendReq))
+		return srv.(AdminServer).DelRole(ctx, req.(*DelRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchDefaultFriendReq)
+func _Admin_SearchRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchDefaultFriend(ctx, in)
+		return srv.(AdminServer).SearchRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchDefaultFriend_FullMethodName,
+		FullMethod: Admin_SearchRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchDefaultFriend(ctx, req.(*SearchDefaultFriendReq))
+		return srv.(AdminServer).SearchRole(ctx, req.(*SearchRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDefaultGroupReq)
+func _Admin_SetAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdminRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddDefaultGroup(ctx, in)
+		return srv.(AdminServer).SetAdminRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddDefaultGroup_FullMethodName,
+		FullMethod: Admin_SetAdminRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddDefaultGroup(ctx, req.(*AddDefaultGroupReq))
+		return srv.(AdminServer).SetAdminRole(ctx, req.(*SetAdminRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelDefaultGroupReq)
+func _Admin_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelDefaultGroup(ctx, in)
+		return srv.(AdminServer).GetPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelDefaultGroup_FullMethodName,
+		FullMethod: Admin_GetPermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelDefaultGroup(ctx, req.(*DelDefaultGroupReq))
+		return srv.(AdminServer).GetPermissions(ctx, req.(*GetPermissionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_FindDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindDefaultGroupReq)
+func _Admin_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).FindDefaultGroup(ctx, in)
+		return srv.(AdminServer).CheckPermission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_FindDefaultGroup_FullMethodName,
+		FullMethod: Admin_CheckPermission_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).FindDefaultGroup(ctx, req.(*FindDefaultGroupReq))
+		return srv.(AdminServer).CheckPermission(ctx, req.(*CheckPermissionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchDefaultGroupReq)
+func _Admin_AddAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAuditLogReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchDefaultGroup(ctx, in)
+		return srv.(AdminServer).AddAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchDefaultGroup_FullMethodName,
+		FullMethod: Admin_AddAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchDefaultGroup(ctx, req.(*SearchDefaultGroupReq))
+		return srv.(AdminServer).AddAuditLog(ctx, req.(*AddAuditLogReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddInvitationCodeReq)
+func _Admin_SearchAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddInvitationCode(ctx, in)
+		return srv.(AdminServer).SearchAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddInvitationCode_FullMethodName,
+		FullMethod: Admin_SearchAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddInvitationCode(ctx, req.(*AddInvitationCodeReq))
+		return srv.(AdminServer).SearchAuditLog(ctx, req.(*SearchAuditLogReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_GenInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenInvitationCodeReq)
+func _Admin_AddAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAPIKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).GenInvitationCode(ctx, in)
+		return srv.(AdminServer).AddAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_GenInvitationCode_FullMethodName,
+		FullMethod: Admin_AddAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).GenInvitationCode(ctx, req.(*GenInvitationCodeReq))
+		return srv.(AdminServer).AddAPIKey(ctx, req.(*AddAPIKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_FindInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindInvitationCodeReq)
+func _Admin_SearchAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAPIKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).FindInvitationCode(ctx, in)
+		return srv.(AdminServer).SearchAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_FindInvitationCode_FullMethodName,
+		FullMethod: Admin_SearchAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).FindInvitationCode(ctx, req.(*FindInvitationCodeReq))
+		return srv.(AdminServer).SearchAPIKey(ctx, req.(*SearchAPIKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_UseInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UseInvitationCodeReq)
+func _Admin_DelAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAPIKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).UseInvitationCode(ctx, in)
+		return srv.(AdminServer).DelAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_UseInvitationCode_FullMethodName,
+		FullMethod: Admin_DelAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).UseInvitationCode(ctx, req.(*UseInvitationCodeReq))
+		return srv.(AdminServer).DelAPIKey(ctx, req.(*DelAPIKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelInvitationCodeReq)
+func _Admin_CheckAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAPIKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelInvitationCode(ctx, in)
+		return srv.(AdminServer).CheckAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelInvitationCode_FullMethodName,
+		FullMethod: Admin_CheckAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelInvitationCode(ctx, req.(*DelInvitationCodeReq))
+		return srv.(AdminServer).CheckAPIKey(ctx, req.(*CheckAPIKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchInvitationCodeReq)
+func _Admin_AddDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDefaultFriendReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchInvitationCode(ctx, in)
+		return srv.(AdminServer).AddDefaultFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchInvitationCode_FullMethodName,
+		FullMethod: Admin_AddDefaultFriend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchInvitationCode(ctx, req.(*SearchInvitationCodeReq))
+		return srv.(AdminServer).AddDefaultFriend(ctx, req.(*AddDefaultFriendReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserIPLimitLoginReq)
+func _Admin_DelDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelDefaultFriendReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, in)
+		return srv.(AdminServer).DelDefaultFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchUserIPLimitLogin_FullMethodName,
+		FullMethod: Admin_DelDefaultFriend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, req.(*SearchUserIPLimitLoginReq))
+		return srv.(AdminServer).DelDefaultFriend(ctx, req.(*DelDefaultFriendReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserIPLimitLoginReq)
+func _Admin_FindDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDefaultFriendReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddUserIPLimitLogin(ctx, in)
+		return srv.(AdminServer).FindDefaultFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddUserIPLimitLogin_FullMethodName,
+		FullMethod: Admin_FindDefaultFriend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddUserIPLimitLogin(ctx, req.(*AddUserIPLimitLoginReq))
+		return srv.(AdminServer).FindDefaultFriend(ctx, req.(*FindDefaultFriendReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelUserIPLimitLoginReq)
+func _Admin_SearchDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDefaultFriendReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelUserIPLimitLogin(ctx, in)
+		return srv.(AdminServer).SearchDefaultFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelUserIPLimitLogin_FullMethodName,
+		FullMethod: Admin_SearchDefaultFriend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelUserIPLimitLogin(ctx, req.(*DelUserIPLimitLoginReq))
+		return srv.(AdminServer).SearchDefaultFriend(ctx, req.(*SearchDefaultFriendReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchIPForbiddenReq)
+func _Admin_AddDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDefaultGroupReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchIPForbidden(ctx, in)
+		return srv.(AdminServer).AddDefaultGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchIPForbidden_FullMethodName,
+		FullMethod: Admin_AddDefaultGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchIPForbidden(ctx, req.(*SearchIPForbiddenReq))
+		return srv.(AdminServer).AddDefaultGroup(ctx, req.(*AddDefaultGroupReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Admin_DelDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelDefaultGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelDefaultGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelDefaultGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelDefaultGroup(ctx, req.(*DelDefaultGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_FindDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDefaultGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).FindDefaultGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_FindDefaultGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).FindDefaultGroup(ctx, req.(*FindDefaultGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchDefaultGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDefaultGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchDefaultGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchDefaultGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchDefaultGroup(ctx, req.(*SearchDefaultGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInvitationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddInvitationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddInvitationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddInvitationCode(ctx, req.(*AddInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GenInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenInvitationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GenInvitationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GenInvitationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GenInvitationCode(ctx, req.(*GenInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_FindInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInvitationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).FindInvitationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_FindInvitationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).FindInvitationCode(ctx, req.(*FindInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UseInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseInvitationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UseInvitationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UseInvitationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UseInvitationCode(ctx, req.(*UseInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelInvitationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelInvitationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelInvitationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelInvitationCode(ctx, req.(*DelInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInvitationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchInvitationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchInvitationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchInvitationCode(ctx, req.(*SearchInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserIPLimitLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchUserIPLimitLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, req.(*SearchUserIPLimitLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserIPLimitLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddUserIPLimitLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddUserIPLimitLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddUserIPLimitLogin(ctx, req.(*AddUserIPLimitLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelUserIPLimitLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelUserIPLimitLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelUserIPLimitLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelUserIPLimitLogin(ctx, req.(*DelUserIPLimitLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIPForbiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchIPForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchIPForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchIPForbidden(ctx, req.(*SearchIPForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddIPForbiddenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddIPForbidden(ctx, in)
+		return srv.(AdminServer).AddIPForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddIPForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddIPForbidden(ctx, req.(*AddIPForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelIPForbiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelIPForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelIPForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelIPForbidden(ctx, req.(*DelIPForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CancellationUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancellationUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CancellationUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CancellationUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CancellationUser(ctx, req.(*CancellationUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).BlockUser(ctx, req.(*BlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UnblockUser(ctx, req.(*UnblockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchBlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchBlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchBlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchBlockUser(ctx, req.(*SearchBlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_FindUserBlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserBlockInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).FindUserBlockInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_FindUserBlockInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).FindUserBlockInfo(ctx, req.(*FindUserBlockInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SubmitBanAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBanAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SubmitBanAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SubmitBanAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SubmitBanAppeal(ctx, req.(*SubmitBanAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetUserBanAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBanAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetUserBanAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetUserBanAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetUserBanAppeal(ctx, req.(*GetUserBanAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchBanAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBanAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchBanAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchBanAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchBanAppeal(ctx, req.(*SearchBanAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CommentBanAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentBanAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CommentBanAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CommentBanAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CommentBanAppeal(ctx, req.(*CommentBanAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReviewBanAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewBanAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReviewBanAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ReviewBanAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReviewBanAppeal(ctx, req.(*ReviewBanAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CheckRegisterForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRegisterForbiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckRegisterForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CheckRegisterForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckRegisterForbidden(ctx, req.(*CheckRegisterForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CheckLoginForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckLoginForbiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckLoginForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CheckLoginForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckLoginForbidden(ctx, req.(*CheckLoginForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCaptchaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetCaptcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetCaptcha(ctx, req.(*GetCaptchaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CheckCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCaptchaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CheckCaptcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckCaptcha(ctx, req.(*CheckCaptchaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddTestAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTestAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddTestAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddTestAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddTestAccount(ctx, req.(*AddTestAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelTestAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelTestAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelTestAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelTestAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelTestAccount(ctx, req.(*DelTestAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchTestAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTestAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchTestAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchTestAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchTestAccount(ctx, req.(*SearchTestAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetTestAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTestAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetTestAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetTestAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetTestAccount(ctx, req.(*GetTestAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CheckTestAccountCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTestAccountCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckTestAccountCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CheckTestAccountCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckTestAccountCode(ctx, req.(*CheckTestAccountCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CreateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateToken(ctx, req.(*CreateTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ParseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ParseToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ParseToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ParseToken(ctx, req.(*ParseTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetJWKS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetJWKS(ctx, req.(*GetJWKSReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAppletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddApplet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddApplet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddApplet(ctx, req.(*AddAppletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAppletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelApplet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelApplet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelApplet(ctx, req.(*DelAppletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateApplet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UpdateApplet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateApplet(ctx, req.(*UpdateAppletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_FindApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAppletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).FindApplet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_FindApplet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).FindApplet(ctx, req.(*FindAppletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAppletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchApplet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchApplet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchApplet(ctx, req.(*SearchAppletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetClientConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetClientConfig(ctx, req.(*GetClientConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClientConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SetClientConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetClientConfig(ctx, req.(*SetClientConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelClientConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelClientConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddIPForbidden_FullMethodName,
+		FullMethod: Admin_DelClientConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddIPForbidden(ctx, req.(*AddIPForbiddenReq))
+		return srv.(AdminServer).DelClientConfig(ctx, req.(*DelClientConfigReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelIPForbiddenReq)
+func _Admin_GetUserToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelIPForbidden(ctx, in)
+		return srv.(AdminServer).GetUserToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelIPForbidden_FullMethodName,
+		FullMethod: Admin_GetUserToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelIPForbidden(ctx, req.(*DelIPForbiddenReq))
+		return srv.(AdminServer).GetUserToken(ctx, req.(*GetUserTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_CancellationUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancellationUserReq)
+func _Admin_InvalidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).CancellationUser(ctx, in)
+		return srv.(AdminServer).InvalidateToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_CancellationUser_FullMethodName,
+		FullMethod: Admin_InvalidateToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).CancellationUser(ctx, req.(*CancellationUserReq))
+		return srv.(AdminServer).InvalidateToken(ctx, req.(*InvalidateTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockUserReq)
+func _Admin_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).BlockUser(ctx, in)
+		return srv.(AdminServer).GetSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_BlockUser_FullMethodName,
+		FullMethod: Admin_GetSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).BlockUser(ctx, req.(*BlockUserReq))
+		return srv.(AdminServer).GetSessions(ctx, req.(*GetSessionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnblockUserReq)
+func _Admin_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).UnblockUser(ctx, in)
+		return srv.(AdminServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_UnblockUser_FullMethodName,
+		FullMethod: Admin_RevokeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).UnblockUser(ctx, req.(*UnblockUserReq))
+		return srv.(AdminServer).RevokeSession(ctx, req.(*RevokeSessionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchBlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchBlockUserReq)
+func _Admin_LoginFailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginFailedReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchBlockUser(ctx, in)
+		return srv.(AdminServer).LoginFailed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchBlockUser_FullMethodName,
+		FullMethod: Admin_LoginFailed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchBlockUser(ctx, req.(*SearchBlockUserReq))
+		return srv.(AdminServer).LoginFailed(ctx, req.(*LoginFailedReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_FindUserBlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserBlockInfoReq)
+func _Admin_LoginSucceeded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginSucceededReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).FindUserBlockInfo(ctx, in)
+		return srv.(AdminServer).LoginSucceeded(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_FindUserBlockInfo_FullMethodName,
+		FullMethod: Admin_LoginSucceeded_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).FindUserBlockInfo(ctx, req.(*FindUserBlockInfoReq))
+		return srv.(AdminServer).LoginSucceeded(ctx, req.(*LoginSucceededReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_CheckRegisterForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRegisterForbiddenReq)
+func _Admin_SearchLoginLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLoginLockReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).CheckRegisterForbidden(ctx, in)
+		return srv.(AdminServer).SearchLoginLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_CheckRegisterForbidden_FullMethodName,
+		FullMethod: Admin_SearchLoginLock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).CheckRegisterForbidden(ctx, req.(*CheckRegisterForbiddenReq))
+		return srv.(AdminServer).SearchLoginLock(ctx, req.(*SearchLoginLockReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_CheckLoginForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckLoginForbiddenReq)
+func _Admin_GetLoginLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginLockReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).CheckLoginForbidden(ctx, in)
+		return srv.(AdminServer).GetLoginLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_CheckLoginForbidden_FullMethodName,
+		FullMethod: Admin_GetLoginLock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).CheckLoginForbidden(ctx, req.(*CheckLoginForbiddenReq))
+		return srv.(AdminServer).GetLoginLock(ctx, req.(*GetLoginLockReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTokenReq)
+func _Admin_ClearLoginLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLoginLockReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).CreateToken(ctx, in)
+		return srv.(AdminServer).ClearLoginLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_CreateToken_FullMethodName,
+		FullMethod: Admin_ClearLoginLock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).CreateToken(ctx, req.(*CreateTokenReq))
+		return srv.(AdminServer).ClearLoginLock(ctx, req.(*ClearLoginLockReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_ParseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParseTokenReq)
+func _Admin_LatestApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestApplicationVersionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).ParseToken(ctx, in)
+		return srv.(AdminServer).LatestApplicationVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_ParseToken_FullMethodName,
+		FullMethod: Admin_LatestApplicationVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).ParseToken(ctx, req.(*ParseTokenReq))
+		return srv.(AdminServer).LatestApplicationVersion(ctx, req.(*LatestApplicationVersionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAppletReq)
+func _Admin_AddApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddApplicationVersionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddApplet(ctx, in)
+		return srv.(AdminServer).AddApplicationVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddApplet_FullMethodName,
+		FullMethod: Admin_AddApplicationVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddApplet(ctx, req.(*AddAppletReq))
+		return srv.(AdminServer).AddApplicationVersion(ctx, req.(*AddApplicationVersionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelAppletReq)
+func _Admin_UpdateApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationVersionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelApplet(ctx, in)
+		return srv.(AdminServer).UpdateApplicationVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelApplet_FullMethodName,
+		FullMethod: Admin_UpdateApplicationVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelApplet(ctx, req.(*DelAppletReq))
+		return srv.(AdminServer).UpdateApplicationVersion(ctx, req.(*UpdateApplicationVersionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_UpdateApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppletReq)
+func _Admin_DeleteApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationVersionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).UpdateApplet(ctx, in)
+		return srv.(AdminServer).DeleteApplicationVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_UpdateApplet_FullMethodName,
+		FullMethod: Admin_DeleteApplicationVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).UpdateApplet(ctx, req.(*UpdateAppletReq))
+		return srv.(AdminServer).DeleteApplicationVersion(ctx, req.(*DeleteApplicationVersionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_FindApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindAppletReq)
+func _Admin_PageApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageApplicationVersionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).FindApplet(ctx, in)
+		return srv.(AdminServer).PageApplicationVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_FindApplet_FullMethodName,
+		FullMethod: Admin_PageApplicationVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).FindApplet(ctx, req.(*FindAppletReq))
+		return srv.(AdminServer).PageApplicationVersion(ctx, req.(*PageApplicationVersionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchAppletReq)
+func _Admin_SetupTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupTwoFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchApplet(ctx, in)
+		return srv.(AdminServer).SetupTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchApplet_FullMethodName,
+		FullMethod: Admin_SetupTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchApplet(ctx, req.(*SearchAppletReq))
+		return srv.(AdminServer).SetupTwoFactor(ctx, req.(*SetupTwoFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_GetClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClientConfigReq)
+func _Admin_ConfirmTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).GetClientConfig(ctx, in)
+		return srv.(AdminServer).ConfirmTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_GetClientConfig_FullMethodName,
+		FullMethod: Admin_ConfirmTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).GetClientConfig(ctx, req.(*GetClientConfigReq))
+		return srv.(AdminServer).ConfirmTwoFactor(ctx, req.(*ConfirmTwoFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SetClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetClientConfigReq)
+func _Admin_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SetClientConfig(ctx, in)
+		return srv.(AdminServer).DisableTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SetClientConfig_FullMethodName,
+		FullMethod: Admin_DisableTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SetClientConfig(ctx, req.(*SetClientConfigReq))
+		return srv.(AdminServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelClientConfigReq)
+func _Admin_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelClientConfig(ctx, in)
+		return srv.(AdminServer).RegenerateRecoveryCodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelClientConfig_FullMethodName,
+		FullMethod: Admin_RegenerateRecoveryCodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelClientConfig(ctx, req.(*DelClientConfigReq))
+		return srv.(AdminServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_GetUserToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserTokenReq)
+func _Admin_GetTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwoFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).GetUserToken(ctx, in)
+		return srv.(AdminServer).GetTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_GetUserToken_FullMethodName,
+		FullMethod: Admin_GetTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).GetUserToken(ctx, req.(*GetUserTokenReq))
+		return srv.(AdminServer).GetTwoFactor(ctx, req.(*GetTwoFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_InvalidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvalidateTokenReq)
+func _Admin_SetTwoFactorRequired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTwoFactorRequiredReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).InvalidateToken(ctx, in)
+		return srv.(AdminServer).SetTwoFactorRequired(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_InvalidateToken_FullMethodName,
+		FullMethod: Admin_SetTwoFactorRequired_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).InvalidateToken(ctx, req.(*InvalidateTokenReq))
+		return srv.(AdminServer).SetTwoFactorRequired(ctx, req.(*SetTwoFactorRequiredReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_LatestApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LatestApplicationVersionReq)
+func _Admin_SyncLDAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncLDAPReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).LatestApplicationVersion(ctx, in)
+		return srv.(AdminServer).SyncLDAP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_LatestApplicationVersion_FullMethodName,
+		FullMethod: Admin_SyncLDAP_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).LatestApplicationVersion(ctx, req.(*LatestApplicationVersionReq))
+		return srv.(AdminServer).SyncLDAP(ctx, req.(*SyncLDAPReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddApplicationVersionReq)
+func _Admin_SetAppletClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppletClientReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddApplicationVersion(ctx, in)
+		return srv.(AdminServer).SetAppletClient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddApplicationVersion_FullMethodName,
+		FullMethod: Admin_SetAppletClient_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddApplicationVersion(ctx, req.(*AddApplicationVersionReq))
+		return srv.(AdminServer).SetAppletClient(ctx, req.(*SetAppletClientReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_UpdateApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateApplicationVersionReq)
+func _Admin_OIDCAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCAuthorizeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).UpdateApplicationVersion(ctx, in)
+		return srv.(AdminServer).OIDCAuthorize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_UpdateApplicationVersion_FullMethodName,
+		FullMethod: Admin_OIDCAuthorize_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).UpdateApplicationVersion(ctx, req.(*UpdateApplicationVersionReq))
+		return srv.(AdminServer).OIDCAuthorize(ctx, req.(*OIDCAuthorizeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DeleteApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApplicationVersionReq)
+func _Admin_OIDCToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DeleteApplicationVersion(ctx, in)
+		return srv.(AdminServer).OIDCToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DeleteApplicationVersion_FullMethodName,
+		FullMethod: Admin_OIDCToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DeleteApplicationVersion(ctx, req.(*DeleteApplicationVersionReq))
+		return srv.(AdminServer).OIDCToken(ctx, req.(*OIDCTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_PageApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageApplicationVersionReq)
+func _Admin_OIDCUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCUserInfoReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).PageApplicationVersion(ctx, in)
+		return srv.(AdminServer).OIDCUserInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_PageApplicationVersion_FullMethodName,
+		FullMethod: Admin_OIDCUserInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).PageApplicationVersion(ctx, req.(*PageApplicationVersionReq))
+		return srv.(AdminServer).OIDCUserInfo(ctx, req.(*OIDCUserInfoReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1901,6 +3459,58 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchAdminAccount",
 			Handler:    _Admin_SearchAdminAccount_Handler,
 		},
+		{
+			MethodName: "AddRole",
+			Handler:    _Admin_AddRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _Admin_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DelRole",
+			Handler:    _Admin_DelRole_Handler,
+		},
+		{
+			MethodName: "SearchRole",
+			Handler:    _Admin_SearchRole_Handler,
+		},
+		{
+			MethodName: "SetAdminRole",
+			Handler:    _Admin_SetAdminRole_Handler,
+		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _Admin_GetPermissions_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _Admin_CheckPermission_Handler,
+		},
+		{
+			MethodName: "AddAuditLog",
+			Handler:    _Admin_AddAuditLog_Handler,
+		},
+		{
+			MethodName: "SearchAuditLog",
+			Handler:    _Admin_SearchAuditLog_Handler,
+		},
+		{
+			MethodName: "AddAPIKey",
+			Handler:    _Admin_AddAPIKey_Handler,
+		},
+		{
+			MethodName: "SearchAPIKey",
+			Handler:    _Admin_SearchAPIKey_Handler,
+		},
+		{
+			MethodName: "DelAPIKey",
+			Handler:    _Admin_DelAPIKey_Handler,
+		},
+		{
+			MethodName: "CheckAPIKey",
+			Handler:    _Admin_CheckAPIKey_Handler,
+		},
 		{
 			MethodName: "AddDefaultFriend",
 			Handler:    _Admin_AddDefaultFriend_Handler,
@@ -2001,6 +3611,26 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserBlockInfo",
 			Handler:    _Admin_FindUserBlockInfo_Handler,
 		},
+		{
+			MethodName: "SubmitBanAppeal",
+			Handler:    _Admin_SubmitBanAppeal_Handler,
+		},
+		{
+			MethodName: "GetUserBanAppeal",
+			Handler:    _Admin_GetUserBanAppeal_Handler,
+		},
+		{
+			MethodName: "SearchBanAppeal",
+			Handler:    _Admin_SearchBanAppeal_Handler,
+		},
+		{
+			MethodName: "CommentBanAppeal",
+			Handler:    _Admin_CommentBanAppeal_Handler,
+		},
+		{
+			MethodName: "ReviewBanAppeal",
+			Handler:    _Admin_ReviewBanAppeal_Handler,
+		},
 		{
 			MethodName: "CheckRegisterForbidden",
 			Handler:    _Admin_CheckRegisterForbidden_Handler,
@@ -2009,6 +3639,34 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckLoginForbidden",
 			Handler:    _Admin_CheckLoginForbidden_Handler,
 		},
+		{
+			MethodName: "GetCaptcha",
+			Handler:    _Admin_GetCaptcha_Handler,
+		},
+		{
+			MethodName: "CheckCaptcha",
+			Handler:    _Admin_CheckCaptcha_Handler,
+		},
+		{
+			MethodName: "AddTestAccount",
+			Handler:    _Admin_AddTestAccount_Handler,
+		},
+		{
+			MethodName: "DelTestAccount",
+			Handler:    _Admin_DelTestAccount_Handler,
+		},
+		{
+			MethodName: "SearchTestAccount",
+			Handler:    _Admin_SearchTestAccount_Handler,
+		},
+		{
+			MethodName: "GetTestAccount",
+			Handler:    _Admin_GetTestAccount_Handler,
+		},
+		{
+			MethodName: "CheckTestAccountCode",
+			Handler:    _Admin_CheckTestAccountCode_Handler,
+		},
 		{
 			MethodName: "CreateToken",
 			Handler:    _Admin_CreateToken_Handler,
@@ -2017,6 +3675,14 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ParseToken",
 			Handler:    _Admin_ParseToken_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Admin_RefreshToken_Handler,
+		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _Admin_GetJWKS_Handler,
+		},
 		{
 			MethodName: "AddApplet",
 			Handler:    _Admin_AddApplet_Handler,
@@ -2057,6 +3723,34 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateToken",
 			Handler:    _Admin_InvalidateToken_Handler,
 		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _Admin_GetSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Admin_RevokeSession_Handler,
+		},
+		{
+			MethodName: "LoginFailed",
+			Handler:    _Admin_LoginFailed_Handler,
+		},
+		{
+			MethodName: "LoginSucceeded",
+			Handler:    _Admin_LoginSucceeded_Handler,
+		},
+		{
+			MethodName: "SearchLoginLock",
+			Handler:    _Admin_SearchLoginLock_Handler,
+		},
+		{
+			MethodName: "GetLoginLock",
+			Handler:    _Admin_GetLoginLock_Handler,
+		},
+		{
+			MethodName: "ClearLoginLock",
+			Handler:    _Admin_ClearLoginLock_Handler,
+		},
 		{
 			MethodName: "LatestApplicationVersion",
 			Handler:    _Admin_LatestApplicationVersion_Handler,
@@ -2077,6 +3771,50 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PageApplicationVersion",
 			Handler:    _Admin_PageApplicationVersion_Handler,
 		},
+		{
+			MethodName: "SetupTwoFactor",
+			Handler:    _Admin_SetupTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactor",
+			Handler:    _Admin_ConfirmTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _Admin_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Admin_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetTwoFactor",
+			Handler:    _Admin_GetTwoFactor_Handler,
+		},
+		{
+			MethodName: "SetTwoFactorRequired",
+			Handler:    _Admin_SetTwoFactorRequired_Handler,
+		},
+		{
+			MethodName: "SyncLDAP",
+			Handler:    _Admin_SyncLDAP_Handler,
+		},
+		{
+			MethodName: "SetAppletClient",
+			Handler:    _Admin_SetAppletClient_Handler,
+		},
+		{
+			MethodName: "OIDCAuthorize",
+			Handler:    _Admin_OIDCAuthorize_Handler,
+		},
+		{
+			MethodName: "OIDCToken",
+			Handler:    _Admin_OIDCToken_Handler,
+		},
+		{
+			MethodName: "OIDCUserInfo",
+			Handler:    _Admin_OIDCUserInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/admin.proto",
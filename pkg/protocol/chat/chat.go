@@ -17,6 +17,8 @@ package chat
 import (
 	"regexp"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
 	constantpb "github.com/openimsdk/protocol/constant"
@@ -63,7 +65,7 @@ func (x *FindUserFullInfoReq) Check() error {
 }
 
 func (x *SendVerifyCodeReq) Check() error {
-	if x.UsedFor < constant.VerificationCodeForRegister || x.UsedFor > constant.VerificationCodeForLogin {
+	if (x.UsedFor < constant.VerificationCodeForRegister || x.UsedFor > constant.VerificationCodeForLoginLink) && x.UsedFor != constant.VerificationCodeForBanAppeal {
 		return errs.ErrArgs.WrapMsg("usedFor flied is empty")
 	}
 	if x.Email == "" {
@@ -190,6 +192,43 @@ func (x *ResetPasswordReq) Check() error {
 	return nil
 }
 
+func (x *SubmitBanAppealReq) Check() error {
+	if err := checkAccountVerifyCode(x.AreaCode, x.PhoneNumber, x.Email, x.VerifyCode); err != nil {
+		return err
+	}
+	if strings.TrimSpace(x.Content) == "" {
+		return errs.ErrArgs.WrapMsg("content is empty")
+	}
+	return nil
+}
+
+func (x *GetBanAppealReq) Check() error {
+	return checkAccountVerifyCode(x.AreaCode, x.PhoneNumber, x.Email, x.VerifyCode)
+}
+
+func checkAccountVerifyCode(areaCode, phoneNumber, email, verifyCode string) error {
+	if email == "" {
+		if areaCode == "" {
+			return errs.ErrArgs.WrapMsg("AreaCode is empty")
+		} else if err := AreaCodeCheck(areaCode); err != nil {
+			return err
+		}
+		if phoneNumber == "" {
+			return errs.ErrArgs.WrapMsg("PhoneNumber is empty")
+		} else if err := PhoneNumberCheck(phoneNumber); err != nil {
+			return err
+		}
+	} else {
+		if err := EmailCheck(email); err != nil {
+			return err
+		}
+	}
+	if verifyCode == "" {
+		return errs.ErrArgs.WrapMsg("VerifyCode is empty")
+	}
+	return nil
+}
+
 func (x *ChangePasswordReq) Check() error {
 	if x.UserID == "" {
 		return errs.ErrArgs.WrapMsg("userID is empty")
@@ -297,6 +336,9 @@ func (x *AddUserAccountReq) Check() error {
 	}
 
 	if x.User.Email == "" {
+		if x.LdapUsername != "" && x.User.PhoneNumber == "" { // directory users log in with their username
+			return nil
+		}
 		if x.User.AreaCode == "" || x.User.PhoneNumber == "" {
 			return errs.ErrArgs.WrapMsg("area code or phone number is empty")
 		}
@@ -317,3 +359,138 @@ func (x *AddUserAccountReq) Check() error {
 
 	return nil
 }
+
+func (x *LoginByLinkReq) Check() error {
+	if x.Token == "" {
+		return errs.ErrArgs.WrapMsg("token is empty")
+	}
+	if x.Platform < constantpb.IOSPlatformID || x.Platform > constantpb.HarmonyOSPlatformID {
+		return errs.ErrArgs.WrapMsg("platform is invalid")
+	}
+	return nil
+}
+
+func (x *ConfirmTwoFactorReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *RegenerateRecoveryCodesReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *OAuthAuthorizeReq) Check() error {
+	if x.Provider == "" {
+		return errs.ErrArgs.WrapMsg("provider is empty")
+	}
+	if x.State == "" {
+		return errs.ErrArgs.WrapMsg("state is empty")
+	}
+	if x.CodeChallenge == "" {
+		return errs.ErrArgs.WrapMsg("codeChallenge is empty")
+	}
+	return nil
+}
+
+func (x *OAuthLoginReq) Check() error {
+	if x.Provider == "" || x.Code == "" || x.CodeVerifier == "" {
+		return errs.ErrArgs.WrapMsg("provider, code and codeVerifier must be set")
+	}
+	if x.Platform < constantpb.IOSPlatformID || x.Platform > constantpb.HarmonyOSPlatformID {
+		return errs.ErrArgs.WrapMsg("platform is invalid")
+	}
+	return nil
+}
+
+func (x *LinkOAuthReq) Check() error {
+	if x.Provider == "" || x.Code == "" || x.CodeVerifier == "" {
+		return errs.ErrArgs.WrapMsg("provider, code and codeVerifier must be set")
+	}
+	return nil
+}
+
+func (x *UnlinkOAuthReq) Check() error {
+	if x.Provider == "" {
+		return errs.ErrArgs.WrapMsg("provider is empty")
+	}
+	return nil
+}
+
+func (x *FinishPasskeyRegistrationReq) Check() error {
+	if x.SessionID == "" || x.Credential == "" {
+		return errs.ErrArgs.WrapMsg("sessionID and credential must be set")
+	}
+	if utf8.RuneCountInString(x.Name) > 64 {
+		return errs.ErrArgs.WrapMsg("name is too long")
+	}
+	return nil
+}
+
+func (x *PasskeyLoginReq) Check() error {
+	if x.SessionID == "" || x.Credential == "" {
+		return errs.ErrArgs.WrapMsg("sessionID and credential must be set")
+	}
+	if x.Platform < constantpb.IOSPlatformID || x.Platform > constantpb.HarmonyOSPlatformID {
+		return errs.ErrArgs.WrapMsg("platform is invalid")
+	}
+	return nil
+}
+
+func (x *DelPasskeyReq) Check() error {
+	if x.CredentialID == "" {
+		return errs.ErrArgs.WrapMsg("credentialID is empty")
+	}
+	return nil
+}
+
+func (x *RequestUserDeletionReq) Check() error {
+	if len(x.Reason) > 512 {
+		return errs.ErrArgs.WrapMsg("reason is too long")
+	}
+	return nil
+}
+
+func (x *FindDueUserDeletionReq) Check() error {
+	if x.Limit <= 0 {
+		return errs.ErrArgs.WrapMsg("limit is invalid")
+	}
+	return nil
+}
+
+func (x *PurgeUserReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	return nil
+}
+
+func (x *RequestContactChangeReq) Check() error {
+	if (x.Email == "") == (x.PhoneNumber == "") {
+		return errs.ErrArgs.WrapMsg("either email or phoneNumber must be set")
+	}
+	if x.Email != "" {
+		return EmailCheck(x.Email)
+	}
+	if x.AreaCode == "" {
+		return errs.ErrArgs.WrapMsg("areaCode is empty")
+	}
+	return nil
+}
+
+func (x *ConfirmContactChangeReq) Check() error {
+	if (x.Email == "") == (x.PhoneNumber == "") {
+		return errs.ErrArgs.WrapMsg("either email or phoneNumber must be set")
+	}
+	if x.Email == "" && x.AreaCode == "" {
+		return errs.ErrArgs.WrapMsg("areaCode is empty")
+	}
+	if x.VerifyCode == "" {
+		return errs.ErrArgs.WrapMsg("verifyCode is empty")
+	}
+	return nil
+}
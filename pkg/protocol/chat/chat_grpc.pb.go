@@ -33,28 +33,57 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Chat_UpdateUserInfo_FullMethodName          = "/openim.chat.chat/UpdateUserInfo"
-	Chat_AddUserAccount_FullMethodName          = "/openim.chat.chat/AddUserAccount"
-	Chat_SearchUserPublicInfo_FullMethodName    = "/openim.chat.chat/SearchUserPublicInfo"
-	Chat_FindUserPublicInfo_FullMethodName      = "/openim.chat.chat/FindUserPublicInfo"
-	Chat_SearchUserFullInfo_FullMethodName      = "/openim.chat.chat/SearchUserFullInfo"
-	Chat_FindUserFullInfo_FullMethodName        = "/openim.chat.chat/FindUserFullInfo"
-	Chat_SendVerifyCode_FullMethodName          = "/openim.chat.chat/SendVerifyCode"
-	Chat_VerifyCode_FullMethodName              = "/openim.chat.chat/VerifyCode"
-	Chat_RegisterUser_FullMethodName            = "/openim.chat.chat/RegisterUser"
-	Chat_Login_FullMethodName                   = "/openim.chat.chat/Login"
-	Chat_ResetPassword_FullMethodName           = "/openim.chat.chat/ResetPassword"
-	Chat_ChangePassword_FullMethodName          = "/openim.chat.chat/ChangePassword"
-	Chat_CheckUserExist_FullMethodName          = "/openim.chat.chat/CheckUserExist"
-	Chat_DelUserAccount_FullMethodName          = "/openim.chat.chat/DelUserAccount"
-	Chat_FindUserAccount_FullMethodName         = "/openim.chat.chat/FindUserAccount"
-	Chat_FindAccountUser_FullMethodName         = "/openim.chat.chat/FindAccountUser"
-	Chat_OpenIMCallback_FullMethodName          = "/openim.chat.chat/OpenIMCallback"
-	Chat_UserLoginCount_FullMethodName          = "/openim.chat.chat/UserLoginCount"
-	Chat_SearchUserInfo_FullMethodName          = "/openim.chat.chat/SearchUserInfo"
-	Chat_GetTokenForVideoMeeting_FullMethodName = "/openim.chat.chat/GetTokenForVideoMeeting"
-	Chat_SetAllowRegister_FullMethodName        = "/openim.chat.chat/SetAllowRegister"
-	Chat_GetAllowRegister_FullMethodName        = "/openim.chat.chat/GetAllowRegister"
+	Chat_UpdateUserInfo_FullMethodName            = "/openim.chat.chat/UpdateUserInfo"
+	Chat_AddUserAccount_FullMethodName            = "/openim.chat.chat/AddUserAccount"
+	Chat_SearchUserPublicInfo_FullMethodName      = "/openim.chat.chat/SearchUserPublicInfo"
+	Chat_FindUserPublicInfo_FullMethodName        = "/openim.chat.chat/FindUserPublicInfo"
+	Chat_SearchUserFullInfo_FullMethodName        = "/openim.chat.chat/SearchUserFullInfo"
+	Chat_FindUserFullInfo_FullMethodName          = "/openim.chat.chat/FindUserFullInfo"
+	Chat_SendVerifyCode_FullMethodName            = "/openim.chat.chat/SendVerifyCode"
+	Chat_VerifyCode_FullMethodName                = "/openim.chat.chat/VerifyCode"
+	Chat_RegisterUser_FullMethodName              = "/openim.chat.chat/RegisterUser"
+	Chat_Login_FullMethodName                     = "/openim.chat.chat/Login"
+	Chat_LoginByLink_FullMethodName               = "/openim.chat.chat/LoginByLink"
+	Chat_ResetPassword_FullMethodName             = "/openim.chat.chat/ResetPassword"
+	Chat_SubmitBanAppeal_FullMethodName           = "/openim.chat.chat/SubmitBanAppeal"
+	Chat_GetBanAppeal_FullMethodName              = "/openim.chat.chat/GetBanAppeal"
+	Chat_ChangePassword_FullMethodName            = "/openim.chat.chat/ChangePassword"
+	Chat_CheckPassword_FullMethodName             = "/openim.chat.chat/CheckPassword"
+	Chat_CheckUserExist_FullMethodName            = "/openim.chat.chat/CheckUserExist"
+	Chat_DelUserAccount_FullMethodName            = "/openim.chat.chat/DelUserAccount"
+	Chat_FindUserAccount_FullMethodName           = "/openim.chat.chat/FindUserAccount"
+	Chat_FindAccountUser_FullMethodName           = "/openim.chat.chat/FindAccountUser"
+	Chat_OpenIMCallback_FullMethodName            = "/openim.chat.chat/OpenIMCallback"
+	Chat_UserLoginCount_FullMethodName            = "/openim.chat.chat/UserLoginCount"
+	Chat_SearchUserInfo_FullMethodName            = "/openim.chat.chat/SearchUserInfo"
+	Chat_GetTokenForVideoMeeting_FullMethodName   = "/openim.chat.chat/GetTokenForVideoMeeting"
+	Chat_SetAllowRegister_FullMethodName          = "/openim.chat.chat/SetAllowRegister"
+	Chat_GetAllowRegister_FullMethodName          = "/openim.chat.chat/GetAllowRegister"
+	Chat_SetupTwoFactor_FullMethodName            = "/openim.chat.chat/SetupTwoFactor"
+	Chat_ConfirmTwoFactor_FullMethodName          = "/openim.chat.chat/ConfirmTwoFactor"
+	Chat_DisableTwoFactor_FullMethodName          = "/openim.chat.chat/DisableTwoFactor"
+	Chat_RegenerateRecoveryCodes_FullMethodName   = "/openim.chat.chat/RegenerateRecoveryCodes"
+	Chat_GetTwoFactor_FullMethodName              = "/openim.chat.chat/GetTwoFactor"
+	Chat_GetOAuthProviders_FullMethodName         = "/openim.chat.chat/GetOAuthProviders"
+	Chat_OAuthAuthorize_FullMethodName            = "/openim.chat.chat/OAuthAuthorize"
+	Chat_OAuthLogin_FullMethodName                = "/openim.chat.chat/OAuthLogin"
+	Chat_LinkOAuth_FullMethodName                 = "/openim.chat.chat/LinkOAuth"
+	Chat_UnlinkOAuth_FullMethodName               = "/openim.chat.chat/UnlinkOAuth"
+	Chat_GetOAuthIdentities_FullMethodName        = "/openim.chat.chat/GetOAuthIdentities"
+	Chat_FindLDAPUser_FullMethodName              = "/openim.chat.chat/FindLDAPUser"
+	Chat_BeginPasskeyRegistration_FullMethodName  = "/openim.chat.chat/BeginPasskeyRegistration"
+	Chat_FinishPasskeyRegistration_FullMethodName = "/openim.chat.chat/FinishPasskeyRegistration"
+	Chat_BeginPasskeyLogin_FullMethodName         = "/openim.chat.chat/BeginPasskeyLogin"
+	Chat_PasskeyLogin_FullMethodName              = "/openim.chat.chat/PasskeyLogin"
+	Chat_GetPasskeys_FullMethodName               = "/openim.chat.chat/GetPasskeys"
+	Chat_DelPasskey_FullMethodName                = "/openim.chat.chat/DelPasskey"
+	Chat_RequestUserDeletion_FullMethodName       = "/openim.chat.chat/RequestUserDeletion"
+	Chat_FindDueUserDeletion_FullMethodName       = "/openim.chat.chat/FindDueUserDeletion"
+	Chat_PurgeUser_FullMethodName                 = "/openim.chat.chat/PurgeUser"
+	Chat_RequestContactChange_FullMethodName      = "/openim.chat.chat/RequestContactChange"
+	Chat_ConfirmContactChange_FullMethodName      = "/openim.chat.chat/ConfirmContactChange"
+	Chat_RequestUserExport_FullMethodName         = "/openim.chat.chat/RequestUserExport"
+	Chat_GetUserExport_FullMethodName             = "/openim.chat.chat/GetUserExport"
 )
 
 // ChatClient is the client API for Chat service.
@@ -74,8 +103,12 @@ type ChatClient interface {
 	VerifyCode(ctx context.Context, in *VerifyCodeReq, opts ...grpc.CallOption) (*VerifyCodeResp, error)
 	RegisterUser(ctx context.Context, in *RegisterUserReq, opts ...grpc.CallOption) (*RegisterUserResp, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+	LoginByLink(ctx context.Context, in *LoginByLinkReq, opts ...grpc.CallOption) (*LoginResp, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
+	SubmitBanAppeal(ctx context.Context, in *SubmitBanAppealReq, opts ...grpc.CallOption) (*SubmitBanAppealResp, error)
+	GetBanAppeal(ctx context.Context, in *GetBanAppealReq, opts ...grpc.CallOption) (*GetBanAppealResp, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+	CheckPassword(ctx context.Context, in *CheckPasswordReq, opts ...grpc.CallOption) (*CheckPasswordResp, error)
 	CheckUserExist(ctx context.Context, in *CheckUserExistReq, opts ...grpc.CallOption) (*CheckUserExistResp, error)
 	DelUserAccount(ctx context.Context, in *DelUserAccountReq, opts ...grpc.CallOption) (*DelUserAccountResp, error)
 	FindUserAccount(ctx context.Context, in *FindUserAccountReq, opts ...grpc.CallOption) (*FindUserAccountResp, error)
@@ -88,6 +121,38 @@ type ChatClient interface {
 	GetTokenForVideoMeeting(ctx context.Context, in *GetTokenForVideoMeetingReq, opts ...grpc.CallOption) (*GetTokenForVideoMeetingResp, error)
 	SetAllowRegister(ctx context.Context, in *SetAllowRegisterReq, opts ...grpc.CallOption) (*SetAllowRegisterResp, error)
 	GetAllowRegister(ctx context.Context, in *GetAllowRegisterReq, opts ...grpc.CallOption) (*GetAllowRegisterResp, error)
+	// Two-factor authentication
+	SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error)
+	ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq, opts ...grpc.CallOption) (*ConfirmTwoFactorResp, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResp, error)
+	GetTwoFactor(ctx context.Context, in *GetTwoFactorReq, opts ...grpc.CallOption) (*GetTwoFactorResp, error)
+	// OAuth
+	GetOAuthProviders(ctx context.Context, in *GetOAuthProvidersReq, opts ...grpc.CallOption) (*GetOAuthProvidersResp, error)
+	OAuthAuthorize(ctx context.Context, in *OAuthAuthorizeReq, opts ...grpc.CallOption) (*OAuthAuthorizeResp, error)
+	OAuthLogin(ctx context.Context, in *OAuthLoginReq, opts ...grpc.CallOption) (*OAuthLoginResp, error)
+	LinkOAuth(ctx context.Context, in *LinkOAuthReq, opts ...grpc.CallOption) (*LinkOAuthResp, error)
+	UnlinkOAuth(ctx context.Context, in *UnlinkOAuthReq, opts ...grpc.CallOption) (*UnlinkOAuthResp, error)
+	GetOAuthIdentities(ctx context.Context, in *GetOAuthIdentitiesReq, opts ...grpc.CallOption) (*GetOAuthIdentitiesResp, error)
+	// Directory users, used by the directory sync
+	FindLDAPUser(ctx context.Context, in *FindLDAPUserReq, opts ...grpc.CallOption) (*FindLDAPUserResp, error)
+	// Passkey
+	BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationReq, opts ...grpc.CallOption) (*BeginPasskeyRegistrationResp, error)
+	FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationReq, opts ...grpc.CallOption) (*FinishPasskeyRegistrationResp, error)
+	BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginReq, opts ...grpc.CallOption) (*BeginPasskeyLoginResp, error)
+	PasskeyLogin(ctx context.Context, in *PasskeyLoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+	GetPasskeys(ctx context.Context, in *GetPasskeysReq, opts ...grpc.CallOption) (*GetPasskeysResp, error)
+	DelPasskey(ctx context.Context, in *DelPasskeyReq, opts ...grpc.CallOption) (*DelPasskeyResp, error)
+	// Account deletion, the user requests it and the admin rpc purges the user after the cooldown
+	RequestUserDeletion(ctx context.Context, in *RequestUserDeletionReq, opts ...grpc.CallOption) (*RequestUserDeletionResp, error)
+	FindDueUserDeletion(ctx context.Context, in *FindDueUserDeletionReq, opts ...grpc.CallOption) (*FindDueUserDeletionResp, error)
+	PurgeUser(ctx context.Context, in *PurgeUserReq, opts ...grpc.CallOption) (*PurgeUserResp, error)
+	// Change of the email or phone number, a code sent to the new one confirms it
+	RequestContactChange(ctx context.Context, in *RequestContactChangeReq, opts ...grpc.CallOption) (*RequestContactChangeResp, error)
+	ConfirmContactChange(ctx context.Context, in *ConfirmContactChangeReq, opts ...grpc.CallOption) (*ConfirmContactChangeResp, error)
+	// Personal data export, the archive is written in the background and the user is notified when it is ready
+	RequestUserExport(ctx context.Context, in *RequestUserExportReq, opts ...grpc.CallOption) (*RequestUserExportResp, error)
+	GetUserExport(ctx context.Context, in *GetUserExportReq, opts ...grpc.CallOption) (*GetUserExportResp, error)
 }
 
 type chatClient struct {
@@ -198,6 +263,16 @@ func (c *chatClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *chatClient) LoginByLink(ctx context.Context, in *LoginByLinkReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Chat_LoginByLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetPasswordResp)
@@ -208,6 +283,26 @@ func (c *chatClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, op
 	return out, nil
 }
 
+func (c *chatClient) SubmitBanAppeal(ctx context.Context, in *SubmitBanAppealReq, opts ...grpc.CallOption) (*SubmitBanAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitBanAppealResp)
+	err := c.cc.Invoke(ctx, Chat_SubmitBanAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetBanAppeal(ctx context.Context, in *GetBanAppealReq, opts ...grpc.CallOption) (*GetBanAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBanAppealResp)
+	err := c.cc.Invoke(ctx, Chat_GetBanAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ChangePasswordResp)
@@ -218,6 +313,16 @@ func (c *chatClient) ChangePassword(ctx context.Context, in *ChangePasswordReq,
 	return out, nil
 }
 
+func (c *chatClient) CheckPassword(ctx context.Context, in *CheckPasswordReq, opts ...grpc.CallOption) (*CheckPasswordResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPasswordResp)
+	err := c.cc.Invoke(ctx, Chat_CheckPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) CheckUserExist(ctx context.Context, in *CheckUserExistReq, opts ...grpc.CallOption) (*CheckUserExistResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckUserExistResp)
@@ -318,6 +423,256 @@ func (c *chatClient) GetAllowRegister(ctx context.Context, in *GetAllowRegisterR
 	return out, nil
 }
 
+func (c *chatClient) SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupTwoFactorResp)
+	err := c.cc.Invoke(ctx, Chat_SetupTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorReq, opts ...grpc.CallOption) (*ConfirmTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTwoFactorResp)
+	err := c.cc.Invoke(ctx, Chat_ConfirmTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResp)
+	err := c.cc.Invoke(ctx, Chat_DisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Chat_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetTwoFactor(ctx context.Context, in *GetTwoFactorReq, opts ...grpc.CallOption) (*GetTwoFactorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTwoFactorResp)
+	err := c.cc.Invoke(ctx, Chat_GetTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetOAuthProviders(ctx context.Context, in *GetOAuthProvidersReq, opts ...grpc.CallOption) (*GetOAuthProvidersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOAuthProvidersResp)
+	err := c.cc.Invoke(ctx, Chat_GetOAuthProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) OAuthAuthorize(ctx context.Context, in *OAuthAuthorizeReq, opts ...grpc.CallOption) (*OAuthAuthorizeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthAuthorizeResp)
+	err := c.cc.Invoke(ctx, Chat_OAuthAuthorize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) OAuthLogin(ctx context.Context, in *OAuthLoginReq, opts ...grpc.CallOption) (*OAuthLoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthLoginResp)
+	err := c.cc.Invoke(ctx, Chat_OAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) LinkOAuth(ctx context.Context, in *LinkOAuthReq, opts ...grpc.CallOption) (*LinkOAuthResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkOAuthResp)
+	err := c.cc.Invoke(ctx, Chat_LinkOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) UnlinkOAuth(ctx context.Context, in *UnlinkOAuthReq, opts ...grpc.CallOption) (*UnlinkOAuthResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkOAuthResp)
+	err := c.cc.Invoke(ctx, Chat_UnlinkOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetOAuthIdentities(ctx context.Context, in *GetOAuthIdentitiesReq, opts ...grpc.CallOption) (*GetOAuthIdentitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOAuthIdentitiesResp)
+	err := c.cc.Invoke(ctx, Chat_GetOAuthIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindLDAPUser(ctx context.Context, in *FindLDAPUserReq, opts ...grpc.CallOption) (*FindLDAPUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindLDAPUserResp)
+	err := c.cc.Invoke(ctx, Chat_FindLDAPUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationReq, opts ...grpc.CallOption) (*BeginPasskeyRegistrationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginPasskeyRegistrationResp)
+	err := c.cc.Invoke(ctx, Chat_BeginPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationReq, opts ...grpc.CallOption) (*FinishPasskeyRegistrationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishPasskeyRegistrationResp)
+	err := c.cc.Invoke(ctx, Chat_FinishPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginReq, opts ...grpc.CallOption) (*BeginPasskeyLoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginPasskeyLoginResp)
+	err := c.cc.Invoke(ctx, Chat_BeginPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) PasskeyLogin(ctx context.Context, in *PasskeyLoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Chat_PasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetPasskeys(ctx context.Context, in *GetPasskeysReq, opts ...grpc.CallOption) (*GetPasskeysResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPasskeysResp)
+	err := c.cc.Invoke(ctx, Chat_GetPasskeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DelPasskey(ctx context.Context, in *DelPasskeyReq, opts ...grpc.CallOption) (*DelPasskeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelPasskeyResp)
+	err := c.cc.Invoke(ctx, Chat_DelPasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RequestUserDeletion(ctx context.Context, in *RequestUserDeletionReq, opts ...grpc.CallOption) (*RequestUserDeletionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserDeletionResp)
+	err := c.cc.Invoke(ctx, Chat_RequestUserDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindDueUserDeletion(ctx context.Context, in *FindDueUserDeletionReq, opts ...grpc.CallOption) (*FindDueUserDeletionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDueUserDeletionResp)
+	err := c.cc.Invoke(ctx, Chat_FindDueUserDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) PurgeUser(ctx context.Context, in *PurgeUserReq, opts ...grpc.CallOption) (*PurgeUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeUserResp)
+	err := c.cc.Invoke(ctx, Chat_PurgeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RequestContactChange(ctx context.Context, in *RequestContactChangeReq, opts ...grpc.CallOption) (*RequestContactChangeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestContactChangeResp)
+	err := c.cc.Invoke(ctx, Chat_RequestContactChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ConfirmContactChange(ctx context.Context, in *ConfirmContactChangeReq, opts ...grpc.CallOption) (*ConfirmContactChangeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmContactChangeResp)
+	err := c.cc.Invoke(ctx, Chat_ConfirmContactChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RequestUserExport(ctx context.Context, in *RequestUserExportReq, opts ...grpc.CallOption) (*RequestUserExportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserExportResp)
+	err := c.cc.Invoke(ctx, Chat_RequestUserExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetUserExport(ctx context.Context, in *GetUserExportReq, opts ...grpc.CallOption) (*GetUserExportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserExportResp)
+	err := c.cc.Invoke(ctx, Chat_GetUserExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServer is the server API for Chat service.
 // All implementations must embed UnimplementedChatServer
 // for forward compatibility.
@@ -335,8 +690,12 @@ type ChatServer interface {
 	VerifyCode(context.Context, *VerifyCodeReq) (*VerifyCodeResp, error)
 	RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error)
 	Login(context.Context, *LoginReq) (*LoginResp, error)
+	LoginByLink(context.Context, *LoginByLinkReq) (*LoginResp, error)
 	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
+	SubmitBanAppeal(context.Context, *SubmitBanAppealReq) (*SubmitBanAppealResp, error)
+	GetBanAppeal(context.Context, *GetBanAppealReq) (*GetBanAppealResp, error)
 	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
+	CheckPassword(context.Context, *CheckPasswordReq) (*CheckPasswordResp, error)
 	CheckUserExist(context.Context, *CheckUserExistReq) (*CheckUserExistResp, error)
 	DelUserAccount(context.Context, *DelUserAccountReq) (*DelUserAccountResp, error)
 	FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error)
@@ -349,6 +708,38 @@ type ChatServer interface {
 	GetTokenForVideoMeeting(context.Context, *GetTokenForVideoMeetingReq) (*GetTokenForVideoMeetingResp, error)
 	SetAllowRegister(context.Context, *SetAllowRegisterReq) (*SetAllowRegisterResp, error)
 	GetAllowRegister(context.Context, *GetAllowRegisterReq) (*GetAllowRegisterResp, error)
+	// Two-factor authentication
+	SetupTwoFactor(context.Context, *SetupTwoFactorReq) (*SetupTwoFactorResp, error)
+	ConfirmTwoFactor(context.Context, *ConfirmTwoFactorReq) (*ConfirmTwoFactorResp, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorReq) (*DisableTwoFactorResp, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RegenerateRecoveryCodesResp, error)
+	GetTwoFactor(context.Context, *GetTwoFactorReq) (*GetTwoFactorResp, error)
+	// OAuth
+	GetOAuthProviders(context.Context, *GetOAuthProvidersReq) (*GetOAuthProvidersResp, error)
+	OAuthAuthorize(context.Context, *OAuthAuthorizeReq) (*OAuthAuthorizeResp, error)
+	OAuthLogin(context.Context, *OAuthLoginReq) (*OAuthLoginResp, error)
+	LinkOAuth(context.Context, *LinkOAuthReq) (*LinkOAuthResp, error)
+	UnlinkOAuth(context.Context, *UnlinkOAuthReq) (*UnlinkOAuthResp, error)
+	GetOAuthIdentities(context.Context, *GetOAuthIdentitiesReq) (*GetOAuthIdentitiesResp, error)
+	// Directory users, used by the directory sync
+	FindLDAPUser(context.Context, *FindLDAPUserReq) (*FindLDAPUserResp, error)
+	// Passkey
+	BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationReq) (*BeginPasskeyRegistrationResp, error)
+	FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationReq) (*FinishPasskeyRegistrationResp, error)
+	BeginPasskeyLogin(context.Context, *BeginPasskeyLoginReq) (*BeginPasskeyLoginResp, error)
+	PasskeyLogin(context.Context, *PasskeyLoginReq) (*LoginResp, error)
+	GetPasskeys(context.Context, *GetPasskeysReq) (*GetPasskeysResp, error)
+	DelPasskey(context.Context, *DelPasskeyReq) (*DelPasskeyResp, error)
+	// Account deletion, the user requests it and the admin rpc purges the user after the cooldown
+	RequestUserDeletion(context.Context, *RequestUserDeletionReq) (*RequestUserDeletionResp, error)
+	FindDueUserDeletion(context.Context, *FindDueUserDeletionReq) (*FindDueUserDeletionResp, error)
+	PurgeUser(context.Context, *PurgeUserReq) (*PurgeUserResp, error)
+	// Change of the email or phone number, a code sent to the new one confirms it
+	RequestContactChange(context.Context, *RequestContactChangeReq) (*RequestContactChangeResp, error)
+	ConfirmContactChange(context.Context, *ConfirmContactChangeReq) (*ConfirmContactChangeResp, error)
+	// Personal data export, the archive is written in the background and the user is notified when it is ready
+	RequestUserExport(context.Context, *RequestUserExportReq) (*RequestUserExportResp, error)
+	GetUserExport(context.Context, *GetUserExportReq) (*GetUserExportResp, error)
 	mustEmbedUnimplementedChatServer()
 }
 
@@ -389,12 +780,24 @@ func (UnimplementedChatServer) RegisterUser(context.Context, *RegisterUserReq) (
 func (UnimplementedChatServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedChatServer) LoginByLink(context.Context, *LoginByLinkReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByLink not implemented")
+}
 func (UnimplementedChatServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
 }
+func (UnimplementedChatServer) SubmitBanAppeal(context.Context, *SubmitBanAppealReq) (*SubmitBanAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBanAppeal not implemented")
+}
+func (UnimplementedChatServer) GetBanAppeal(context.Context, *GetBanAppealReq) (*GetBanAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBanAppeal not implemented")
+}
 func (UnimplementedChatServer) ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedChatServer) CheckPassword(context.Context, *CheckPasswordReq) (*CheckPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPassword not implemented")
+}
 func (UnimplementedChatServer) CheckUserExist(context.Context, *CheckUserExistReq) (*CheckUserExistResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckUserExist not implemented")
 }
@@ -425,6 +828,81 @@ func (UnimplementedChatServer) SetAllowRegister(context.Context, *SetAllowRegist
 func (UnimplementedChatServer) GetAllowRegister(context.Context, *GetAllowRegisterReq) (*GetAllowRegisterResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllowRegister not implemented")
 }
+func (UnimplementedChatServer) SetupTwoFactor(context.Context, *SetupTwoFactorReq) (*SetupTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupTwoFactor not implemented")
+}
+func (UnimplementedChatServer) ConfirmTwoFactor(context.Context, *ConfirmTwoFactorReq) (*ConfirmTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactor not implemented")
+}
+func (UnimplementedChatServer) DisableTwoFactor(context.Context, *DisableTwoFactorReq) (*DisableTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedChatServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RegenerateRecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedChatServer) GetTwoFactor(context.Context, *GetTwoFactorReq) (*GetTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactor not implemented")
+}
+func (UnimplementedChatServer) GetOAuthProviders(context.Context, *GetOAuthProvidersReq) (*GetOAuthProvidersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthProviders not implemented")
+}
+func (UnimplementedChatServer) OAuthAuthorize(context.Context, *OAuthAuthorizeReq) (*OAuthAuthorizeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthAuthorize not implemented")
+}
+func (UnimplementedChatServer) OAuthLogin(context.Context, *OAuthLoginReq) (*OAuthLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthLogin not implemented")
+}
+func (UnimplementedChatServer) LinkOAuth(context.Context, *LinkOAuthReq) (*LinkOAuthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOAuth not implemented")
+}
+func (UnimplementedChatServer) UnlinkOAuth(context.Context, *UnlinkOAuthReq) (*UnlinkOAuthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkOAuth not implemented")
+}
+func (UnimplementedChatServer) GetOAuthIdentities(context.Context, *GetOAuthIdentitiesReq) (*GetOAuthIdentitiesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthIdentities not implemented")
+}
+func (UnimplementedChatServer) FindLDAPUser(context.Context, *FindLDAPUserReq) (*FindLDAPUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindLDAPUser not implemented")
+}
+func (UnimplementedChatServer) BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationReq) (*BeginPasskeyRegistrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeyRegistration not implemented")
+}
+func (UnimplementedChatServer) FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationReq) (*FinishPasskeyRegistrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPasskeyRegistration not implemented")
+}
+func (UnimplementedChatServer) BeginPasskeyLogin(context.Context, *BeginPasskeyLoginReq) (*BeginPasskeyLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeyLogin not implemented")
+}
+func (UnimplementedChatServer) PasskeyLogin(context.Context, *PasskeyLoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasskeyLogin not implemented")
+}
+func (UnimplementedChatServer) GetPasskeys(context.Context, *GetPasskeysReq) (*GetPasskeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPasskeys not implemented")
+}
+func (UnimplementedChatServer) DelPasskey(context.Context, *DelPasskeyReq) (*DelPasskeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelPasskey not implemented")
+}
+func (UnimplementedChatServer) RequestUserDeletion(context.Context, *RequestUserDeletionReq) (*RequestUserDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserDeletion not implemented")
+}
+func (UnimplementedChatServer) FindDueUserDeletion(context.Context, *FindDueUserDeletionReq) (*FindDueUserDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDueUserDeletion not implemented")
+}
+func (UnimplementedChatServer) PurgeUser(context.Context, *PurgeUserReq) (*PurgeUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUser not implemented")
+}
+func (UnimplementedChatServer) RequestContactChange(context.Context, *RequestContactChangeReq) (*RequestContactChangeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestContactChange not implemented")
+}
+func (UnimplementedChatServer) ConfirmContactChange(context.Context, *ConfirmContactChangeReq) (*ConfirmContactChangeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmContactChange not implemented")
+}
+func (UnimplementedChatServer) RequestUserExport(context.Context, *RequestUserExportReq) (*RequestUserExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserExport not implemented")
+}
+func (UnimplementedChatServer) GetUserExport(context.Context, *GetUserExportReq) (*GetUserExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserExport not implemented")
+}
 func (UnimplementedChatServer) mustEmbedUnimplementedChatServer() {}
 func (UnimplementedChatServer) testEmbeddedByValue()              {}
 
@@ -626,62 +1104,134 @@ func _Chat_Login_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordReq)
+func _Chat_LoginByLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ResetPassword(ctx, in)
+		return srv.(ChatServer).LoginByLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Chat_ResetPassword_FullMethodName,
+		FullMethod: Chat_LoginByLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+		return srv.(ChatServer).LoginByLink(ctx, req.(*LoginByLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordReq)
+func _Chat_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ChangePassword(ctx, in)
+		return srv.(ChatServer).ResetPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Chat_ChangePassword_FullMethodName,
+		FullMethod: Chat_ResetPassword_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+		return srv.(ChatServer).ResetPassword(ctx, req.(*ResetPasswordReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_CheckUserExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckUserExistReq)
+func _Chat_SubmitBanAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBanAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).CheckUserExist(ctx, in)
+		return srv.(ChatServer).SubmitBanAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Chat_CheckUserExist_FullMethodName,
+		FullMethod: Chat_SubmitBanAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).CheckUserExist(ctx, req.(*CheckUserExistReq))
+		return srv.(ChatServer).SubmitBanAppeal(ctx, req.(*SubmitBanAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_DelUserAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelUserAccountReq)
+func _Chat_GetBanAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBanAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetBanAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetBanAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetBanAppeal(ctx, req.(*GetBanAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_CheckPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).CheckPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_CheckPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).CheckPassword(ctx, req.(*CheckPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_CheckUserExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserExistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).CheckUserExist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_CheckUserExist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).CheckUserExist(ctx, req.(*CheckUserExistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DelUserAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelUserAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -842,6 +1392,456 @@ func _Chat_GetAllowRegister_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_SetupTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupTwoFactorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SetupTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SetupTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SetupTwoFactor(ctx, req.(*SetupTwoFactorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ConfirmTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ConfirmTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_ConfirmTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ConfirmTwoFactor(ctx, req.(*ConfirmTwoFactorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_DisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwoFactorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetTwoFactor(ctx, req.(*GetTwoFactorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetOAuthProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthProvidersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetOAuthProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetOAuthProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetOAuthProviders(ctx, req.(*GetOAuthProvidersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_OAuthAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthAuthorizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).OAuthAuthorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_OAuthAuthorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).OAuthAuthorize(ctx, req.(*OAuthAuthorizeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_OAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).OAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_OAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).OAuthLogin(ctx, req.(*OAuthLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_LinkOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkOAuthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).LinkOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_LinkOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).LinkOAuth(ctx, req.(*LinkOAuthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_UnlinkOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkOAuthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).UnlinkOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_UnlinkOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).UnlinkOAuth(ctx, req.(*UnlinkOAuthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetOAuthIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthIdentitiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetOAuthIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetOAuthIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetOAuthIdentities(ctx, req.(*GetOAuthIdentitiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindLDAPUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindLDAPUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindLDAPUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_FindLDAPUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindLDAPUser(ctx, req.(*FindLDAPUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_BeginPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyRegistrationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).BeginPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_BeginPasskeyRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).BeginPasskeyRegistration(ctx, req.(*BeginPasskeyRegistrationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FinishPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPasskeyRegistrationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FinishPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_FinishPasskeyRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FinishPasskeyRegistration(ctx, req.(*FinishPasskeyRegistrationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_BeginPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).BeginPasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_BeginPasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).BeginPasskeyLogin(ctx, req.(*BeginPasskeyLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_PasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasskeyLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).PasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_PasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).PasskeyLogin(ctx, req.(*PasskeyLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetPasskeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasskeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetPasskeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetPasskeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetPasskeys(ctx, req.(*GetPasskeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DelPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelPasskeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DelPasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_DelPasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DelPasskey(ctx, req.(*DelPasskeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RequestUserDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RequestUserDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_RequestUserDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RequestUserDeletion(ctx, req.(*RequestUserDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindDueUserDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDueUserDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindDueUserDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_FindDueUserDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindDueUserDeletion(ctx, req.(*FindDueUserDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_PurgeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).PurgeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_PurgeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).PurgeUser(ctx, req.(*PurgeUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RequestContactChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestContactChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RequestContactChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_RequestContactChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RequestContactChange(ctx, req.(*RequestContactChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ConfirmContactChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmContactChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ConfirmContactChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_ConfirmContactChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ConfirmContactChange(ctx, req.(*ConfirmContactChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RequestUserExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RequestUserExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_RequestUserExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RequestUserExport(ctx, req.(*RequestUserExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetUserExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetUserExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetUserExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetUserExport(ctx, req.(*GetUserExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Chat_ServiceDesc is the grpc.ServiceDesc for Chat service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -889,14 +1889,30 @@ var Chat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Chat_Login_Handler,
 		},
+		{
+			MethodName: "LoginByLink",
+			Handler:    _Chat_LoginByLink_Handler,
+		},
 		{
 			MethodName: "ResetPassword",
 			Handler:    _Chat_ResetPassword_Handler,
 		},
+		{
+			MethodName: "SubmitBanAppeal",
+			Handler:    _Chat_SubmitBanAppeal_Handler,
+		},
+		{
+			MethodName: "GetBanAppeal",
+			Handler:    _Chat_GetBanAppeal_Handler,
+		},
 		{
 			MethodName: "ChangePassword",
 			Handler:    _Chat_ChangePassword_Handler,
 		},
+		{
+			MethodName: "CheckPassword",
+			Handler:    _Chat_CheckPassword_Handler,
+		},
 		{
 			MethodName: "CheckUserExist",
 			Handler:    _Chat_CheckUserExist_Handler,
@@ -937,6 +1953,106 @@ var Chat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllowRegister",
 			Handler:    _Chat_GetAllowRegister_Handler,
 		},
+		{
+			MethodName: "SetupTwoFactor",
+			Handler:    _Chat_SetupTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactor",
+			Handler:    _Chat_ConfirmTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _Chat_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Chat_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetTwoFactor",
+			Handler:    _Chat_GetTwoFactor_Handler,
+		},
+		{
+			MethodName: "GetOAuthProviders",
+			Handler:    _Chat_GetOAuthProviders_Handler,
+		},
+		{
+			MethodName: "OAuthAuthorize",
+			Handler:    _Chat_OAuthAuthorize_Handler,
+		},
+		{
+			MethodName: "OAuthLogin",
+			Handler:    _Chat_OAuthLogin_Handler,
+		},
+		{
+			MethodName: "LinkOAuth",
+			Handler:    _Chat_LinkOAuth_Handler,
+		},
+		{
+			MethodName: "UnlinkOAuth",
+			Handler:    _Chat_UnlinkOAuth_Handler,
+		},
+		{
+			MethodName: "GetOAuthIdentities",
+			Handler:    _Chat_GetOAuthIdentities_Handler,
+		},
+		{
+			MethodName: "FindLDAPUser",
+			Handler:    _Chat_FindLDAPUser_Handler,
+		},
+		{
+			MethodName: "BeginPasskeyRegistration",
+			Handler:    _Chat_BeginPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyRegistration",
+			Handler:    _Chat_FinishPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "BeginPasskeyLogin",
+			Handler:    _Chat_BeginPasskeyLogin_Handler,
+		},
+		{
+			MethodName: "PasskeyLogin",
+			Handler:    _Chat_PasskeyLogin_Handler,
+		},
+		{
+			MethodName: "GetPasskeys",
+			Handler:    _Chat_GetPasskeys_Handler,
+		},
+		{
+			MethodName: "DelPasskey",
+			Handler:    _Chat_DelPasskey_Handler,
+		},
+		{
+			MethodName: "RequestUserDeletion",
+			Handler:    _Chat_RequestUserDeletion_Handler,
+		},
+		{
+			MethodName: "FindDueUserDeletion",
+			Handler:    _Chat_FindDueUserDeletion_Handler,
+		},
+		{
+			MethodName: "PurgeUser",
+			Handler:    _Chat_PurgeUser_Handler,
+		},
+		{
+			MethodName: "RequestContactChange",
+			Handler:    _Chat_RequestContactChange_Handler,
+		},
+		{
+			MethodName: "ConfirmContactChange",
+			Handler:    _Chat_ConfirmContactChange_Handler,
+		},
+		{
+			MethodName: "RequestUserExport",
+			Handler:    _Chat_RequestUserExport_Handler,
+		},
+		{
+			MethodName: "GetUserExport",
+			Handler:    _Chat_GetUserExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat/chat.proto",
@@ -62,11 +62,44 @@ func (o *AdminClient) CheckRegister(ctx context.Context, ip string) error {
 	return err
 }
 
+func (o *AdminClient) CheckCaptcha(ctx context.Context, ip string, captchaID string, captchaAnswer string) error {
+	_, err := o.client.CheckCaptcha(ctx, &admin.CheckCaptchaReq{Ip: ip, CaptchaID: captchaID, CaptchaAnswer: captchaAnswer})
+	return err
+}
+
+// GetTestAccount returns the test account of account, nil when it is not one or has expired.
+func (o *AdminClient) GetTestAccount(ctx context.Context, account string) (*admin.TestAccount, error) {
+	resp, err := o.client.GetTestAccount(ctx, &admin.GetTestAccountReq{Account: account})
+	if err != nil {
+		return nil, err
+	}
+	return resp.TestAccount, nil
+}
+
+// CheckTestAccountCode checks the code of a test account, false when account is not one or has expired.
+func (o *AdminClient) CheckTestAccountCode(ctx context.Context, account string, code string) (bool, error) {
+	resp, err := o.client.CheckTestAccountCode(ctx, &admin.CheckTestAccountCodeReq{Account: account, Code: code})
+	if err != nil {
+		return false, err
+	}
+	return resp.TestAccount, nil
+}
+
 func (o *AdminClient) CheckLogin(ctx context.Context, userID string, ip string) error {
 	_, err := o.client.CheckLoginForbidden(ctx, &admin.CheckLoginForbiddenReq{Ip: ip, UserID: userID})
 	return err
 }
 
+func (o *AdminClient) LoginFailed(ctx context.Context, userID string, userType int32, ip string) error {
+	_, err := o.client.LoginFailed(ctx, &admin.LoginFailedReq{UserID: userID, UserType: userType, Ip: ip})
+	return err
+}
+
+func (o *AdminClient) LoginSucceeded(ctx context.Context, userID string, userType int32) error {
+	_, err := o.client.LoginSucceeded(ctx, &admin.LoginSucceededReq{UserID: userID, UserType: userType})
+	return err
+}
+
 func (o *AdminClient) UseInvitationCode(ctx context.Context, userID string, invitationCode string) error {
 	_, err := o.client.UseInvitationCode(ctx, &admin.UseInvitationCodeReq{UserID: userID, Code: invitationCode})
 	return err
@@ -83,8 +116,8 @@ func (o *AdminClient) CheckNilOrAdmin(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32) (*admin.CreateTokenResp, error) {
-	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType})
+func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32, platformID int32, deviceID string, ip string) (*admin.CreateTokenResp, error) {
+	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType, PlatformID: platformID, DeviceID: deviceID, Ip: ip})
 }
 
 func (o *AdminClient) GetDefaultFriendUserID(ctx context.Context) ([]string, error) {
@@ -107,3 +140,20 @@ func (o *AdminClient) InvalidateToken(ctx context.Context, userID string) error
 	_, err := o.client.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: userID})
 	return err
 }
+
+func (o *AdminClient) SubmitBanAppeal(ctx context.Context, userID string, content string) (string, error) {
+	resp, err := o.client.SubmitBanAppeal(ctx, &admin.SubmitBanAppealReq{UserID: userID, Content: content})
+	if err != nil {
+		return "", err
+	}
+	return resp.AppealID, nil
+}
+
+// GetBanAppeal returns the active block of the user and the newest appeal, each nil when there is none.
+func (o *AdminClient) GetBanAppeal(ctx context.Context, userID string) (*admin.BlockInfo, *admin.BanAppeal, error) {
+	resp, err := o.client.GetUserBanAppeal(ctx, &admin.GetUserBanAppealReq{UserID: userID})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Block, resp.Appeal, nil
+}
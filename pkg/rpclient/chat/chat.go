@@ -113,3 +113,32 @@ func (o *ChatClient) DelUserAccount(ctx context.Context, req *chat.DelUserAccoun
 	resp, err = o.client.DelUserAccount(ctx, req)
 	return resp, err
 }
+
+func (o *ChatClient) AddUserAccount(ctx context.Context, req *chat.AddUserAccountReq) (string, error) {
+	resp, err := o.client.AddUserAccount(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.UserID, nil
+}
+
+func (o *ChatClient) FindLDAPUser(ctx context.Context) (map[string]string, error) {
+	resp, err := o.client.FindLDAPUser(ctx, &chat.FindLDAPUserReq{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UsernameUserMap, nil
+}
+
+func (o *ChatClient) FindDueUserDeletion(ctx context.Context, limit int32) ([]string, error) {
+	resp, err := o.client.FindDueUserDeletion(ctx, &chat.FindDueUserDeletionReq{Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIDs, nil
+}
+
+func (o *ChatClient) PurgeUser(ctx context.Context, userID string) error {
+	_, err := o.client.PurgeUser(ctx, &chat.PurgeUserReq{UserID: userID})
+	return err
+}
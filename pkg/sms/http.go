@@ -0,0 +1,97 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// HTTPConfig describes a request to a generic SMS gateway or webhook. Body is a template executed with
+// MessageData, e.g. {"to":{{json .Phone}},"text":{{json .Message}}}.
+type HTTPConfig struct {
+	URL     string
+	Method  string // defaults to POST
+	Headers map[string]string
+	Body    string
+	Message string
+}
+
+// NewHTTP sends by calling a configurable HTTP endpoint, any 2xx response is a success.
+func NewHTTP(conf HTTPConfig) (SMS, error) {
+	if conf.URL == "" {
+		return nil, errs.New("http sms url must be set")
+	}
+	msg, err := newMessage(conf.Message)
+	if err != nil {
+		return nil, err
+	}
+	body, err := parseTemplate("body", conf.Body)
+	if err != nil {
+		return nil, err
+	}
+	method := strings.ToUpper(conf.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+	header := http.Header{}
+	for key, value := range conf.Headers {
+		header.Set(key, value)
+	}
+	return &httpSMS{
+		url:     conf.URL,
+		method:  method,
+		header:  header,
+		body:    body,
+		message: msg,
+	}, nil
+}
+
+type httpSMS struct {
+	url     string
+	method  string
+	header  http.Header
+	body    *template.Template
+	message *template.Template
+}
+
+func (h *httpSMS) Name() string {
+	return "http"
+}
+
+func (h *httpSMS) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	data := newMessageData(areaCode, phoneNumber, verifyCode)
+	msg, err := render(h.message, data)
+	if err != nil {
+		return err
+	}
+	data.Message = msg
+	body, err := render(h.body, data)
+	if err != nil {
+		return err
+	}
+	status, resp, err := do(ctx, h.Name(), h.method, h.url, h.header, []byte(body))
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 {
+		return errs.New("http send sms failed", "status", status, "body", string(resp)).Wrap()
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// DefaultMessage is the text sent by providers that do not use a template of their own.
+const DefaultMessage = "Your verification code is {{.Code}}"
+
+// maxResponseSize bounds how much of a provider response is read.
+const maxResponseSize = 64 << 10
+
+var client = &http.Client{
+	Timeout: time.Second * 10,
+}
+
+// MessageData is what message and body templates are executed with.
+type MessageData struct {
+	AreaCode    string // with leading +
+	PhoneNumber string
+	Phone       string // E.164, area code and phone number
+	Code        string
+	Message     string // the rendered message, only set for body templates
+}
+
+var templateFuncs = template.FuncMap{
+	// json quotes a value for use inside a JSON body template
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	},
+}
+
+func parseTemplate(name string, text string) (*template.Template, error) {
+	t, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid sms template", "name", name)
+	}
+	return t, nil
+}
+
+func newMessage(text string) (*template.Template, error) {
+	if text == "" {
+		text = DefaultMessage
+	}
+	return parseTemplate("message", text)
+}
+
+func newMessageData(areaCode string, phoneNumber string, verifyCode string) *MessageData {
+	areaCode = normalizeAreaCode(areaCode)
+	return &MessageData{
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		Phone:       areaCode + phoneNumber,
+		Code:        verifyCode,
+	}
+}
+
+func render(t *template.Template, data *MessageData) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errs.WrapMsg(err, "render sms template failed", "name", t.Name())
+	}
+	return buf.String(), nil
+}
+
+// do sends the request and returns the status code and body of the response.
+func do(ctx context.Context, provider string, method string, url string, header http.Header, body []byte) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, errs.WrapMsg(err, "new sms request failed", "provider", provider)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, errs.WrapMsg(err, "send sms request failed", "provider", provider)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, nil, errs.WrapMsg(err, "read sms response failed", "provider", provider, "status", resp.StatusCode)
+	}
+	return resp.StatusCode, data, nil
+}
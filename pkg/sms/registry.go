@@ -0,0 +1,139 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// Provider names accepted in configuration.
+const (
+	ProviderAli    = "ali"
+	ProviderTwilio = "twilio"
+	ProviderSNS    = "sns"
+	ProviderVonage = "vonage"
+	ProviderHTTP   = "http"
+)
+
+// Factory creates a provider from its configuration.
+type Factory func() (SMS, error)
+
+// Registry knows how to create providers by name. Providers are only created when Build needs them,
+// so unused providers may stay unconfigured.
+type Registry struct {
+	factories map[string]Factory
+}
+
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+func (r *Registry) Register(name string, factory Factory) {
+	r.factories[strings.ToLower(name)] = factory
+}
+
+// Build returns the provider named fallback, or a router when routes map area codes to other providers.
+// fallback may be empty when every area code to send to has a route.
+func (r *Registry) Build(fallback string, routes map[string]string) (SMS, error) {
+	created := make(map[string]SMS)
+	create := func(name string) (SMS, error) {
+		name = strings.ToLower(name)
+		if s, ok := created[name]; ok {
+			return s, nil
+		}
+		factory, ok := r.factories[name]
+		if !ok {
+			return nil, errs.New("unknown sms provider", "provider", name)
+		}
+		s, err := factory()
+		if err != nil {
+			return nil, errs.WrapMsg(err, "create sms provider failed", "provider", name)
+		}
+		created[name] = s
+		return s, nil
+	}
+	var (
+		def SMS
+		err error
+	)
+	if fallback != "" {
+		if def, err = create(fallback); err != nil {
+			return nil, err
+		}
+	}
+	if len(routes) == 0 {
+		if def == nil {
+			return nil, errs.New("no sms provider configured")
+		}
+		return def, nil
+	}
+	router := &Router{fallback: def, routes: make(map[string]SMS, len(routes))}
+	for areaCode, name := range routes {
+		s, err := create(name)
+		if err != nil {
+			return nil, err
+		}
+		router.routes[normalizeAreaCode(areaCode)] = s
+	}
+	for areaCode := range router.routes {
+		router.areaCodes = append(router.areaCodes, areaCode)
+	}
+	// longest first, so +1 268 can be routed apart from +1
+	sort.Slice(router.areaCodes, func(i, j int) bool {
+		return len(router.areaCodes[i]) > len(router.areaCodes[j])
+	})
+	return router, nil
+}
+
+// Router picks the provider by the area code of the number, numbers without a route use the fallback.
+type Router struct {
+	fallback  SMS
+	routes    map[string]SMS
+	areaCodes []string
+}
+
+func (r *Router) Name() string {
+	return "router"
+}
+
+func (r *Router) Route(areaCode string, phoneNumber string) SMS {
+	phone := normalizeAreaCode(areaCode) + phoneNumber
+	for _, prefix := range r.areaCodes {
+		if strings.HasPrefix(phone, prefix) {
+			return r.routes[prefix]
+		}
+	}
+	return r.fallback
+}
+
+func (r *Router) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	s := r.Route(areaCode, phoneNumber)
+	if s == nil {
+		return errs.ErrArgs.WrapMsg("no sms provider for area code", "areaCode", areaCode)
+	}
+	return s.SendCode(ctx, areaCode, phoneNumber, verifyCode)
+}
+
+func normalizeAreaCode(areaCode string) string {
+	areaCode = strings.TrimSpace(areaCode)
+	if areaCode != "" && !strings.HasPrefix(areaCode, "+") {
+		areaCode = "+" + areaCode
+	}
+	return areaCode
+}
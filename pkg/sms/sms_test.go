@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type stubRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   string
+}
+
+// newStub records every request and answers with status and body.
+func newStub(t *testing.T, status int, body string) (*httptest.Server, *[]stubRequest) {
+	t.Helper()
+	var reqs []stubRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		reqs = append(reqs, stubRequest{Method: r.Method, Path: r.URL.Path, Header: r.Header.Clone(), Body: string(data)})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &reqs
+}
+
+func parseForm(t *testing.T, body string) url.Values {
+	t.Helper()
+	form, err := url.ParseQuery(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return form
+}
+
+func TestTwilio(t *testing.T) {
+	srv, reqs := newStub(t, http.StatusCreated, `{"sid":"SM1"}`)
+	s, err := NewTwilio(srv.URL, "AC1", "token", "+15550000000", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendCode(context.Background(), "1", "5551234567", "123456"); err != nil {
+		t.Fatal(err)
+	}
+	req := (*reqs)[0]
+	if req.Path != "/2010-04-01/Accounts/AC1/Messages.json" {
+		t.Fatalf("unexpected path %s", req.Path)
+	}
+	if user, pass, ok := (&http.Request{Header: req.Header}).BasicAuth(); !ok || user != "AC1" || pass != "token" {
+		t.Fatalf("unexpected basic auth %s %s", user, pass)
+	}
+	form := parseForm(t, req.Body)
+	if form.Get("To") != "+15551234567" || form.Get("From") != "+15550000000" || form.Get("Body") != "Your verification code is 123456" {
+		t.Fatalf("unexpected form %v", form)
+	}
+
+	srv, _ = newStub(t, http.StatusBadRequest, `{"code":21211,"message":"invalid To"}`)
+	s, _ = NewTwilio(srv.URL, "AC1", "token", "MG1", "")
+	if err := s.SendCode(context.Background(), "+1", "1", "123456"); err == nil || !strings.Contains(err.Error(), "21211") {
+		t.Fatalf("expected twilio error, got %v", err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	srv, reqs := newStub(t, http.StatusOK, `<PublishResponse/>`)
+	s, err := NewSNS(srv.URL, "us-east-1", "AKID", "secret", "", "OpenIM", "", "code {{.Code}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendCode(context.Background(), "+44", "7700900000", "654321"); err != nil {
+		t.Fatal(err)
+	}
+	req := (*reqs)[0]
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(auth, "/us-east-1/sns/aws4_request") ||
+		!strings.Contains(auth, "SignedHeaders=content-type;host;x-amz-date") {
+		t.Fatalf("unexpected authorization %s", auth)
+	}
+	form := parseForm(t, req.Body)
+	if form.Get("Action") != "Publish" || form.Get("PhoneNumber") != "+447700900000" || form.Get("Message") != "code 654321" {
+		t.Fatalf("unexpected form %v", form)
+	}
+	if form.Get("MessageAttributes.entry.2.Value.StringValue") != "OpenIM" {
+		t.Fatalf("missing sender id %v", form)
+	}
+
+	srv, _ = newStub(t, http.StatusForbidden, `<ErrorResponse><Error><Code>InvalidClientTokenId</Code><Message>bad</Message></Error></ErrorResponse>`)
+	s, _ = NewSNS(srv.URL, "us-east-1", "AKID", "secret", "", "", "", "")
+	if err := s.SendCode(context.Background(), "+44", "1", "1"); err == nil || !strings.Contains(err.Error(), "InvalidClientTokenId") {
+		t.Fatalf("expected sns error, got %v", err)
+	}
+}
+
+func TestVonage(t *testing.T) {
+	srv, reqs := newStub(t, http.StatusOK, `{"messages":[{"status":"0"}]}`)
+	s, err := NewVonage(srv.URL, "key", "secret", "OpenIM", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendCode(context.Background(), "+49", "15112345678", "111111"); err != nil {
+		t.Fatal(err)
+	}
+	form := parseForm(t, (*reqs)[0].Body)
+	if (*reqs)[0].Path != "/sms/json" || form.Get("to") != "4915112345678" || form.Get("api_key") != "key" {
+		t.Fatalf("unexpected request %v", (*reqs)[0])
+	}
+
+	srv, _ = newStub(t, http.StatusOK, `{"messages":[{"status":"4","error-text":"Bad Credentials"}]}`)
+	s, _ = NewVonage(srv.URL, "key", "secret", "OpenIM", "")
+	if err := s.SendCode(context.Background(), "+49", "1", "1"); err == nil || !strings.Contains(err.Error(), "Bad Credentials") {
+		t.Fatalf("expected vonage error, got %v", err)
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	srv, reqs := newStub(t, http.StatusOK, `ok`)
+	s, err := NewHTTP(HTTPConfig{
+		URL:     srv.URL + "/send",
+		Headers: map[string]string{"X-Api-Key": "k"},
+		Body:    `{"to":{{json .Phone}},"text":{{json .Message}}}`,
+		Message: `code "{{.Code}}"`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendCode(context.Background(), "86", "13800000000", "222222"); err != nil {
+		t.Fatal(err)
+	}
+	req := (*reqs)[0]
+	var body map[string]string
+	if err := json.Unmarshal([]byte(req.Body), &body); err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != http.MethodPost || req.Header.Get("X-Api-Key") != "k" || body["to"] != "+8613800000000" || body["text"] != `code "222222"` {
+		t.Fatalf("unexpected request %v", req)
+	}
+
+	srv, _ = newStub(t, http.StatusInternalServerError, `down`)
+	s, _ = NewHTTP(HTTPConfig{URL: srv.URL})
+	if err := s.SendCode(context.Background(), "86", "1", "1"); err == nil {
+		t.Fatal("expected http error")
+	}
+}
+
+type recordSMS struct {
+	name  string
+	phone []string
+}
+
+func (r *recordSMS) Name() string {
+	return r.name
+}
+
+func (r *recordSMS) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	r.phone = append(r.phone, areaCode+phoneNumber)
+	return nil
+}
+
+func TestRegistryRoutes(t *testing.T) {
+	providers := map[string]*recordSMS{}
+	registry := NewRegistry()
+	for _, name := range []string{ProviderAli, ProviderTwilio, ProviderVonage} {
+		name := name
+		registry.Register(name, func() (SMS, error) {
+			providers[name] = &recordSMS{name: name}
+			return providers[name], nil
+		})
+	}
+	registry.Register(ProviderSNS, func() (SMS, error) {
+		t.Fatal("unused provider created")
+		return nil, nil
+	})
+	s, err := registry.Build("twilio", map[string]string{"+86": "ali", "1268": "vonage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	_ = s.SendCode(ctx, "+86", "13800000000", "1")
+	_ = s.SendCode(ctx, "+1", "2685550000", "1")
+	_ = s.SendCode(ctx, "+1", "4155550000", "1")
+	if len(providers["ali"].phone) != 1 || len(providers["vonage"].phone) != 1 || len(providers["twilio"].phone) != 1 {
+		t.Fatalf("unexpected routing ali=%v vonage=%v twilio=%v", providers["ali"].phone, providers["vonage"].phone, providers["twilio"].phone)
+	}
+
+	s, err = registry.Build("", map[string]string{"+86": "ali"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SendCode(ctx, "+1", "4155550000", "1"); err == nil {
+		t.Fatal("expected error without fallback")
+	}
+	if _, err := registry.Build("unknown", nil); err == nil {
+		t.Fatal("expected unknown provider error")
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	snsService    = "sns"
+	snsAPIVersion = "2010-03-31"
+	snsAlgorithm  = "AWS4-HMAC-SHA256"
+)
+
+// NewSNS publishes directly to phone numbers with AWS SNS. Requests are signed with Signature Version 4,
+// sessionToken is only needed for temporary credentials. endpoint defaults to the regional SNS endpoint.
+func NewSNS(endpoint, region, accessKeyID, secretAccessKey, sessionToken, senderID, smsType, message string) (SMS, error) {
+	if region == "" || accessKeyID == "" || secretAccessKey == "" {
+		return nil, errs.New("sns region, accessKeyId and secretAccessKey must be set")
+	}
+	msg, err := newMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		endpoint = "https://sns." + region + ".amazonaws.com"
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/") + "/")
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid sns endpoint", "endpoint", endpoint)
+	}
+	if smsType == "" {
+		smsType = "Transactional"
+	}
+	return &sns{
+		url:             u,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		sessionToken:    sessionToken,
+		senderID:        senderID,
+		smsType:         smsType,
+		message:         msg,
+	}, nil
+}
+
+type sns struct {
+	url             *url.URL
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	senderID        string
+	smsType         string
+	message         *template.Template
+}
+
+func (s *sns) Name() string {
+	return "sns"
+}
+
+func (s *sns) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	data := newMessageData(areaCode, phoneNumber, verifyCode)
+	text, err := render(s.message, data)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("Action", "Publish")
+	form.Set("Version", snsAPIVersion)
+	form.Set("PhoneNumber", data.Phone)
+	form.Set("Message", text)
+	attributes := [][2]string{{"AWS.SNS.SMS.SMSType", s.smsType}}
+	if s.senderID != "" {
+		attributes = append(attributes, [2]string{"AWS.SNS.SMS.SenderID", s.senderID})
+	}
+	for i, attr := range attributes {
+		prefix := "MessageAttributes.entry." + strconv.Itoa(i+1)
+		form.Set(prefix+".Name", attr[0])
+		form.Set(prefix+".Value.DataType", "String")
+		form.Set(prefix+".Value.StringValue", attr[1])
+	}
+	body := []byte(form.Encode())
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	s.sign(header, body, time.Now().UTC())
+	status, resp, err := do(ctx, s.Name(), http.MethodPost, s.url.String(), header, body)
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 {
+		var res struct {
+			Error struct {
+				Code    string `xml:"Code"`
+				Message string `xml:"Message"`
+			} `xml:"Error"`
+		}
+		_ = xml.Unmarshal(resp, &res)
+		return errs.New("sns send sms failed", "status", status, "code", res.Error.Code, "message", res.Error.Message).Wrap()
+	}
+	return nil
+}
+
+// sign adds the Signature Version 4 headers for a POST to the endpoint root.
+func (s *sns) sign(header http.Header, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	header.Set("X-Amz-Date", amzDate)
+	if s.sessionToken != "" {
+		header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+	names := []string{"content-type", "host", "x-amz-date"}
+	values := map[string]string{
+		"content-type": header.Get("Content-Type"),
+		"host":         s.url.Host,
+		"x-amz-date":   amzDate,
+	}
+	if s.sessionToken != "" {
+		names = append(names, "x-amz-security-token")
+		values["x-amz-security-token"] = s.sessionToken
+	}
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(values[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	path := s.url.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost,
+		path,
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+	scope := date + "/" + s.region + "/" + snsService + "/aws4_request"
+	stringToSign := strings.Join([]string{snsAlgorithm, amzDate, scope, hexSHA256([]byte(canonicalRequest))}, "\n")
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, snsService)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	header.Set("Authorization", snsAlgorithm+" Credential="+s.accessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
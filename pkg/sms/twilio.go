@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const twilioEndpoint = "https://api.twilio.com"
+
+// NewTwilio sends through the Twilio Messages API. from is a sender number, or a messaging service
+// SID starting with MG. endpoint defaults to the Twilio API.
+func NewTwilio(endpoint, accountSID, authToken, from, message string) (SMS, error) {
+	if accountSID == "" || authToken == "" || from == "" {
+		return nil, errs.New("twilio accountSid, authToken and from must be set")
+	}
+	msg, err := newMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		endpoint = twilioEndpoint
+	}
+	return &twilio{
+		url:        strings.TrimSuffix(endpoint, "/") + "/2010-04-01/Accounts/" + url.PathEscape(accountSID) + "/Messages.json",
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+		message:    msg,
+	}, nil
+}
+
+type twilio struct {
+	url        string
+	accountSID string
+	authToken  string
+	from       string
+	message    *template.Template
+}
+
+func (t *twilio) Name() string {
+	return "twilio"
+}
+
+func (t *twilio) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	data := newMessageData(areaCode, phoneNumber, verifyCode)
+	body, err := render(t.message, data)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("To", data.Phone)
+	if strings.HasPrefix(t.from, "MG") {
+		form.Set("MessagingServiceSid", t.from)
+	} else {
+		form.Set("From", t.from)
+	}
+	form.Set("Body", body)
+	header := http.Header{}
+	header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(t.accountSID+":"+t.authToken)))
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	status, resp, err := do(ctx, t.Name(), http.MethodPost, t.url, header, []byte(form.Encode()))
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 {
+		var res struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(resp, &res)
+		return errs.New("twilio send sms failed", "status", status, "code", res.Code, "message", res.Message).Wrap()
+	}
+	return nil
+}
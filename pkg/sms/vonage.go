@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const vonageEndpoint = "https://rest.nexmo.com"
+
+// NewVonage sends through the Vonage (Nexmo) SMS API, endpoint defaults to the Vonage API.
+func NewVonage(endpoint, apiKey, apiSecret, from, message string) (SMS, error) {
+	if apiKey == "" || apiSecret == "" || from == "" {
+		return nil, errs.New("vonage apiKey, apiSecret and from must be set")
+	}
+	msg, err := newMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		endpoint = vonageEndpoint
+	}
+	return &vonage{
+		url:       strings.TrimSuffix(endpoint, "/") + "/sms/json",
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		from:      from,
+		message:   msg,
+	}, nil
+}
+
+type vonage struct {
+	url       string
+	apiKey    string
+	apiSecret string
+	from      string
+	message   *template.Template
+}
+
+func (v *vonage) Name() string {
+	return "vonage"
+}
+
+func (v *vonage) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	data := newMessageData(areaCode, phoneNumber, verifyCode)
+	text, err := render(v.message, data)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("api_key", v.apiKey)
+	form.Set("api_secret", v.apiSecret)
+	form.Set("from", v.from)
+	form.Set("to", strings.TrimPrefix(data.Phone, "+"))
+	form.Set("text", text)
+	header := http.Header{}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	status, resp, err := do(ctx, v.Name(), http.MethodPost, v.url, header, []byte(form.Encode()))
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 {
+		return errs.New("vonage send sms failed", "status", status).Wrap()
+	}
+	// the API answers 200 also when sending failed, the status of each message part tells
+	var res struct {
+		Messages []struct {
+			Status    string `json:"status"`
+			ErrorText string `json:"error-text"`
+		} `json:"messages"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return errs.WrapMsg(err, "vonage invalid response", "body", string(resp))
+	}
+	if len(res.Messages) == 0 {
+		return errs.New("vonage send sms failed", "body", string(resp)).Wrap()
+	}
+	for _, m := range res.Messages {
+		if m.Status != "0" {
+			return errs.New("vonage send sms failed", "status", m.Status, "message", m.ErrorText).Wrap()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed of RFC 6238 Appendix B, the ASCII string "12345678901234567890".
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeRFC6238(t *testing.T) {
+	// The RFC lists 8 digit codes, the 6 digit codes are their last 6 digits.
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, c := range cases {
+		code, err := Code(rfcSecret, Step(time.Unix(c.unix, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != c.code {
+			t.Errorf("Code at %d = %s, want %s", c.unix, code, c.code)
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := Step(now)
+	for offset := int64(-2); offset <= 2; offset++ {
+		code, err := Code(rfcSecret, current+offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		step, ok := Validate(rfcSecret, code, now)
+		want := offset >= -defaultSkew && offset <= defaultSkew
+		if ok != want {
+			t.Errorf("Validate of the code %d steps away = %v, want %v", offset, ok, want)
+		}
+		if ok && step != current+offset {
+			t.Errorf("Validate of the code %d steps away matched step %d, want %d", offset, step, current+offset)
+		}
+	}
+	if _, ok := Validate(rfcSecret, "12345", now); ok {
+		t.Error("short code accepted")
+	}
+	if _, ok := Validate("not base32!", "123456", now); ok {
+		t.Error("invalid secret accepted")
+	}
+}
+
+// TestValidateStepReuse checks that a code matches the same step for as long as it is accepted, which is
+// what the stored last step of a user relies on to reject a replayed code.
+func TestValidateStepReuse(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, err := Code(rfcSecret, Step(now))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastStep, ok := Validate(rfcSecret, code, now)
+	if !ok {
+		t.Fatal("current code rejected")
+	}
+	for _, later := range []time.Duration{0, time.Second, Period * time.Second} {
+		step, ok := Validate(rfcSecret, code, now.Add(later))
+		if !ok {
+			t.Fatalf("code rejected %s later", later)
+		}
+		if step > lastStep {
+			t.Errorf("code matched the newer step %d %s later, it could be used twice", step, later)
+		}
+	}
+	next, err := Code(rfcSecret, Step(now)+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if step, ok := Validate(rfcSecret, next, now.Add(Period*time.Second)); !ok || step <= lastStep {
+		t.Errorf("code of the next step = %d, %v, want a step after %d", step, ok, lastStep)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != defaultRecoveryNum || len(hashes) != defaultRecoveryNum {
+		t.Fatalf("got %d codes and %d hashes, want %d", len(codes), len(hashes), defaultRecoveryNum)
+	}
+	seen := make(map[string]bool)
+	for i, code := range codes {
+		if seen[code] {
+			t.Errorf("duplicate recovery code %s", code)
+		}
+		seen[code] = true
+		if HashRecoveryCode(code) != hashes[i] {
+			t.Errorf("hash of %s does not match the stored one", code)
+		}
+		typed := " " + strings.ToUpper(strings.ReplaceAll(code, "-", "")) + " "
+		if HashRecoveryCode(typed) != hashes[i] {
+			t.Errorf("hash of %q typed without dash does not match the stored one", typed)
+		}
+		if hashes[i] == code {
+			t.Errorf("recovery code %s is stored in plain", code)
+		}
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := Code(secret, Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := Validate(secret, code, time.Now()); !ok {
+		t.Error("code of a generated secret rejected")
+	}
+	if uri := URI("OpenIM", "alice", secret); !strings.HasPrefix(uri, "otpauth://totp/OpenIM:alice?") || !strings.Contains(uri, "secret="+secret) {
+		t.Errorf("unexpected key uri %s", uri)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package userexport writes the personal data archive of a user and signs the links it is downloaded with.
+// The archive holds every table twice, as JSON for machines and as CSV for spreadsheets.
+package userexport
+
+import (
+	"archive/zip"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// FileExt is the extension of the archives, they are stored as <exportID><FileExt>.
+const FileExt = ".zip"
+
+// Table is one kind of records of the user, Rows hold the values in the order of Columns.
+type Table struct {
+	Name    string
+	Columns []string
+	Rows    [][]any
+}
+
+// WriteArchive writes the zip archive of tables to w.
+func WriteArchive(w io.Writer, tables []Table) error {
+	zw := zip.NewWriter(w)
+	for _, table := range tables {
+		if err := writeJSON(zw, table); err != nil {
+			return err
+		}
+		if err := writeCSV(zw, table); err != nil {
+			return err
+		}
+	}
+	return errs.Wrap(zw.Close())
+}
+
+func writeJSON(zw *zip.Writer, table Table) error {
+	records := make([]map[string]any, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		record := make(map[string]any, len(table.Columns))
+		for i, column := range table.Columns {
+			record[column] = formatJSON(row[i])
+		}
+		records = append(records, record)
+	}
+	f, err := zw.Create(table.Name + ".json")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	return errs.Wrap(encoder.Encode(records))
+}
+
+func writeCSV(zw *zip.Writer, table Table) error {
+	f, err := zw.Create(table.Name + ".csv")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	cw := csv.NewWriter(f)
+	if err := cw.Write(table.Columns); err != nil {
+		return errs.Wrap(err)
+	}
+	for _, row := range table.Rows {
+		values := make([]string, len(row))
+		for i, value := range row {
+			values[i] = formatCSV(value)
+		}
+		if err := cw.Write(values); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	cw.Flush()
+	return errs.Wrap(cw.Error())
+}
+
+func formatJSON(value any) any {
+	if t, ok := value.(time.Time); ok {
+		return formatTime(t)
+	}
+	return value
+}
+
+func formatCSV(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return formatTime(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formatTime leaves unset times empty instead of writing year one.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// NewExportID returns a random ID, it is the only part of the archive path that comes from outside.
+func NewExportID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Path returns the path of the archive in dir, it rejects IDs that are not generated by NewExportID.
+func Path(dir string, exportID string) (string, error) {
+	if b, err := hex.DecodeString(exportID); err != nil || len(b) != 16 {
+		return "", errs.ErrArgs.WrapMsg("invalid export id")
+	}
+	return filepath.Join(dir, exportID+FileExt), nil
+}
+
+// Sign returns the signature of the download link of the archive, expire is a unix timestamp in seconds.
+func Sign(secret string, exportID string, expire int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(exportID + "." + strconv.FormatInt(expire, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a download link and that it has not expired.
+func Verify(secret string, exportID string, expire int64, sign string, now time.Time) error {
+	if !hmac.Equal([]byte(Sign(secret, exportID, expire)), []byte(sign)) {
+		return errs.ErrNoPermission.WrapMsg("invalid download signature")
+	}
+	if now.Unix() > expire {
+		return errs.ErrNoPermission.WrapMsg("download link expired")
+	}
+	return nil
+}
+
+// DownloadURL returns the signed link of the archive on chat-api, base is the public URL of chat-api.
+func DownloadURL(base string, secret string, exportID string, expire int64) string {
+	query := url.Values{
+		"id":     {exportID},
+		"expire": {strconv.FormatInt(expire, 10)},
+		"sign":   {Sign(secret, exportID, expire)},
+	}
+	return base + "/user/export/download?" + query.Encode()
+}
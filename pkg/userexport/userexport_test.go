@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWriteArchive(t *testing.T) {
+	created := time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC)
+	tables := []Table{{
+		Name:    "profile",
+		Columns: []string{"userID", "nickname", "createTime", "birthTime"},
+		Rows:    [][]any{{"u1", "Ann, \"A\"", created, time.Time{}}},
+	}}
+	var buf bytes.Buffer
+	if err := WriteArchive(&buf, tables); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(data)
+	}
+	wantCSV := "userID,nickname,createTime,birthTime\nu1,\"Ann, \"\"A\"\"\",2024-05-01T08:30:00Z,\n"
+	if files["profile.csv"] != wantCSV {
+		t.Errorf("profile.csv = %q, want %q", files["profile.csv"], wantCSV)
+	}
+	if !bytes.Contains([]byte(files["profile.json"]), []byte(`"createTime": "2024-05-01T08:30:00Z"`)) {
+		t.Errorf("profile.json = %s", files["profile.json"])
+	}
+}
+
+func TestSign(t *testing.T) {
+	now := time.Now()
+	exportID, err := NewExportID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expire := now.Add(time.Hour).Unix()
+	link, err := url.Parse(DownloadURL("https://chat.example.com/api", "secret", exportID, expire))
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := link.Query()
+	if query.Get("id") != exportID || query.Get("expire") != strconv.FormatInt(expire, 10) {
+		t.Fatalf("unexpected link %s", link)
+	}
+	if err := Verify("secret", exportID, expire, query.Get("sign"), now); err != nil {
+		t.Errorf("valid link rejected: %v", err)
+	}
+	if err := Verify("other", exportID, expire, query.Get("sign"), now); err == nil {
+		t.Error("link signed with another secret accepted")
+	}
+	if err := Verify("secret", exportID, expire+1, query.Get("sign"), now); err == nil {
+		t.Error("link with a changed expiry accepted")
+	}
+	if err := Verify("secret", exportID, expire, query.Get("sign"), now.Add(2*time.Hour)); err == nil {
+		t.Error("expired link accepted")
+	}
+}
+
+func TestPath(t *testing.T) {
+	if _, err := Path("/data", "../../etc/passwd"); err == nil {
+		t.Error("path traversal accepted")
+	}
+	exportID, _ := NewExportID()
+	p, err := Path("/data", exportID)
+	if err != nil || p != "/data/"+exportID+FileExt {
+		t.Errorf("Path = %q, %v", p, err)
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/password"
+	"github.com/openimsdk/chat/tools/dataversion"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/system/program"
+	"github.com/openimsdk/tools/utils/runtimeenv"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	passwordKey     = "password_hash"
+	passwordVersion = 1
+
+	accountCollection = "account"
+	adminCollection   = "admin"
+	pageNum           = 1000
+)
+
+// legacy matches a stored value that was saved as sent by the client, hashes start with "$".
+var legacy = bson.M{"$regex": "^[^$]"}
+
+func initConfig(configDir string) (*config.Mongo, *config.Share, error) {
+	var (
+		mongoConfig = &config.Mongo{}
+		shareConfig = &config.Share{}
+	)
+
+	runtimeEnv := runtimeenv.RuntimeEnvironment()
+
+	err := config.Load(configDir, config.MongodbConfigFileName, config.EnvPrefixMap[config.MongodbConfigFileName], runtimeEnv, mongoConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = config.Load(configDir, config.ShareFileName, config.EnvPrefixMap[config.ShareFileName], runtimeEnv, shareConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mongoConfig, shareConfig, nil
+}
+
+type storedPassword struct {
+	ID              primitive.ObjectID `bson:"_id"`
+	Password        string             `bson:"password"`
+	PasswordHistory []string           `bson:"password_history"`
+}
+
+// hashAll hashes the legacy passwords of coll. Every document is updated on its own and only if its
+// password is still the value that was read, a document updated meanwhile is left as it is.
+// Hashed documents no longer match the filter, so the first page is read until it is empty.
+func hashAll(ctx context.Context, coll *mongo.Collection, hasher password.Hasher, withHistory bool) (int, error) {
+	filter := bson.M{"password": legacy}
+	if withHistory {
+		filter = bson.M{"$or": []bson.M{{"password": legacy}, {"password_history": legacy}}}
+	}
+	opts := options.Find().SetProjection(bson.M{"password": 1, "password_history": 1}).SetLimit(pageNum)
+	var hashed int
+	for {
+		docs, err := mongoutil.Find[*storedPassword](ctx, coll, filter, opts)
+		if err != nil {
+			return hashed, err
+		}
+		if len(docs) == 0 {
+			return hashed, nil
+		}
+		var updated int
+		for _, doc := range docs {
+			set := bson.M{}
+			if doc.Password != "" && !password.IsHashed(doc.Password) {
+				value, err := hasher.Hash(doc.Password)
+				if err != nil {
+					return hashed, err
+				}
+				set["password"] = value
+			}
+			if withHistory {
+				history := make([]string, len(doc.PasswordHistory))
+				var changed bool
+				for i, previous := range doc.PasswordHistory {
+					history[i] = previous
+					if previous == "" || password.IsHashed(previous) {
+						continue
+					}
+					if history[i], err = hasher.Hash(previous); err != nil {
+						return hashed, err
+					}
+					changed = true
+				}
+				if changed {
+					set["password_history"] = history
+				}
+			}
+			if len(set) == 0 {
+				continue
+			}
+			res, err := mongoutil.UpdateOneResult(ctx, coll, bson.M{"_id": doc.ID, "password": doc.Password}, bson.M{"$set": set})
+			if err != nil {
+				return hashed, err
+			}
+			if res.ModifiedCount > 0 {
+				updated++
+			}
+		}
+		hashed += updated
+		if updated == 0 {
+			// the rest changed meanwhile or cannot be hashed, run again
+			return hashed, fmt.Errorf("%d passwords in %s were not hashed", len(docs), coll.Name())
+		}
+	}
+}
+
+func doHashPassword() error {
+	var index int
+	var configDir string
+	flag.IntVar(&index, "i", 0, "Index number")
+	defaultConfigDir := filepath.Join("..", "..", "..", "..", "..", "config")
+	flag.StringVar(&configDir, "c", defaultConfigDir, "Configuration dir")
+	flag.Parse()
+
+	fmt.Printf("Index: %d, Config Path: %s\n", index, configDir)
+
+	mongoConfig, shareConfig, err := initConfig(configDir)
+	if err != nil {
+		return err
+	}
+	hasher, err := shareConfig.PasswordHash.Build()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	mgocli, err := mongoutil.NewMongoDB(ctx, mongoConfig.Build())
+	if err != nil {
+		return err
+	}
+
+	versionColl := mgocli.GetDB().Collection(dataversion.Collection)
+	converted, err := dataversion.CheckVersion(versionColl, passwordKey, passwordVersion)
+	if err != nil {
+		return err
+	}
+	if converted {
+		fmt.Println("[password] passwords have been hashed")
+		return nil
+	}
+
+	accounts, err := hashAll(ctx, mgocli.GetDB().Collection(accountCollection), hasher, true)
+	fmt.Printf("[password] %d account passwords hashed\n", accounts)
+	if err != nil {
+		return err
+	}
+	admins, err := hashAll(ctx, mgocli.GetDB().Collection(adminCollection), hasher, false)
+	fmt.Printf("[password] %d admin passwords hashed\n", admins)
+	if err != nil {
+		return err
+	}
+	if err := dataversion.SetVersion(versionColl, passwordKey, passwordVersion); err != nil {
+		return fmt.Errorf("set mongodb password version %w", err)
+	}
+	fmt.Println("[password] hash legacy passwords success")
+	return nil
+}
+
+func main() {
+	if err := doHashPassword(); err != nil {
+		program.ExitWithError(err)
+	}
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openimsdk/chat/internal/rpc/chat"
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	table "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/phonenumber"
+	"github.com/openimsdk/chat/tools/dataversion"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/system/program"
+	"github.com/openimsdk/tools/utils/runtimeenv"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	phoneKey     = "phone_e164"
+	phoneVersion = 1
+
+	attributeCollection  = "attribute"
+	credentialCollection = "credential"
+	pageNum              = 1000
+)
+
+func initConfig(configDir string) (*config.Mongo, error) {
+	var (
+		mongoConfig = &config.Mongo{}
+	)
+
+	runtimeEnv := runtimeenv.RuntimeEnvironment()
+
+	err := config.Load(configDir, config.MongodbConfigFileName, config.EnvPrefixMap[config.MongodbConfigFileName], runtimeEnv, mongoConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return mongoConfig, nil
+}
+
+func findAll[T any](ctx context.Context, coll *mongo.Collection, filter any) ([]T, error) {
+	pagination := &sdkws.RequestPagination{
+		PageNumber: 1,
+		ShowNumber: pageNum,
+	}
+	var all []T
+	for {
+		_, page, err := mongoutil.FindPage[T](ctx, coll, filter, pagination)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < pageNum {
+			return all, nil
+		}
+		pagination.PageNumber++
+	}
+}
+
+// normalizeAccount normalizes a phone credential account, the area code and phone number joined by a space.
+func normalizeAccount(account string) (string, error) {
+	areaCode, phone, ok := strings.Cut(account, " ")
+	if !ok {
+		return "", fmt.Errorf("no space between area code and phone number")
+	}
+	areaCode, phone, err := phonenumber.Normalize(areaCode, phone)
+	if err != nil {
+		return "", err
+	}
+	return chat.BuildCredentialPhone(areaCode, phone), nil
+}
+
+// userChange is the normalized phone number of one user, empty fields are left unchanged.
+type userChange struct {
+	oldAccount  string
+	account     string
+	areaCode    string
+	phoneNumber string
+}
+
+func (c *userChange) credentials() int {
+	if c.account == "" {
+		return 0
+	}
+	return 1
+}
+
+func (c *userChange) attributes() int {
+	if c.areaCode == "" {
+		return 0
+	}
+	return 1
+}
+
+func (c *userChange) apply(ctx context.Context, credColl *mongo.Collection, attrColl *mongo.Collection, userID string) error {
+	if c.account != "" {
+		err := mongoutil.UpdateOne(ctx, credColl, bson.M{"user_id": userID, "account": c.oldAccount},
+			bson.M{"$set": bson.M{"account": c.account}}, false)
+		if err != nil {
+			return err
+		}
+	}
+	if c.areaCode != "" {
+		err := mongoutil.UpdateOne(ctx, attrColl, bson.M{"user_id": userID},
+			bson.M{"$set": bson.M{"area_code": c.areaCode, "phone_number": c.phoneNumber}}, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func doNormalizePhone() error {
+	var index int
+	var configDir string
+	var dryRun bool
+	flag.IntVar(&index, "i", 0, "Index number")
+	defaultConfigDir := filepath.Join("..", "..", "..", "..", "..", "config")
+	flag.StringVar(&configDir, "c", defaultConfigDir, "Configuration dir")
+	flag.BoolVar(&dryRun, "dry-run", false, "Only report what would change")
+	flag.Parse()
+
+	fmt.Printf("Index: %d, Config Path: %s\n", index, configDir)
+
+	mongoConfig, err := initConfig(configDir)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	mgocli, err := mongoutil.NewMongoDB(ctx, mongoConfig.Build())
+	if err != nil {
+		return err
+	}
+
+	versionColl := mgocli.GetDB().Collection(dataversion.Collection)
+	converted, err := dataversion.CheckVersion(versionColl, phoneKey, phoneVersion)
+	if err != nil {
+		return err
+	}
+	if converted {
+		fmt.Println("[phone] phone numbers have been normalized")
+		return nil
+	}
+
+	attrColl := mgocli.GetDB().Collection(attributeCollection)
+	credColl := mgocli.GetDB().Collection(credentialCollection)
+
+	credentials, err := findAll[*table.Credential](ctx, credColl, bson.M{"type": constant.CredentialPhone})
+	if err != nil {
+		return err
+	}
+	// normalized account -> users, a collision is a number written differently by several users
+	owners := make(map[string][]string)
+	normalized := make(map[string]string) // user id -> normalized account
+	invalid := make(map[string]bool)
+	for _, credential := range credentials {
+		account, err := normalizeAccount(credential.Account)
+		if err != nil {
+			invalid[credential.UserID] = true
+			fmt.Printf("[phone] invalid user %s account %q: %v\n", credential.UserID, credential.Account, err)
+			continue
+		}
+		owners[account] = append(owners[account], credential.UserID)
+		normalized[credential.UserID] = account
+	}
+	collided := make(map[string]bool)
+	accounts := make([]string, 0, len(owners))
+	for account := range owners {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		if userIDs := owners[account]; len(userIDs) > 1 {
+			fmt.Printf("[phone] collision %s users %s\n", account, strings.Join(userIDs, ", "))
+			for _, userID := range userIDs {
+				collided[userID] = true
+			}
+		}
+	}
+
+	attrs, err := findAll[*table.Attribute](ctx, attrColl, bson.M{"phone_number": bson.M{"$ne": ""}})
+	if err != nil {
+		return err
+	}
+	credentialByUser := make(map[string]*table.Credential, len(credentials))
+	for _, credential := range credentials {
+		credentialByUser[credential.UserID] = credential
+	}
+	attrByUser := make(map[string]*table.Attribute, len(attrs))
+	for _, attr := range attrs {
+		attrByUser[attr.UserID] = attr
+	}
+	userIDs := make([]string, 0, len(credentialByUser)+len(attrByUser))
+	for userID := range credentialByUser {
+		userIDs = append(userIDs, userID)
+	}
+	for userID := range attrByUser {
+		if _, ok := credentialByUser[userID]; !ok {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Strings(userIDs)
+
+	// Each user is written in a transaction of its own, so a large user base stays within the transaction
+	// limits of mongodb. Users already normalized are skipped, an interrupted run is resumed by running again.
+	tx := mgocli.GetTx()
+	var credUpdated, attrUpdated, failed int
+	for _, userID := range userIDs {
+		var change userChange
+		if credential, ok := credentialByUser[userID]; ok {
+			if account, ok := normalized[userID]; ok && !collided[userID] && account != credential.Account {
+				fmt.Printf("[phone] user %s credential %q -> %q\n", userID, credential.Account, account)
+				change.oldAccount, change.account = credential.Account, account
+			}
+		}
+		// the attribute follows the credential of the user
+		if attr, ok := attrByUser[userID]; ok && attr.AreaCode != "" && !collided[userID] && !invalid[userID] {
+			areaCode, phone, err := phonenumber.Normalize(attr.AreaCode, attr.PhoneNumber)
+			if err != nil {
+				fmt.Printf("[phone] invalid user %s attribute %q %q: %v\n", userID, attr.AreaCode, attr.PhoneNumber, err)
+			} else if areaCode != attr.AreaCode || phone != attr.PhoneNumber {
+				fmt.Printf("[phone] user %s attribute %q %q -> %q %q\n", userID, attr.AreaCode, attr.PhoneNumber, areaCode, phone)
+				change.areaCode, change.phoneNumber = areaCode, phone
+			}
+		}
+		if change.account == "" && change.areaCode == "" {
+			continue
+		}
+		if dryRun {
+			credUpdated, attrUpdated = credUpdated+change.credentials(), attrUpdated+change.attributes()
+			continue
+		}
+		if err := tx.Transaction(ctx, func(ctx context.Context) error {
+			return change.apply(ctx, credColl, attrColl, userID)
+		}); err != nil {
+			fmt.Printf("[phone] user %s failed: %v\n", userID, err)
+			failed++
+			continue
+		}
+		credUpdated, attrUpdated = credUpdated+change.credentials(), attrUpdated+change.attributes()
+	}
+	fmt.Printf("[phone] credentials %d normalized, attributes %d normalized, %d invalid, %d users in collisions, %d failed\n",
+		credUpdated, attrUpdated, len(invalid), len(collided), failed)
+	if dryRun {
+		fmt.Println("[phone] dry run, nothing was written")
+		return nil
+	}
+	if failed > 0 {
+		return fmt.Errorf("normalizing %d users failed, run again to retry them", failed)
+	}
+	if len(collided) > 0 {
+		// run again once the colliding accounts are resolved
+		fmt.Println("[phone] colliding accounts were left unchanged, resolve them and run again")
+		return nil
+	}
+	if err := dataversion.SetVersion(versionColl, phoneKey, phoneVersion); err != nil {
+		return fmt.Errorf("set mongodb phone version %w", err)
+	}
+	fmt.Println("[phone] normalize phone numbers success")
+	return nil
+}
+
+func main() {
+	if err := doNormalizePhone(); err != nil {
+		program.ExitWithError(err)
+	}
+}